@@ -19,6 +19,8 @@ import (
 	"github.com/pkg/errors"
 
 	_ "github.com/pivotal/kpack/internal/logrus/fatal"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/archive"
 	"github.com/pivotal/kpack/pkg/blob"
 	"github.com/pivotal/kpack/pkg/buildchange"
 	"github.com/pivotal/kpack/pkg/cnb"
@@ -34,13 +36,19 @@ var (
 
 	gitURL          = flag.String("git-url", os.Getenv("GIT_URL"), "The url of the Git repository to initialize.")
 	gitRevision     = flag.String("git-revision", os.Getenv("GIT_REVISION"), "The Git revision to make the repository HEAD.")
+	gitBranch       = flag.String("git-branch", os.Getenv("GIT_BRANCH"), "The Git branch to check out at the revision instead of a detached HEAD.")
+	gitCompatMode   = flag.String("git-compatibility-mode", os.Getenv("GIT_COMPATIBILITY_MODE"), "How to fetch from git servers with protocol quirks, GitCLI fetches with the git command line client.")
+	gitKnownHosts   = flag.String("git-known-hosts", os.Getenv("GIT_KNOWN_HOSTS"), "known_hosts entries trusted when verifying the host keys of ssh git remotes.")
+	insecureGitHost = flag.Bool("insecure-skip-git-host-key-verification", getenvBool("INSECURE_SKIP_GIT_HOST_KEY_VERIFICATION"), "Skip verifying the host keys of ssh git remotes.")
 	blobURL         = flag.String("blob-url", os.Getenv("BLOB_URL"), "The url of the source code blob.")
 	stripComponents = flag.Int("strip-components", getenvInt("BLOB_STRIP_COMPONENTS", 0), "The number of directory components to strip from the blobs content when extracting.")
 	registryImage   = flag.String("registry-image", os.Getenv("REGISTRY_IMAGE"), "The registry location of the source code image.")
 	hostName        = flag.String("dns-probe-hostname", os.Getenv("DNS_PROBE_HOSTNAME"), "hostname to dns poll")
 	sourceSubPath   = flag.String("source-sub-path", os.Getenv("SOURCE_SUB_PATH"), "the subpath inside the source directory that will be the buildpack workspace")
+	dereference     = flag.Bool("dereference-symlinks", getenvBool("DEREFERENCE_SYMLINKS"), "Replace symlinks in the source with copies of their targets.")
 	buildChanges    = flag.String("build-changes", os.Getenv("BUILD_CHANGES"), "JSON string of build changes and their reason")
 	descriptorPath  = flag.String("project-descriptor-path", os.Getenv("PROJECT_DESCRIPTOR_PATH"), "path to project descriptor file")
+	imageMetadata   = flag.String("image-metadata", os.Getenv("IMAGE_METADATA"), "JSON object of metadata to write to the app dir")
 
 	builderImage = flag.String("builder-image", os.Getenv("BUILDER_IMAGE"), "The builder image used to build the application")
 	builderName  = flag.String("builder-name", os.Getenv("BUILDER_NAME"), "The builder name provided during creation")
@@ -154,11 +162,23 @@ func main() {
 		logger.Fatal(err)
 	}
 
+	if *dereference {
+		err = archive.DereferenceSymlinks(appDir)
+		if err != nil {
+			logger.Fatalf("error while dereferencing symlinks: %s", err)
+		}
+	}
+
 	err = cnb.ProcessProjectDescriptor(filepath.Join(appDir, *sourceSubPath), *descriptorPath, platformDir, logger)
 	if err != nil {
 		logger.Fatalf("error while processing the project descriptor: %s", err)
 	}
 
+	err = cnb.WriteImageMetadata(filepath.Join(appDir, *sourceSubPath), *imageMetadata)
+	if err != nil {
+		logger.Fatalf("error writing image metadata: %s", err)
+	}
+
 	if *builderImage != "" && *builderName != "" && *builderKind != "" {
 		logger.Printf("Builder:\n Image: %s \n Name: %s \n Kind: %s ", *builderImage,
 			*builderName, *builderKind)
@@ -205,11 +225,26 @@ func fetchSource(logger *log.Logger, keychain authn.Keychain) error {
 			return err
 		}
 
+		hostKeyPolicy := git.HostKeyPolicy{
+			KnownHosts:         *gitKnownHosts,
+			InsecureSkipVerify: *insecureGitHost,
+		}
+
+		if corev1alpha1.GitCompatibilityMode(*gitCompatMode) == corev1alpha1.GitCompatibilityModeCLI {
+			fetcher := git.CLIFetcher{
+				Logger:        logger,
+				Keychain:      gitKeychain,
+				HostKeyPolicy: hostKeyPolicy,
+			}
+			return fetcher.Fetch(appDir, *gitURL, *gitRevision, *gitBranch, projectMetadataDir)
+		}
+
 		fetcher := git.Fetcher{
-			Logger:   logger,
-			Keychain: gitKeychain,
+			Logger:        logger,
+			Keychain:      gitKeychain,
+			HostKeyPolicy: hostKeyPolicy,
 		}
-		return fetcher.Fetch(appDir, *gitURL, *gitRevision, projectMetadataDir)
+		return fetcher.Fetch(appDir, *gitURL, *gitRevision, *gitBranch, projectMetadataDir)
 	case *blobURL != "":
 		fetcher := blob.Fetcher{
 			Logger: logger,
@@ -287,6 +322,14 @@ func copyFile(src, dest string) error {
 	return os.Chmod(dest, srcInfo.Mode())
 }
 
+func getenvBool(key string) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return false
+	}
+	return value
+}
+
 func getenvInt(key string, defaultValue int) int {
 	value := os.Getenv(key)
 	atoi, err := strconv.Atoi(value)
@@ -2,8 +2,8 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
-	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
@@ -17,6 +17,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sigstore/cosign/cmd/cosign/cli/options"
 	"github.com/sigstore/cosign/cmd/cosign/cli/sign"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	_ "github.com/pivotal/kpack/internal/logrus/fatal"
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
@@ -37,6 +43,11 @@ const (
 
 var (
 	cacheTag                string
+	previousImage           string
+	previousCacheImage      string
+	buildName               string
+	buildNamespace          string
+	buildUID                string
 	terminationMsgPath      string
 	notaryV1URL             string
 	dockerCredentials       flaghelpers.CredentialsFlags
@@ -52,6 +63,11 @@ var (
 
 func init() {
 	flag.StringVar(&cacheTag, "cache-tag", os.Getenv(buildapi.CacheTagEnvVar), "Tag of image cache")
+	flag.StringVar(&previousImage, "previous-image", os.Getenv(buildapi.PreviousImageEnvVar), "Image of the previous build")
+	flag.StringVar(&previousCacheImage, "previous-cache-image", os.Getenv(buildapi.PreviousCacheImageEnvVar), "Cache image of the previous build")
+	flag.StringVar(&buildName, "build-name", os.Getenv(buildapi.BuildNameEnvVar), "Name of the build")
+	flag.StringVar(&buildNamespace, "build-namespace", os.Getenv(buildapi.BuildNamespaceEnvVar), "Namespace of the build")
+	flag.StringVar(&buildUID, "build-uid", os.Getenv(buildapi.BuildUIDEnvVar), "UID of the build")
 	flag.StringVar(&terminationMsgPath, "termination-message-path", os.Getenv(buildapi.TerminationMessagePathEnvVar), "Termination path for build metadata")
 	flag.StringVar(&notaryV1URL, "notary-v1-url", "", "Notary V1 server url")
 	flag.Var(&dockerCredentials, "basic-docker", "Basic authentication for docker of the form 'secretname=git.domain.com'")
@@ -105,18 +121,17 @@ func main() {
 		ImageFetcher: &registry.Client{},
 	}
 
-	if len(report.Image.Tags) == 0 {
-		log.Fatal("no image found in report")
+	buildMetadata, err := metadataRetriever.GetBuildMetadataFromReport(report, cacheTag, keychain)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	builtImageRef := fmt.Sprintf("%s@%s", report.Image.Tags[0], report.Image.Digest)
-
-	buildMetadata, err := metadataRetriever.GetBuildMetadata(builtImageRef, cacheTag, keychain)
+	buildMetadata.CacheMetrics, err = metadataRetriever.GetCacheMetrics(buildMetadata.LatestImage, previousImage, buildMetadata.LatestCacheImage, previousCacheImage, keychain)
 	if err != nil {
-		log.Fatal(err)
+		logger.Printf("Unable to determine cache metrics: %s\n", err)
 	}
 
-	data, err := cnb.CompressBuildMetadata(buildMetadata)
+	data, err := terminationMessage(buildMetadata)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -153,6 +168,75 @@ func main() {
 	logger.Println("Build successful")
 }
 
+// terminationMessage compresses the build metadata for the termination
+// message. Metadata too large for a termination message is written to a
+// ConfigMap that the termination message refers to instead.
+func terminationMessage(buildMetadata *cnb.BuildMetadata) ([]byte, error) {
+	data, err := cnb.CompressBuildMetadata(buildMetadata)
+	if err != cnb.ErrBuildMetadataTooLarge {
+		return data, err
+	}
+
+	configMapName, err := writeResultsConfigMap(buildMetadata)
+	if err == nil {
+		return cnb.CompressBuildMetadata(&cnb.BuildMetadata{ResultsConfigMap: configMapName})
+	}
+	logger.Printf("Unable to write build metadata to ConfigMap: %s\n", err)
+
+	if buildMetadata.ImageLabels == nil {
+		return nil, cnb.ErrBuildMetadataTooLarge
+	}
+
+	logger.Println("Image labels are too large to report, omitting them from build status")
+	buildMetadata.ImageLabels = nil
+	return cnb.CompressBuildMetadata(buildMetadata)
+}
+
+func writeResultsConfigMap(buildMetadata *cnb.BuildMetadata) (string, error) {
+	clusterConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return "", err
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(buildMetadata)
+	if err != nil {
+		return "", err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      buildapi.ResultsConfigMapName(buildName),
+			Namespace: buildNamespace,
+			Labels: map[string]string{
+				buildapi.BuildLabel: buildName,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: buildapi.SchemeGroupVersion.String(),
+					Kind:       "Build",
+					Name:       buildName,
+					UID:        types.UID(buildUID),
+				},
+			},
+		},
+		Data: map[string]string{
+			buildapi.ResultsConfigMapKey: string(data),
+		},
+	}
+
+	ctx := context.Background()
+	_, err = k8sClient.CoreV1().ConfigMaps(buildNamespace).Create(ctx, configMap, metav1.CreateOptions{})
+	if k8s_errors.IsAlreadyExists(err) {
+		_, err = k8sClient.CoreV1().ConfigMaps(buildNamespace).Update(ctx, configMap, metav1.UpdateOptions{})
+	}
+	return configMap.Name, err
+}
+
 func signImage(report platform.ExportReport, keychain authn.Keychain) error {
 	if hasCosign() {
 		cosignSigner := cosign.NewImageSigner(logger, sign.SignCmd)
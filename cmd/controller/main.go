@@ -13,6 +13,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
@@ -43,6 +44,7 @@ import (
 	"github.com/pivotal/kpack/pkg/dockercreds/k8sdockercreds"
 	"github.com/pivotal/kpack/pkg/duckbuilder"
 	"github.com/pivotal/kpack/pkg/git"
+	"github.com/pivotal/kpack/pkg/health"
 	"github.com/pivotal/kpack/pkg/reconciler"
 	"github.com/pivotal/kpack/pkg/reconciler/build"
 	"github.com/pivotal/kpack/pkg/reconciler/builder"
@@ -53,7 +55,10 @@ import (
 	"github.com/pivotal/kpack/pkg/reconciler/clusterstore"
 	"github.com/pivotal/kpack/pkg/reconciler/image"
 	"github.com/pivotal/kpack/pkg/reconciler/lifecycle"
+	"github.com/pivotal/kpack/pkg/reconciler/promotion"
 	"github.com/pivotal/kpack/pkg/reconciler/sourceresolver"
+	"github.com/pivotal/kpack/pkg/reconciler/stack"
+	"github.com/pivotal/kpack/pkg/reconciler/store"
 	"github.com/pivotal/kpack/pkg/registry"
 )
 
@@ -84,6 +89,12 @@ var (
 	maximumPlatformApiVersion = flag.String("maximum-platform-api-version", os.Getenv("MAXIMUM_PLATFORM_API_VERSION"), "The maximum allowed platform api version a build can utilize")
 	buildWaiterImage          = flag.String("build-waiter-image", os.Getenv("BUILD_WAITER_IMAGE"), "The image used to initialize a build")
 	injectedSidecarSupport    = flag.Bool("injected-sidecar-support", getEnvBool("INJECTED_SIDECAR_SUPPORT", false), "if set to true, all builds will execute in standard containers instead of init containers to support injected sidecars")
+	imageRepositoryPrefix     = flag.String("image-repository-prefix", os.Getenv("IMAGE_REPOSITORY_PREFIX"), "The repository prefix helper and lifecycle images are relocated to for air-gapped installs")
+	verifyImageDigests        = flag.Bool("verify-image-digests", getEnvBool("VERIFY_IMAGE_DIGESTS", false), "if set to true, the controller fails to start unless every helper image is pinned to a digest that exists in its registry")
+	prioritizeFirstBuilds     = flag.Bool("prioritize-first-builds", getEnvBool("PRIORITIZE_FIRST_BUILDS", true), "if set to true, the first build of an image is started before other builds queued by a build quota")
+	healthProbePort           = flag.Int("health-probe-port", health.DefaultPort, "The port /healthz and /readyz are served on")
+	serviceAccountName        = flag.String("service-account-name", os.Getenv("SERVICE_ACCOUNT_NAME"), "The service account of the controller, used to verify that registry keychains can be created")
+	enableBuildDeduplication  = flag.Bool("enable-build-deduplication", getEnvBool("ENABLE_BUILD_DEDUPLICATION", false), "if set to true, builds reuse the image of a successful build in the same namespace with identical inputs")
 )
 
 func main() {
@@ -127,6 +138,13 @@ func main() {
 	clusterBuildpackInformer := informerFactory.Kpack().V1alpha2().ClusterBuildpacks()
 	clusterStoreInformer := informerFactory.Kpack().V1alpha2().ClusterStores()
 	clusterStackInformer := informerFactory.Kpack().V1alpha2().ClusterStacks()
+	promotionInformer := informerFactory.Kpack().V1alpha2().Promotions()
+	storeInformer := informerFactory.Kpack().V1alpha2().Stores()
+	stackInformer := informerFactory.Kpack().V1alpha2().Stacks()
+	builderGrantInformer := informerFactory.Kpack().V1alpha2().BuilderGrants()
+	buildQuotaInformer := informerFactory.Kpack().V1alpha2().BuildQuotas()
+	buildTemplateInformer := informerFactory.Kpack().V1alpha2().BuildTemplates()
+	clusterBuildTemplateInformer := informerFactory.Kpack().V1alpha2().ClusterBuildTemplates()
 
 	duckBuilderInformer := &duckbuilder.DuckBuilderInformer{
 		BuilderInformer:        builderInformer,
@@ -136,6 +154,7 @@ func main() {
 	k8sInformerFactory := informers.NewSharedInformerFactory(k8sClient, options.ResyncPeriod)
 	pvcInformer := k8sInformerFactory.Core().V1().PersistentVolumeClaims()
 	podInformer := k8sInformerFactory.Core().V1().Pods()
+	networkPolicyInformer := k8sInformerFactory.Networking().V1().NetworkPolicies()
 	keychainFactory, err := k8sdockercreds.NewSecretKeychainFactory(k8sClient)
 	if err != nil {
 		log.Fatalf("could not create k8s keychain factory: %s", err)
@@ -149,6 +168,14 @@ func main() {
 		}),
 	)
 	lifecycleConfigmapInformer := lifecycleConfigmapInformerFactory.Core().V1().ConfigMaps()
+	networkPolicyConfigmapInformerFactory := informers.NewSharedInformerFactoryWithOptions(
+		k8sClient,
+		options.ResyncPeriod,
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fmt.Sprintf("metadata.name=%s", config.BuildNetworkPolicyConfigName)
+		}),
+	)
+	networkPolicyConfigmapInformer := networkPolicyConfigmapInformerFactory.Core().V1().ConfigMaps()
 
 	metadataRetriever := &cnb.RemoteMetadataRetriever{
 		ImageFetcher: &registry.Client{},
@@ -164,8 +191,8 @@ func main() {
 		log.Fatalf("could not resolve provided maximum platform api version: %s", err)
 	}
 
-	buildpodGenerator := &buildpod.Generator{
-		BuildPodConfig: buildapi.BuildPodImages{
+	kpackConfigDefaults, err := config.ParseKpackConfig(&corev1.ConfigMap{}, config.KpackConfig{
+		BuildPodImages: buildapi.BuildPodImages{
 			BuildInitImage:         *buildInitImage,
 			BuildWaiterImage:       *buildWaiterImage,
 			CompletionImage:        *completionImage,
@@ -173,15 +200,55 @@ func main() {
 			BuildInitWindowsImage:  *buildInitWindowsImage,
 			CompletionWindowsImage: *completionWindowsImage,
 		},
+		FeatureGates: config.FeatureGates{
+			EnablePriorityClasses:    *enablePriorityClasses,
+			InjectedSidecarSupport:   *injectedSidecarSupport,
+			EnableBuildDeduplication: *enableBuildDeduplication,
+			PrioritizeFirstBuilds:    *prioritizeFirstBuilds,
+		},
+		ImageRepositoryPrefix: *imageRepositoryPrefix,
+	})
+	if err != nil {
+		log.Fatalf("invalid controller config: %s", err)
+	}
+
+	if *verifyImageDigests {
+		keychain, err := keychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{})
+		if err != nil {
+			log.Fatalf("could not create keychain to verify images: %s", err)
+		}
+
+		for _, image := range kpackConfigDefaults.Images() {
+			if err := (&registry.Client{}).VerifyDigest(keychain, image); err != nil {
+				log.Fatalf("could not verify image %s: %s", image, err)
+			}
+		}
+	}
+
+	kpackConfig := config.NewKpackConfigStore(kpackConfigDefaults)
+	configMapWatcher.WatchWithDefault(corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.KpackConfigName,
+			Namespace: system.Namespace(),
+		},
+	}, func(cm *corev1.ConfigMap) {
+		if err := kpackConfig.Update(cm); err != nil {
+			logger.Errorw("Error updating kpack config", zap.Error(err))
+		}
+	})
+
+	buildpodGenerator := &buildpod.Generator{
+		KpackConfig:               kpackConfig,
 		K8sClient:                 k8sClient,
 		KeychainFactory:           keychainFactory,
 		ImageFetcher:              &registry.Client{},
 		DynamicClient:             dynamicClient,
 		MaximumPlatformApiVersion: maxPlatformApi,
-		InjectedSidecarSupport:    *injectedSidecarSupport,
+		SystemNamespace:           system.Namespace(),
+		KpackVersion:              cmd.Identifer,
 	}
 
-	gitResolver := git.NewResolver(k8sClient)
+	gitResolver := git.NewResolver(k8sClient, system.Namespace())
 	blobResolver := &blob.Resolver{}
 	registryResolver := &registry.Resolver{}
 
@@ -193,7 +260,7 @@ func main() {
 		RegistryClient: &registry.Client{},
 	}
 
-	lifecycleProvider := config.NewLifecycleProvider(&registry.Client{}, keychainFactory)
+	lifecycleProvider := config.NewLifecycleProvider(&registry.Client{}, keychainFactory, kpackConfig)
 
 	builderCreator := &cnb.RemoteBuilderCreator{
 		RegistryClient:    &registry.Client{},
@@ -202,15 +269,18 @@ func main() {
 		KeychainFactory:   keychainFactory,
 	}
 
-	buildController := build.NewController(ctx, options, k8sClient, buildInformer, podInformer, metadataRetriever, buildpodGenerator, keychainFactory, *injectedSidecarSupport)
-	imageController := image.NewController(ctx, options, k8sClient, imageInformer, buildInformer, duckBuilderInformer, sourceResolverInformer, pvcInformer, *enablePriorityClasses)
+	buildController := build.NewController(ctx, options, k8sClient, buildInformer, podInformer, pvcInformer, buildQuotaInformer, metadataRetriever, buildpodGenerator, keychainFactory, &registry.Client{}, &registry.Client{}, kpackConfig)
+	imageController := image.NewController(ctx, options, k8sClient, imageInformer, buildInformer, duckBuilderInformer, sourceResolverInformer, builderGrantInformer, buildQuotaInformer, buildTemplateInformer, clusterBuildTemplateInformer, pvcInformer, networkPolicyInformer, networkPolicyConfigmapInformer, keychainFactory, &registry.Client{}, &registry.Client{}, kpackConfig)
 	sourceResolverController := sourceresolver.NewController(ctx, options, sourceResolverInformer, gitResolver, blobResolver, registryResolver)
-	builderController, builderResync := builder.NewController(ctx, options, builderInformer, builderCreator, keychainFactory, clusterStoreInformer, buildpackInformer, clusterBuildpackInformer, clusterStackInformer)
+	builderController, builderResync := builder.NewController(ctx, options, builderInformer, builderCreator, keychainFactory, clusterStoreInformer, buildpackInformer, clusterBuildpackInformer, clusterStackInformer, storeInformer, stackInformer)
 	buildpackController := buildpack.NewController(ctx, options, keychainFactory, buildpackInformer, remoteStoreReader)
-	clusterBuilderController, clusterBuilderResync := clusterbuilder.NewController(ctx, options, clusterBuilderInformer, builderCreator, keychainFactory, clusterStoreInformer, clusterBuildpackInformer, clusterStackInformer)
+	clusterBuilderController, clusterBuilderResync := clusterbuilder.NewController(ctx, options, clusterBuilderInformer, builderCreator, keychainFactory, clusterStoreInformer, clusterBuildpackInformer, clusterStackInformer, buildInformer)
 	clusterBuildpackController := clusterbuildpack.NewController(ctx, options, keychainFactory, clusterBuildpackInformer, remoteStoreReader)
 	clusterStoreController := clusterstore.NewController(ctx, options, keychainFactory, clusterStoreInformer, remoteStoreReader)
 	clusterStackController := clusterstack.NewController(ctx, options, keychainFactory, clusterStackInformer, remoteStackReader)
+	storeController := store.NewController(ctx, options, keychainFactory, storeInformer, remoteStoreReader)
+	stackController := stack.NewController(ctx, options, keychainFactory, stackInformer, remoteStackReader)
+	promotionController := promotion.NewController(ctx, options, keychainFactory, promotionInformer, buildInformer, &registry.Client{})
 	lifecycleController := lifecycle.NewController(ctx, options, k8sClient, config.LifecycleConfigName, lifecycleConfigmapInformer, lifecycleProvider)
 
 	lifecycleProvider.AddEventHandler(builderResync)
@@ -220,21 +290,46 @@ func main() {
 	informerFactory.Start(stopChan)
 	k8sInformerFactory.Start(stopChan)
 	lifecycleConfigmapInformerFactory.Start(stopChan)
+	networkPolicyConfigmapInformerFactory.Start(stopChan)
 
-	waitForSync(stopChan,
+	syncedInformers := []cache.SharedIndexInformer{
 		buildInformer.Informer(),
 		imageInformer.Informer(),
 		sourceResolverInformer.Informer(),
 		pvcInformer.Informer(),
 		podInformer.Informer(),
 		lifecycleConfigmapInformer.Informer(),
+		networkPolicyInformer.Informer(),
+		networkPolicyConfigmapInformer.Informer(),
 		builderInformer.Informer(),
 		buildpackInformer.Informer(),
 		clusterBuilderInformer.Informer(),
 		clusterBuildpackInformer.Informer(),
 		clusterStoreInformer.Informer(),
 		clusterStackInformer.Informer(),
-	)
+		promotionInformer.Informer(),
+		storeInformer.Informer(),
+		stackInformer.Informer(),
+		builderGrantInformer.Informer(),
+		buildQuotaInformer.Informer(),
+		buildTemplateInformer.Informer(),
+		clusterBuildTemplateInformer.Informer(),
+	}
+
+	checker := &health.Checker{}
+	checker.AddReadinessCheck("informers", health.InformersSynced(syncedInformers...))
+	checker.AddReadinessCheck("keychain", health.KeychainAvailable(keychainFactory, registry.SecretRef{
+		ServiceAccount: *serviceAccountName,
+		Namespace:      system.Namespace(),
+	}))
+	healthServer := checker.NewServer(*healthProbePort)
+	go func() {
+		if err := healthServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Fatalw("Error serving health probes", zap.Error(err))
+		}
+	}()
+
+	waitForSync(stopChan, syncedInformers...)
 
 	err = runGroup(
 		ctx,
@@ -247,7 +342,10 @@ func main() {
 		run(clusterBuildpackController, routinesPerController),
 		run(clusterStoreController, routinesPerController),
 		run(lifecycleController, routinesPerController),
+		run(promotionController, routinesPerController),
 		run(sourceResolverController, 2*routinesPerController),
+		run(storeController, routinesPerController),
+		run(stackController, routinesPerController),
 		func(ctx context.Context) error {
 			return configMapWatcher.Start(ctx.Done())
 		},
@@ -258,6 +356,10 @@ func main() {
 			<-ctx.Done()
 			return profilingServer.Shutdown(ctx)
 		},
+		func(ctx context.Context) error {
+			<-ctx.Done()
+			return healthServer.Shutdown(ctx)
+		},
 	)
 	if err != nil && err != http.ErrServerClosed {
 		logger.Fatalw("Error running controller", zap.Error(err))
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/tools/clientcmd"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	"github.com/pivotal/kpack/pkg/loadtest"
+)
+
+var (
+	kubeconfig        = flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
+	masterURL         = flag.String("master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
+	namespace         = flag.String("namespace", "default", "The namespace to create the images in")
+	images            = flag.Int("images", 10, "The number of images to create")
+	builderKind       = flag.String("builder-kind", buildapi.ClusterBuilderKind, "The kind of builder the images use")
+	builderName       = flag.String("builder-name", "", "The name of the builder the images use")
+	serviceAccount    = flag.String("service-account", "default", "The service account the images use")
+	repository        = flag.String("repository", "", "The repository the image tags are created in")
+	sourceURL         = flag.String("source-url", "", "The blob source url of every image")
+	waitForCompletion = flag.Bool("wait-for-completion", false, "Wait for the builds to finish instead of only being scheduled")
+	timeout           = flag.Duration("timeout", 10*time.Minute, "The maximum time to wait for the images")
+	pollInterval      = flag.Duration("poll-interval", time.Second, "How often builds are observed")
+	cleanup           = flag.Bool("cleanup", true, "Delete the images after the run")
+	serveAddr         = flag.String("serve-addr", "", "Serve a fake registry on / and a fake blob source on /source.tar.gz at this address")
+)
+
+func main() {
+	flag.Parse()
+
+	if *serveAddr != "" {
+		go serveFakes(*serveAddr)
+	}
+
+	clusterConfig, err := clientcmd.BuildConfigFromFlags(*masterURL, *kubeconfig)
+	if err != nil {
+		log.Fatalf("Error building kubeconfig: %v", err)
+	}
+
+	client, err := versioned.NewForConfig(clusterConfig)
+	if err != nil {
+		log.Fatalf("Error building kpack clientset: %v", err)
+	}
+
+	runner := &loadtest.Runner{
+		Client: client,
+		Config: loadtest.Config{
+			RunID:      strconv.FormatInt(time.Now().Unix(), 10),
+			Namespace:  *namespace,
+			Images:     *images,
+			Repository: *repository,
+			Spec: buildapi.ImageSpec{
+				Builder: corev1.ObjectReference{
+					Kind: *builderKind,
+					Name: *builderName,
+				},
+				ServiceAccountName: *serviceAccount,
+				Source: corev1alpha1.SourceConfig{
+					Blob: &corev1alpha1.Blob{URL: *sourceURL},
+				},
+			},
+			WaitForCompletion: *waitForCompletion,
+			PollInterval:      *pollInterval,
+			Timeout:           *timeout,
+		},
+	}
+
+	ctx := context.Background()
+	report, err := runner.Run(ctx)
+	if *cleanup {
+		if err := runner.Cleanup(ctx); err != nil {
+			log.Printf("Error cleaning up images: %v", err)
+		}
+	}
+	if err != nil {
+		log.Fatalf("Error running load test: %v", err)
+	}
+
+	if err := report.Write(os.Stdout); err != nil {
+		log.Fatalf("Error writing report: %v", err)
+	}
+}
+
+func serveFakes(addr string) {
+	source, err := loadtest.FakeSourceServer()
+	if err != nil {
+		log.Fatalf("Error creating fake source server: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", loadtest.FakeRegistry())
+	mux.Handle("/source.tar.gz", source)
+
+	log.Fatal(http.ListenAndServe(addr, mux))
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"knative.dev/pkg/system"
+
+	"github.com/pivotal/kpack/pkg/health"
+)
+
+var checker = &health.Checker{}
+
+// startHealthServer serves /healthz and /readyz next to the webhook. The
+// webhook is only ready while its serving certificate is valid.
+func startHealthServer(ctx context.Context, cfg *rest.Config, secretName string) {
+	checker.AddReadinessCheck("certificate", health.CertificateValid(kubernetes.NewForConfigOrDie(cfg), system.Namespace(), secretName, time.Now))
+
+	server := checker.NewServer(health.DefaultPort)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("error serving health probes: %s", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+}
+
+// addInformersReadinessCheck reports the webhook as ready once the caches
+// used to default and validate resources are synced.
+func addInformersReadinessCheck(ctx context.Context) {
+	kpackInformers := getKpackInformers(ctx).Kpack().V1alpha2()
+
+	checker.AddReadinessCheck("informers", health.InformersSynced(
+		getStorageClassInformer(ctx).Informer(),
+		kpackInformers.ImageDefaults().Informer(),
+		kpackInformers.ClusterStacks().Informer(),
+		kpackInformers.ClusterStores().Informer(),
+		kpackInformers.ClusterBuildpacks().Informer(),
+	))
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+func init() {
+	injection.Default.RegisterInformer(withImageDefaultsInformer)
+}
+
+// withImageDefaultsLookup infuses the context with a lookup of the
+// ImageDefaults that are merged into Images when they are defaulted.
+func withImageDefaultsLookup(ctx context.Context) func(context.Context) context.Context {
+	imageDefaultsLister := getKpackInformers(ctx).Kpack().V1alpha2().ImageDefaults().Lister()
+
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, v1alpha2.ImageDefaultsLookup, v1alpha2.ImageDefaultsLookupFunc(func(namespace string) []*v1alpha2.ImageDefaults {
+			defaults, err := imageDefaultsLister.ImageDefaults(namespace).List(labels.Everything())
+			if err != nil {
+				logging.FromContext(ctx).Warnf("failed to list image defaults in %s: %s", namespace, err)
+				return nil
+			}
+			return defaults
+		}))
+	}
+}
+
+func withImageDefaultsInformer(ctx context.Context) (context.Context, controller.Informer) {
+	inf := getKpackInformers(ctx).Kpack().V1alpha2().ImageDefaults()
+	return ctx, inf.Informer()
+}
@@ -16,7 +16,6 @@ import (
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/signals"
 	"knative.dev/pkg/webhook"
-	"knative.dev/pkg/webhook/certificates"
 	"knative.dev/pkg/webhook/resourcesemantics"
 	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
@@ -24,33 +23,47 @@ import (
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/webhookcert"
 )
 
 var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
-	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ImageKind):            &v1alpha2.Image{},
-	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuildKind):            &v1alpha2.Build{},
-	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuilderKind):          &v1alpha2.Builder{},
-	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuildpackKind):        &v1alpha2.Buildpack{},
-	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterBuilderKind):   &v1alpha2.ClusterBuilder{},
-	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterBuildpackKind): &v1alpha2.ClusterBuildpack{},
-	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterStoreKind):     &v1alpha2.ClusterStore{},
-	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterStackKind):     &v1alpha2.ClusterStack{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ImageKind):                &v1alpha2.Image{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ImageDefaultsKind):        &v1alpha2.ImageDefaults{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuildKind):                &v1alpha2.Build{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuildQuotaKind):           &v1alpha2.BuildQuota{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuildTemplateKind):        &v1alpha2.BuildTemplate{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuilderKind):              &v1alpha2.Builder{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuilderGrantKind):         &v1alpha2.BuilderGrant{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuildpackKind):            &v1alpha2.Buildpack{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterBuilderKind):       &v1alpha2.ClusterBuilder{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterBuildTemplateKind): &v1alpha2.ClusterBuildTemplate{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterBuildpackKind):     &v1alpha2.ClusterBuildpack{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterStoreKind):         &v1alpha2.ClusterStore{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterStackKind):         &v1alpha2.ClusterStack{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.PromotionKind):            &v1alpha2.Promotion{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.StoreKind):                &v1alpha2.Store{},
+	v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.StackKind):                &v1alpha2.Stack{},
 }
 
 func init() {
 	injection.Default.RegisterInformer(withStorageClassInformer)
 }
 
+const webhookSecretName = "webhook-certs"
+
 func main() {
 	ctx := webhook.WithOptions(signals.NewContext(), webhook.Options{
 		ServiceName: "kpack-webhook",
 		Port:        8443,
-		SecretName:  "webhook-certs",
+		SecretName:  webhookSecretName,
 	})
 
+	cfg := injection.ParseAndGetRESTConfigOrDie()
+	startHealthServer(ctx, cfg, webhookSecretName)
+
 	sharedmain.WebhookMainWithConfig(ctx, "webhook",
-		injection.ParseAndGetRESTConfigOrDie(),
-		certificates.NewController,
+		cfg,
+		webhookcert.NewController,
 		defaultingAdmissionController,
 		validatingAdmissionController,
 		conversionController,
@@ -59,6 +72,8 @@ func main() {
 
 func defaultingAdmissionController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
 	storageClassLister := getStorageClassInformer(ctx).Lister()
+	withImageDefaults := withImageDefaultsLookup(ctx)
+	addInformersReadinessCheck(ctx)
 
 	return defaulting.NewAdmissionController(ctx,
 		// Name of the resource webhook.
@@ -68,7 +83,9 @@ func defaultingAdmissionController(ctx context.Context, _ configmap.Watcher) *co
 		// The resources to default.
 		types,
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
-		withCheckDefaultStorageClass(storageClassLister),
+		func(ctx context.Context) context.Context {
+			return withImageDefaults(withCheckDefaultStorageClass(storageClassLister)(ctx))
+		},
 		// Whether to disallow unknown fields.
 		false,
 	)
@@ -76,6 +93,7 @@ func defaultingAdmissionController(ctx context.Context, _ configmap.Watcher) *co
 
 func validatingAdmissionController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
 	storageClassLister := getStorageClassInformer(ctx).Lister()
+	withStackCompatibility := withStackCompatibilityValidator(ctx)
 
 	return validation.NewAdmissionController(ctx,
 		// Name of the resource webhook.
@@ -85,7 +103,9 @@ func validatingAdmissionController(ctx context.Context, _ configmap.Watcher) *co
 		// The resources to validate.
 		types,
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
-		withCheckDefaultStorageClass(storageClassLister),
+		func(ctx context.Context) context.Context {
+			return withStackCompatibility(withCheckDefaultStorageClass(storageClassLister)(ctx))
+		},
 		// Whether to disallow unknown fields.
 		true,
 	)
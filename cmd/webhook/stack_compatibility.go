@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	"github.com/pivotal/kpack/pkg/client/informers/externalversions"
+	buildlisters "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/cnb"
+)
+
+func init() {
+	injection.Default.RegisterInformer(withClusterStackInformer)
+	injection.Default.RegisterInformer(withClusterStoreInformer)
+	injection.Default.RegisterInformer(withClusterBuildpackInformer)
+}
+
+// withStackCompatibilityValidator infuses the context with a validator that
+// rejects ClusterBuilders whose buildpacks do not support their ClusterStack.
+// Builders are admitted when the stack or store is not yet resolved.
+func withStackCompatibilityValidator(ctx context.Context) func(context.Context) context.Context {
+	clusterStackLister := getKpackInformers(ctx).Kpack().V1alpha2().ClusterStacks().Lister()
+	clusterStoreLister := getKpackInformers(ctx).Kpack().V1alpha2().ClusterStores().Lister()
+	clusterBuildpackLister := getKpackInformers(ctx).Kpack().V1alpha2().ClusterBuildpacks().Lister()
+
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, v1alpha2.StackCompatibilityValidator, v1alpha2.StackCompatibilityValidatorFunc(func(spec *v1alpha2.BuilderSpec) *apis.FieldError {
+			return validateStackCompatibility(ctx, spec, clusterStackLister, clusterStoreLister, clusterBuildpackLister)
+		}))
+	}
+}
+
+func validateStackCompatibility(ctx context.Context, spec *v1alpha2.BuilderSpec, clusterStackLister buildlisters.ClusterStackLister, clusterStoreLister buildlisters.ClusterStoreLister, clusterBuildpackLister buildlisters.ClusterBuildpackLister) *apis.FieldError {
+	if spec.Stack.Kind != v1alpha2.ClusterStackKind {
+		return nil
+	}
+
+	clusterStack, err := clusterStackLister.Get(spec.Stack.Name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logging.FromContext(ctx).Warnf("failed to get cluster stack %s: %s", spec.Stack.Name, err)
+		}
+		return nil
+	}
+	if clusterStack.Status.Id == "" {
+		return nil
+	}
+
+	var clusterStore *v1alpha2.ClusterStore
+	if spec.Store.Name != "" {
+		clusterStore, err = clusterStoreLister.Get(spec.Store.Name)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				logging.FromContext(ctx).Warnf("failed to get cluster store %s: %s", spec.Store.Name, err)
+			}
+			return nil
+		}
+	}
+
+	clusterBuildpacks, err := clusterBuildpackLister.List(labels.Everything())
+	if err != nil {
+		logging.FromContext(ctx).Warnf("failed to list cluster buildpacks: %s", err)
+		return nil
+	}
+
+	resolver := cnb.NewBuildpackResolver(clusterStore, nil, clusterBuildpacks)
+	return cnb.ValidateStackCompatibility(resolver, clusterStack.Status.ResolvedClusterStack, spec.Order)
+}
+
+var (
+	kpackInformersOnce sync.Once
+	kpackInformers     externalversions.SharedInformerFactory
+)
+
+// getKpackInformers returns the informer factory shared by the kpack
+// informers registered with injection.
+func getKpackInformers(ctx context.Context) externalversions.SharedInformerFactory {
+	kpackInformersOnce.Do(func() {
+		client := versioned.NewForConfigOrDie(injection.GetConfig(ctx))
+		kpackInformers = externalversions.NewSharedInformerFactory(client, controller.GetResyncPeriod(ctx))
+	})
+	return kpackInformers
+}
+
+func withClusterStackInformer(ctx context.Context) (context.Context, controller.Informer) {
+	inf := getKpackInformers(ctx).Kpack().V1alpha2().ClusterStacks()
+	return ctx, inf.Informer()
+}
+
+func withClusterStoreInformer(ctx context.Context) (context.Context, controller.Informer) {
+	inf := getKpackInformers(ctx).Kpack().V1alpha2().ClusterStores()
+	return ctx, inf.Informer()
+}
+
+func withClusterBuildpackInformer(ctx context.Context) (context.Context, controller.Informer) {
+	inf := getKpackInformers(ctx).Kpack().V1alpha2().ClusterBuildpacks()
+	return ctx, inf.Informer()
+}
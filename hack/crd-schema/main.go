@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// crd-schema replaces the openAPIV3Schema of every kpack CRD in the config
+// directory with a structural schema generated from the swagger definitions.
+//
+// usage: go run ./hack/crd-schema <swagger.json> <config dir>
+//
+// Required fields are not carried over. openapi-gen marks every field without
+// omitempty as required which rejects resources the webhook accepts.
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: crd-schema <swagger.json> <config dir>")
+	}
+
+	buf, err := ioutil.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var swagger struct {
+		Definitions map[string]map[string]interface{} `json:"definitions"`
+	}
+	if err := json.Unmarshal(buf, &swagger); err != nil {
+		log.Fatal(err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(os.Args[2], "*.yaml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, file := range files {
+		if err := updateCRD(file, swagger.Definitions); err != nil {
+			log.Fatalf("%s: %s", file, err)
+		}
+	}
+}
+
+var (
+	kindRegexp    = regexp.MustCompile(`(?m)^  names:\n(?:    .*\n)*?    kind: (\w+)$`)
+	versionRegexp = regexp.MustCompile(`^  - name: (\w+)$`)
+	schemaRegexp  = regexp.MustCompile(`^( *)openAPIV3Schema:$`)
+)
+
+func updateCRD(file string, definitions map[string]map[string]interface{}) error {
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	if !strings.Contains(string(buf), "openAPIV3Schema:") {
+		return nil
+	}
+
+	match := kindRegexp.FindStringSubmatch(string(buf))
+	if match == nil {
+		return fmt.Errorf("could not find CRD kind")
+	}
+	kind := match[1]
+
+	var (
+		lines   = strings.Split(string(buf), "\n")
+		out     = make([]string, 0, len(lines))
+		version string
+	)
+	for i := 0; i < len(lines); i++ {
+		out = append(out, lines[i])
+
+		if match := versionRegexp.FindStringSubmatch(lines[i]); match != nil {
+			version = match[1]
+			continue
+		}
+
+		match := schemaRegexp.FindStringSubmatch(lines[i])
+		if match == nil {
+			continue
+		}
+
+		name := fmt.Sprintf("kpack.build.%s.%s", version, kind)
+		root, ok := definitions[name]
+		if !ok {
+			return fmt.Errorf("no definition for %s", name)
+		}
+
+		g := &generator{definitions: definitions, seen: map[string]bool{name: true}}
+		w := &yamlWriter{}
+		w.writeMap(g.root(root), len(match[1])+2, false)
+		out = append(out, strings.Split(strings.TrimSuffix(w.String(), "\n"), "\n")...)
+
+		for i+1 < len(lines) && indentation(lines[i+1]) > len(match[1]) {
+			i++
+		}
+	}
+
+	return ioutil.WriteFile(file, []byte(strings.Join(out, "\n")), 0644)
+}
+
+func indentation(line string) int {
+	if strings.TrimSpace(line) == "" {
+		return 0
+	}
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+const definitionPrefix = "#/definitions/"
+
+type generator struct {
+	definitions map[string]map[string]interface{}
+	seen        map[string]bool
+}
+
+func (g *generator) root(definition map[string]interface{}) map[string]interface{} {
+	schema := g.structural(definition)
+
+	properties := schema["properties"].(map[string]interface{})
+	properties["metadata"] = map[string]interface{}{"type": "object"}
+
+	return schema
+}
+
+func (g *generator) structural(schema map[string]interface{}) map[string]interface{} {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved := g.resolve(strings.TrimPrefix(ref, definitionPrefix))
+		if description, ok := schema["description"]; ok {
+			resolved["description"] = description
+		}
+		return resolved
+	}
+
+	out := map[string]interface{}{}
+	for key, value := range schema {
+		switch key {
+		case "description", "type", "format", "enum", "pattern", "minimum", "maximum", "minItems", "maxItems", "minLength", "maxLength", "x-kubernetes-map-type", "x-kubernetes-list-map-keys":
+			out[key] = value
+		case "default":
+			if !isZero(value) {
+				out[key] = value
+			}
+		case "x-kubernetes-list-type":
+			if value != "" {
+				out[key] = value
+			}
+		case "items":
+			out[key] = g.structural(value.(map[string]interface{}))
+		case "additionalProperties":
+			if additional, ok := value.(map[string]interface{}); ok {
+				out[key] = g.structural(additional)
+			}
+		case "properties":
+			properties := map[string]interface{}{}
+			for name, property := range value.(map[string]interface{}) {
+				properties[name] = g.structural(property.(map[string]interface{}))
+			}
+			out[key] = properties
+		}
+	}
+
+	if out["type"] == "object" && out["properties"] == nil && out["additionalProperties"] == nil {
+		out["x-kubernetes-preserve-unknown-fields"] = true
+	}
+
+	return out
+}
+
+func (g *generator) resolve(name string) map[string]interface{} {
+	definition := g.definitions[name]
+
+	switch name {
+	case "io.k8s.apimachinery.pkg.api.resource.Quantity", "io.k8s.apimachinery.pkg.util.intstr.IntOrString":
+		return map[string]interface{}{
+			"anyOf": []interface{}{
+				map[string]interface{}{"type": "integer"},
+				map[string]interface{}{"type": "string"},
+			},
+			"x-kubernetes-int-or-string": true,
+		}
+	case "io.k8s.apimachinery.pkg.apis.meta.v1.Time", "io.k8s.apimachinery.pkg.apis.meta.v1.MicroTime":
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta":
+		return map[string]interface{}{"type": "object"}
+	}
+
+	if definition == nil {
+		log.Fatalf("no definition for %s", name)
+	}
+
+	if g.seen[name] {
+		return map[string]interface{}{"type": "object", "x-kubernetes-preserve-unknown-fields": true}
+	}
+
+	g.seen[name] = true
+	defer delete(g.seen, name)
+
+	return g.structural(definition)
+}
+
+func isZero(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+type yamlWriter struct {
+	strings.Builder
+}
+
+// writeMap writes m with its keys sorted. When inline is set the first key
+// continues the current line, as it does for the first key of a list item.
+func (w *yamlWriter) writeMap(m map[string]interface{}, indent int, inline bool) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for i, key := range keys {
+		if i > 0 || !inline {
+			w.WriteString(strings.Repeat(" ", indent))
+		}
+		w.WriteString(scalar(key) + ":")
+		w.writeValue(m[key], indent)
+	}
+}
+
+func (w *yamlWriter) writeList(l []interface{}, indent int) {
+	for _, item := range l {
+		w.WriteString(strings.Repeat(" ", indent) + "-")
+		if m, ok := item.(map[string]interface{}); ok && len(m) > 0 {
+			w.WriteString(" ")
+			w.writeMap(m, indent+2, true)
+			continue
+		}
+		w.writeValue(item, indent)
+	}
+}
+
+func (w *yamlWriter) writeValue(value interface{}, indent int) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			w.WriteString(" {}\n")
+			return
+		}
+		w.WriteString("\n")
+		w.writeMap(v, indent+2, false)
+	case []interface{}:
+		if len(v) == 0 {
+			w.WriteString(" []\n")
+			return
+		}
+		w.WriteString("\n")
+		w.writeList(v, indent)
+	default:
+		w.WriteString(" " + scalar(v) + "\n")
+	}
+}
+
+var plainRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9 .,;/()'_+=-]*$`)
+
+func scalar(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		if plainRegexp.MatchString(v) && !strings.HasSuffix(v, " ") && !reserved[strings.ToLower(v)] {
+			return v
+		}
+		buf := &bytes.Buffer{}
+		encoder := json.NewEncoder(buf)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(v); err != nil {
+			log.Fatal(err)
+		}
+		return strings.TrimSuffix(buf.String(), "\n")
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+var reserved = map[string]bool{
+	"y": true, "n": true, "yes": true, "no": true, "on": true, "off": true,
+	"true": true, "false": true, "null": true,
+}
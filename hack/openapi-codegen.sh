@@ -20,4 +20,6 @@ sed -i.old 's/Ref\:         ref(\"github.com\/pivotal\/kpack\/pkg\/apis\/core\/v
 
 go run ./hack/openapi/main.go 1> ./api/openapi-spec/swagger.json
 
+go run ./hack/crd-schema ./api/openapi-spec/swagger.json ./config
+
 cd -
@@ -1,6 +1,9 @@
 package v1alpha2
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
 	"github.com/google/go-containerregistry/pkg/name"
@@ -86,6 +89,24 @@ func (b *Build) ImageGeneration() int64 {
 	return int64(atoi)
 }
 
+// SequenceNumber is the number the Image assigned the Build when it was
+// created, falling back to the build number label until the Build has been
+// reconciled.
+func (b *Build) SequenceNumber() int64 {
+	if b == nil {
+		return 0
+	}
+	if b.Status.SequenceNumber != 0 {
+		return b.Status.SequenceNumber
+	}
+
+	number, err := strconv.ParseInt(b.Labels[BuildNumberLabel], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return number
+}
+
 func (b *Build) Stack() string {
 	if b == nil {
 		return ""
@@ -107,6 +128,30 @@ func (b *Build) BuiltImage() string {
 	return b.Status.LatestImage
 }
 
+func (b *Build) PushedImages(latestImage string) []string {
+	digest, err := name.NewDigest(latestImage, name.WeakValidation)
+	if err != nil {
+		return nil
+	}
+
+	var images []string
+	seen := map[string]struct{}{}
+	for _, t := range b.Spec.Tags {
+		tag, err := name.NewTag(t, name.WeakValidation)
+		if err != nil {
+			continue
+		}
+
+		image := tag.Context().Digest(digest.DigestStr()).Name()
+		if _, ok := seen[image]; ok {
+			continue
+		}
+		seen[image] = struct{}{}
+		images = append(images, image)
+	}
+	return images
+}
+
 func (b *Build) CacheImage() string {
 	if b == nil {
 		return ""
@@ -136,6 +181,12 @@ func (b *Build) PodName() string {
 	return kmeta.ChildName(b.Name, "-build-pod")
 }
 
+// ResultsConfigMapName is the name of the ConfigMap the completion step writes
+// build metadata to when it is too large for a termination message.
+func ResultsConfigMapName(buildName string) string {
+	return kmeta.ChildName(buildName, "-results")
+}
+
 func (b *Build) MetadataReady(pod *corev1.Pod) bool {
 	return !b.Status.GetCondition(corev1alpha1.ConditionSucceeded).IsTrue() &&
 		(pod.Status.Phase == corev1.PodSucceeded || podCompletedWithActiveDeadline(pod))
@@ -156,6 +207,52 @@ func (b *Build) DefaultProcess() string {
 	return b.Spec.DefaultProcess
 }
 
+type buildInputs struct {
+	Builder               string                    `json:"builder"`
+	RunImage              string                    `json:"runImage"`
+	Source                corev1alpha1.SourceConfig `json:"source"`
+	Services              Services                  `json:"services,omitempty"`
+	CNBBindings           corev1alpha1.CNBBindings  `json:"cnbBindings,omitempty"`
+	Env                   []corev1.EnvVar           `json:"env,omitempty"`
+	ProjectDescriptorPath string                    `json:"projectDescriptorPath,omitempty"`
+	DefaultProcess        string                    `json:"defaultProcess,omitempty"`
+	CreationTime          string                    `json:"creationTime,omitempty"`
+	RebaseImage           string                    `json:"rebaseImage,omitempty"`
+	ImageMetadata         map[string]string         `json:"imageMetadata,omitempty"`
+}
+
+// InputHash is a content address of everything that determines the image a
+// build produces. Builds with equal input hashes produce equivalent images.
+func (b *Build) InputHash() string {
+	inputs := buildInputs{
+		Builder:               b.Spec.Builder.Image,
+		RunImage:              b.Spec.RunImage.Image,
+		Source:                b.Spec.Source,
+		Services:              b.Spec.Services,
+		CNBBindings:           b.Spec.CNBBindings,
+		Env:                   b.Spec.Env,
+		ProjectDescriptorPath: b.Spec.ProjectDescriptorPath,
+		DefaultProcess:        b.Spec.DefaultProcess,
+		CreationTime:          b.Spec.CreationTime,
+		ImageMetadata:         b.Spec.ImageMetadata,
+	}
+	if b.Spec.LastBuild != nil && b.BuildReason() == BuildReasonStack {
+		inputs.RebaseImage = b.Spec.LastBuild.Image
+	}
+
+	data, err := json.Marshal(inputs)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// Reproducible reports whether the image of an equivalent build may be
+// reused for this build.
+func (b *Build) Reproducible() bool {
+	return b.Spec.CreationTime != "now" && b.Spec.Notary == nil && b.Spec.Cosign == nil
+}
+
 var buildSteps = map[string]struct{}{
 	PrepareContainerName:    {},
 	AnalyzeContainerName:    {},
@@ -0,0 +1,147 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Labels stamped on the exported image so a running container can be traced
+// back to the Build that produced it. They are applied by the image-labels
+// buildpack from the platform env when the builder includes it.
+const (
+	OCISourceLabel         = "org.opencontainers.image.source"
+	OCIRevisionLabel       = "org.opencontainers.image.revision"
+	BuildNameImageLabel    = "io.kpack.build.name"
+	BuildUIDImageLabel     = "io.kpack.build.uid"
+	BuilderImageLabel      = "io.kpack.builder.image"
+	KpackVersionImageLabel = "io.kpack.version"
+
+	// ImageMetadataPath is the file in the image that holds the image
+	// metadata of the build and the labels above.
+	ImageMetadataPath = "/workspace/.kpack/metadata.json"
+
+	kpackImageLabelPrefix = "io.kpack."
+
+	ociSourceEnv     = "BP_OCI_SOURCE"
+	ociRevisionEnv   = "BP_OCI_REVISION"
+	imageLabelsEnv   = "BP_IMAGE_LABELS"
+	imageMetadataEnv = "IMAGE_METADATA"
+)
+
+// billOfInputsEnv returns the platform env describing the inputs of the
+// build. Env the user already set on the build is left untouched.
+func (b *Build) billOfInputsEnv(kpackVersion string) []corev1.EnvVar {
+	source, revision := b.sourceLabels()
+
+	labels := b.imageLabels(kpackVersion)
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var imageLabels []string
+	for _, key := range keys {
+		imageLabels = append(imageLabels, fmt.Sprintf("%s=%q", key, labels[key]))
+	}
+
+	var env []corev1.EnvVar
+	for _, e := range []corev1.EnvVar{
+		{Name: ociSourceEnv, Value: source},
+		{Name: ociRevisionEnv, Value: revision},
+		{Name: imageLabelsEnv, Value: strings.Join(imageLabels, " ")},
+	} {
+		if e.Value == "" || b.hasEnv(e.Name) {
+			continue
+		}
+		e.Name = PlatformEnvVarPrefix + e.Name
+		env = append(env, e)
+	}
+	return env
+}
+
+// sourceLabels returns the values of the OCI source and revision labels.
+func (b *Build) sourceLabels() (source, revision string) {
+	switch {
+	case b.Spec.Source.Git != nil:
+		source = redactURL(b.Spec.Source.Git.URL)
+		revision = b.Spec.Source.Git.Revision
+	case b.Spec.Source.Blob != nil:
+		source = redactURL(b.Spec.Source.Blob.URL)
+	case b.Spec.Source.Registry != nil:
+		source = b.Spec.Source.Registry.Image
+	}
+	return source, revision
+}
+
+// imageLabels returns the image metadata of the build and the labels that
+// trace the image back to the build.
+func (b *Build) imageLabels(kpackVersion string) map[string]string {
+	labels := map[string]string{}
+	for key, value := range b.Spec.ImageMetadata {
+		labels[key] = value
+	}
+
+	for key, value := range map[string]string{
+		BuildNameImageLabel:    b.Name,
+		BuildUIDImageLabel:     string(b.UID),
+		BuilderImageLabel:      b.Spec.Builder.Image,
+		KpackVersionImageLabel: kpackVersion,
+	} {
+		if value != "" {
+			labels[key] = value
+		}
+	}
+	return labels
+}
+
+// imageMetadataEnv returns the env that asks the prepare step to write the
+// image metadata file. It is only written if the build declares image
+// metadata.
+func (b *Build) imageMetadataEnv(kpackVersion string) []corev1.EnvVar {
+	if len(b.Spec.ImageMetadata) == 0 {
+		return nil
+	}
+
+	metadata := b.imageLabels(kpackVersion)
+	source, revision := b.sourceLabels()
+	for key, value := range map[string]string{OCISourceLabel: source, OCIRevisionLabel: revision} {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return nil
+	}
+	return []corev1.EnvVar{{Name: imageMetadataEnv, Value: string(data)}}
+}
+
+func (b *Build) hasEnv(name string) bool {
+	for _, e := range b.Spec.Env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURL removes credentials and query parameters, such as the signature
+// of a presigned blob url, that must not end up in image labels.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme == "" {
+		return rawURL
+	}
+
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
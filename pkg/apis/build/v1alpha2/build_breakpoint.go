@@ -0,0 +1,89 @@
+package v1alpha2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	BreakpointContainerName = "breakpoint"
+	BreakpointContinueFile  = "/tmp/kpack-continue"
+
+	breakpointBefore = "before"
+	breakpointAfter  = "after"
+)
+
+var breakpointSteps = []string{
+	PrepareContainerName,
+	DetectContainerName,
+	AnalyzeContainerName,
+	RestoreContainerName,
+	BuildContainerName,
+	ExportContainerName,
+}
+
+// parseBreakpoint splits a breakpoint such as "before build" or
+// "after detect" into its position and step.
+func parseBreakpoint(breakpoint string) (string, string, error) {
+	fields := strings.Fields(breakpoint)
+	if len(fields) != 2 || (fields[0] != breakpointBefore && fields[0] != breakpointAfter) {
+		return "", "", errors.Errorf("breakpoint must be 'before <step>' or 'after <step>'")
+	}
+
+	for _, step := range breakpointSteps {
+		if fields[1] == step {
+			return fields[0], step, nil
+		}
+	}
+	return "", "", errors.Errorf("breakpoint step must be one of %s", strings.Join(breakpointSteps, ", "))
+}
+
+// useBreakpoint inserts a container next to the breakpoint step that blocks
+// until BreakpointContinueFile is created. It shares the mounts and env of
+// the step so the build can be inspected as that step sees it.
+func (b *Build) useBreakpoint(pod *corev1.Pod) (*corev1.Pod, error) {
+	position, step, err := parseBreakpoint(b.Spec.Breakpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, container := range pod.Spec.InitContainers {
+		if container.Name != step {
+			continue
+		}
+
+		breakpoint := corev1.Container{
+			Name:            BreakpointContainerName,
+			Image:           b.Spec.Builder.Image,
+			Command:         []string{"/bin/sh", "-c"},
+			Args:            []string{breakpointScript(b.Spec.Breakpoint)},
+			Resources:       container.Resources,
+			SecurityContext: container.SecurityContext,
+			Env:             append([]corev1.EnvVar{}, container.Env...),
+			VolumeMounts:    append([]corev1.VolumeMount{}, container.VolumeMounts...),
+			WorkingDir:      "/workspace",
+			ImagePullPolicy: corev1.PullIfNotPresent,
+		}
+
+		index := i
+		if position == breakpointAfter {
+			index = i + 1
+		}
+
+		containers := make([]corev1.Container, 0, len(pod.Spec.InitContainers)+1)
+		containers = append(containers, pod.Spec.InitContainers[:index]...)
+		containers = append(containers, breakpoint)
+		pod.Spec.InitContainers = append(containers, pod.Spec.InitContainers[index:]...)
+		return pod, nil
+	}
+
+	return nil, errors.Errorf("breakpoint step %s is not part of this build", step)
+}
+
+func breakpointScript(breakpoint string) string {
+	return fmt.Sprintf(`echo "paused %s, run 'touch %s' in this container to continue"
+until [ -f %s ]; do sleep 1; done`, breakpoint, BreakpointContinueFile, BreakpointContinueFile)
+}
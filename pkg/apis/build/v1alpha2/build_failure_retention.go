@@ -0,0 +1,170 @@
+package v1alpha2
+
+import (
+	"path"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	retainedWorkspaceVolumeName = "retained-workspace-dir"
+	retainedLayersSubPath       = "layers"
+	retainedWorkspaceSubPath    = "workspace"
+)
+
+var defaultFailureRetentionSize = resource.MustParse("2Gi")
+
+func (b *Build) RetainsFailures() bool {
+	return b.Spec.FailureRetention != nil
+}
+
+func (b *Build) RetainedWorkspaceName() string {
+	return kmeta.ChildName(b.Name, "-workspace")
+}
+
+func (b *Build) DebugPodName() string {
+	return kmeta.ChildName(b.Name, "-debug")
+}
+
+// RetainedUntil returns the time until which a failed build's workspace is
+// kept, measured from when the build failed.
+func (b *Build) RetainedUntil() time.Time {
+	failedAt := b.Status.GetCondition(corev1alpha1.ConditionSucceeded).LastTransitionTime.Inner.Time
+	return failedAt.Add(time.Duration(b.Spec.FailureRetention.TTLSeconds) * time.Second)
+}
+
+func (b *Build) RetainedWorkspace() *corev1.PersistentVolumeClaim {
+	size := defaultFailureRetentionSize
+	if b.Spec.FailureRetention.Size != nil {
+		size = *b.Spec.FailureRetention.Size
+	}
+
+	var storageClassName *string
+	if b.Spec.FailureRetention.StorageClassName != "" {
+		storageClassName = &b.Spec.FailureRetention.StorageClassName
+	}
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.RetainedWorkspaceName(),
+			Namespace: b.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(b),
+			},
+			Labels: combine(b.Labels, map[string]string{
+				BuildLabel: b.Name,
+			}),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+			StorageClassName: storageClassName,
+		},
+	}
+}
+
+// DebugPod returns a pod that mounts the retained workspace and layers of a
+// failed build and idles until activeDeadlineSeconds so they can be inspected
+// with kubectl exec or kubectl cp.
+func (b *Build) DebugPod(activeDeadlineSeconds int64) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.DebugPodName(),
+			Namespace: b.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(b),
+			},
+			Labels: combine(b.Labels, map[string]string{
+				BuildLabel: b.Name,
+			}),
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			ServiceAccountName:    b.Spec.ServiceAccountName,
+			NodeSelector:          b.Spec.NodeSelector,
+			Tolerations:           b.Spec.Tolerations,
+			Affinity:              b.Spec.Affinity,
+			RuntimeClassName:      b.Spec.RuntimeClassName,
+			SchedulerName:         b.Spec.SchedulerName,
+			ImagePullSecrets:      b.Spec.Builder.ImagePullSecrets,
+			Containers: []corev1.Container{
+				{
+					Name:            "debug",
+					Image:           b.Spec.Builder.Image,
+					Command:         []string{"sleep", strconv.FormatInt(activeDeadlineSeconds, 10)},
+					WorkingDir:      "/workspace",
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      retainedWorkspaceVolumeName,
+							MountPath: layersMount.MountPath,
+							SubPath:   retainedLayersSubPath,
+						},
+						{
+							Name:      retainedWorkspaceVolumeName,
+							MountPath: sourceMount.MountPath,
+							SubPath:   retainedWorkspaceSubPath,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				b.retainedWorkspaceVolume(),
+			},
+		},
+	}
+}
+
+// useRetainedWorkspace moves the layers and workspace of a build pod from
+// emptyDirs onto the retained workspace claim so they survive a failure.
+func (b *Build) useRetainedWorkspace(pod *corev1.Pod) *corev1.Pod {
+	retainedSubPath := map[string]string{
+		layersVolumeName:    retainedLayersSubPath,
+		workspaceVolumeName: retainedWorkspaceSubPath,
+	}
+
+	useRetained := func(containers []corev1.Container) {
+		for i := range containers {
+			for j, mount := range containers[i].VolumeMounts {
+				subPath, ok := retainedSubPath[mount.Name]
+				if !ok {
+					continue
+				}
+				containers[i].VolumeMounts[j].Name = retainedWorkspaceVolumeName
+				containers[i].VolumeMounts[j].SubPath = path.Join(subPath, mount.SubPath)
+			}
+		}
+	}
+	useRetained(pod.Spec.InitContainers)
+	useRetained(pod.Spec.Containers)
+
+	volumes := []corev1.Volume{b.retainedWorkspaceVolume()}
+	for _, volume := range pod.Spec.Volumes {
+		if _, ok := retainedSubPath[volume.Name]; !ok {
+			volumes = append(volumes, volume)
+		}
+	}
+	pod.Spec.Volumes = volumes
+	return pod
+}
+
+func (b *Build) retainedWorkspaceVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: retainedWorkspaceVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: b.RetainedWorkspaceName()},
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/pkg/kmeta"
+)
+
+func (im *Image) NetworkPolicyName() string {
+	return kmeta.ChildName(im.Name, "-build")
+}
+
+// EffectiveBuildNetworkPolicy returns the network policy of the image or,
+// when the image does not configure one, the policy of its namespace.
+func (im *Image) EffectiveBuildNetworkPolicy(namespacePolicy *BuildNetworkPolicy) *BuildNetworkPolicy {
+	if im.Spec.Build != nil && im.Spec.Build.NetworkPolicy != nil {
+		return im.Spec.Build.NetworkPolicy
+	}
+	return namespacePolicy
+}
+
+// BuildNetworkPolicy returns the NetworkPolicy that restricts the egress of
+// the build pods of the image to the destinations of policy and DNS.
+func (im *Image) BuildNetworkPolicy(policy *BuildNetworkPolicy) *networkingv1.NetworkPolicy {
+	udp, tcp := corev1.ProtocolUDP, corev1.ProtocolTCP
+	dnsPort := intstr.FromInt(53)
+
+	egress := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: &dnsPort},
+				{Protocol: &tcp, Port: &dnsPort},
+			},
+		},
+	}
+
+	for _, e := range policy.Egress {
+		rule := networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					IPBlock: &networkingv1.IPBlock{
+						CIDR:   e.CIDR,
+						Except: e.Except,
+					},
+				},
+			},
+		}
+		for _, p := range e.Ports {
+			port := intstr.FromInt(int(p))
+			rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{Protocol: &tcp, Port: &port})
+		}
+		egress = append(egress, rule)
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      im.NetworkPolicyName(),
+			Namespace: im.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(im),
+			},
+			Labels: im.Labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					ImageLabel: im.Name,
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress:      egress,
+		},
+	}
+}
@@ -35,9 +35,11 @@ const (
 	cosignDefaultSecretPath          = "/var/build-secrets/cosign/%s"
 	defaultSecretPath                = "/var/build-secrets/%s"
 	ReportTOMLPath                   = "/var/report/report.toml"
+	ResultsConfigMapKey              = "metadata.json"
 
-	BuildLabel = "kpack.io/build"
-	k8sOSLabel = "kubernetes.io/os"
+	BuildLabel   = "kpack.io/build"
+	k8sOSLabel   = "kubernetes.io/os"
+	k8sArchLabel = "kubernetes.io/arch"
 
 	cosignDockerMediaTypesAnnotationPrefix = "kpack.io/cosign.docker-media-types"
 	cosignRespositoryAnnotationPrefix      = "kpack.io/cosign.repository"
@@ -47,6 +49,8 @@ const (
 	BuildReadyAnnotation                   = "build.kpack.io/ready"
 
 	cosignSecretDataCosignKey = "cosign.key"
+	netrcSecretKey            = ".netrc"
+	gitCredentialSecretKey    = "git-credential"
 
 	cacheVolumeName                     = "cache-dir"
 	homeVolumeName                      = "home-dir"
@@ -61,8 +65,16 @@ const (
 	workspaceVolumeName                 = "workspace-dir"
 
 	buildChangesEnvVar           = "BUILD_CHANGES"
+	BuildNameEnvVar              = "BUILD_NAME"
+	BuildNamespaceEnvVar         = "BUILD_NAMESPACE"
+	BuildUIDEnvVar               = "BUILD_UID"
 	CacheTagEnvVar               = "CACHE_TAG"
 	platformApiVersionEnvVarName = "CNB_PLATFORM_API"
+	dereferenceSymlinksEnvVar    = "DEREFERENCE_SYMLINKS"
+	gitKnownHostsEnvVar          = "GIT_KNOWN_HOSTS"
+	insecureGitHostKeysEnvVar    = "INSECURE_SKIP_GIT_HOST_KEY_VERIFICATION"
+	PreviousCacheImageEnvVar     = "PREVIOUS_CACHE_IMAGE"
+	PreviousImageEnvVar          = "PREVIOUS_IMAGE"
 	serviceBindingRootEnvVar     = "SERVICE_BINDING_ROOT"
 	TerminationMessagePathEnvVar = "TERMINATION_MESSAGE_PATH"
 
@@ -111,18 +123,36 @@ func terminationMsgPath(os string) string {
 
 // +k8s:deepcopy-gen=false
 type BuildContext struct {
-	BuildPodBuilderConfig     BuildPodBuilderConfig
-	Secrets                   []corev1.Secret
-	Bindings                  []ServiceBinding
-	ImagePullSecrets          []corev1.LocalObjectReference
-	MaximumPlatformApiVersion *semver.Version
-	InjectedSidecarSupport    bool
+	BuildPodBuilderConfig              BuildPodBuilderConfig
+	Secrets                            []corev1.Secret
+	Bindings                           []ServiceBinding
+	ImagePullSecrets                   []corev1.LocalObjectReference
+	MaximumPlatformApiVersion          *semver.Version
+	InjectedSidecarSupport             bool
+	GitKnownHosts                      string
+	InsecureSkipGitHostKeyVerification bool
+	KpackVersion                       string
 }
 
 func (c BuildContext) os() string {
 	return c.BuildPodBuilderConfig.OS
 }
 
+func (c BuildContext) arch() string {
+	return c.BuildPodBuilderConfig.Arch
+}
+
+func (c BuildContext) gitHostKeyEnv() []corev1.EnvVar {
+	var env []corev1.EnvVar
+	if c.GitKnownHosts != "" {
+		env = append(env, corev1.EnvVar{Name: gitKnownHostsEnvVar, Value: c.GitKnownHosts})
+	}
+	if c.InsecureSkipGitHostKeyVerification {
+		env = append(env, corev1.EnvVar{Name: insecureGitHostKeysEnvVar, Value: "true"})
+	}
+	return env
+}
+
 type BuildPodBuilderConfig struct {
 	StackID      string
 	RunImage     string
@@ -130,6 +160,7 @@ type BuildPodBuilderConfig struct {
 	Gid          int64
 	PlatformAPIs []string
 	OS           string
+	Arch         string
 }
 
 var (
@@ -221,6 +252,13 @@ func (b *Build) BuildPod(images BuildPodImages, buildContext BuildContext) (*cor
 		envVar.Name = PlatformEnvVarPrefix + envVar.Name
 		buildEnv = append(buildEnv, envVar)
 	}
+	buildEnv = append(buildEnv, b.billOfInputsEnv(buildContext.KpackVersion)...)
+	if b.Spec.Source.Git != nil {
+		buildEnv = append(buildEnv, buildContext.gitHostKeyEnv()...)
+	}
+	if b.Spec.Source.DereferenceSymlinks {
+		buildEnv = append(buildEnv, corev1.EnvVar{Name: dereferenceSymlinksEnvVar, Value: "true"})
+	}
 
 	secretVolumes, secretVolumeMounts, secretArgs := b.setupSecretVolumesAndArgs(buildContext.Secrets, gitAndDockerSecrets)
 	cosignVolumes, cosignVolumeMounts, cosignSecretArgs := b.setupCosignVolumes(buildContext.Secrets)
@@ -352,7 +390,8 @@ func (b *Build) BuildPod(images BuildPodImages, buildContext BuildContext) (*cor
 		Env: []corev1.EnvVar{
 			platformApiVersionEnvVar,
 		},
-		SecurityContext: containerSecurityContext(buildContext.BuildPodBuilderConfig),
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		SecurityContext:          containerSecurityContext(buildContext.BuildPodBuilderConfig),
 	}
 	detectContainerMods := ifWindows(buildContext.os(), addNetworkWaitLauncherVolume(), useNetworkWaitLauncher(dnsProbeHost))
 
@@ -390,6 +429,11 @@ func (b *Build) BuildPod(images BuildPodImages, buildContext BuildContext) (*cor
 							homeEnv,
 							{Name: CacheTagEnvVar, Value: b.Spec.RegistryCacheTag()},
 							{Name: TerminationMessagePathEnvVar, Value: completionTerminationMessagePath},
+							{Name: PreviousImageEnvVar, Value: b.previousImage()},
+							{Name: PreviousCacheImageEnvVar, Value: b.previousCacheImage()},
+							{Name: BuildNameEnvVar, Value: b.Name},
+							{Name: BuildNamespaceEnvVar, Value: b.Namespace},
+							{Name: BuildUIDEnvVar, Value: string(b.UID)},
 						},
 						Args: args(
 							b.notaryArgs(),
@@ -424,7 +468,7 @@ func (b *Build) BuildPod(images BuildPodImages, buildContext BuildContext) (*cor
 						Args:            append(secretArgs, imagePullArgs...),
 						Resources:       b.Spec.Resources,
 						SecurityContext: containerSecurityContext(buildContext.BuildPodBuilderConfig),
-						Env: append(
+						Env: append(append(
 							buildEnv,
 							corev1.EnvVar{
 								Name:  "SOURCE_SUB_PATH",
@@ -462,7 +506,7 @@ func (b *Build) BuildPod(images BuildPodImages, buildContext BuildContext) (*cor
 								Name:  buildChangesEnvVar,
 								Value: b.BuildChanges(),
 							},
-						),
+						), b.imageMetadataEnv(buildContext.KpackVersion)...),
 						ImagePullPolicy: corev1.PullIfNotPresent,
 						WorkingDir:      "/workspace",
 						VolumeMounts: volumeMounts(
@@ -638,7 +682,7 @@ func (b *Build) BuildPod(images BuildPodImages, buildContext BuildContext) (*cor
 				)
 			}),
 			ServiceAccountName: b.Spec.ServiceAccountName,
-			NodeSelector:       b.nodeSelector(buildContext.os()),
+			NodeSelector:       b.nodeSelector(buildContext.os(), buildContext.arch()),
 			Tolerations:        b.Spec.Tolerations,
 			Affinity:           b.Spec.Affinity,
 			RuntimeClassName:   b.Spec.RuntimeClassName,
@@ -693,6 +737,17 @@ func (b *Build) BuildPod(images BuildPodImages, buildContext BuildContext) (*cor
 		},
 	}
 
+	if b.Spec.Breakpoint != "" && buildContext.os() != "windows" {
+		pod, err = b.useBreakpoint(pod)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if b.RetainsFailures() && buildContext.os() != "windows" {
+		pod = b.useRetainedWorkspace(pod)
+	}
+
 	if buildContext.InjectedSidecarSupport && buildContext.os() != "windows" {
 		pod = b.useStandardContainers(images.BuildWaiterImage, pod)
 	}
@@ -850,6 +905,20 @@ func noOpModifer(container corev1.Container) corev1.Container {
 	return container
 }
 
+func (b *Build) previousImage() string {
+	if b.Spec.LastBuild == nil {
+		return ""
+	}
+	return b.Spec.LastBuild.Image
+}
+
+func (b *Build) previousCacheImage() string {
+	if b.Spec.LastBuild == nil {
+		return ""
+	}
+	return b.Spec.LastBuild.Cache.Image
+}
+
 func (b *Build) notarySecretVolume() corev1.Volume {
 	config := b.NotaryV1Config()
 	if config == nil {
@@ -919,7 +988,7 @@ func (b *Build) rebasePod(buildContext BuildContext, images BuildPodImages) (*co
 		},
 		Spec: corev1.PodSpec{
 			ServiceAccountName: b.Spec.ServiceAccountName,
-			NodeSelector:       b.nodeSelector("linux"),
+			NodeSelector:       b.nodeSelector("linux", buildContext.arch()),
 			Tolerations:        b.Spec.Tolerations,
 			Affinity:           b.Spec.Affinity,
 			RuntimeClassName:   b.Spec.RuntimeClassName,
@@ -952,6 +1021,11 @@ func (b *Build) rebasePod(buildContext BuildContext, images BuildPodImages) (*co
 					Env: []corev1.EnvVar{
 						{Name: CacheTagEnvVar, Value: b.Spec.RegistryCacheTag()},
 						{Name: TerminationMessagePathEnvVar, Value: completionTerminationMessagePath},
+						{Name: PreviousImageEnvVar, Value: b.previousImage()},
+						{Name: PreviousCacheImageEnvVar, Value: b.previousCacheImage()},
+						{Name: BuildNameEnvVar, Value: b.Name},
+						{Name: BuildNamespaceEnvVar, Value: b.Namespace},
+						{Name: BuildUIDEnvVar, Value: string(b.UID)},
 					},
 					Args: args(
 						b.notaryArgs(),
@@ -1032,6 +1106,15 @@ func (b *Build) cacheVolume(os string) []corev1.Volume {
 	}}
 }
 
+func hasGitCredentialFile(secret corev1.Secret) bool {
+	if secret.Type != corev1.SecretTypeOpaque {
+		return false
+	}
+	_, netrc := secret.Data[netrcSecretKey]
+	_, gitCredential := secret.Data[gitCredentialSecretKey]
+	return netrc || gitCredential
+}
+
 func gitAndDockerSecrets(secret corev1.Secret) bool {
 	return secret.Annotations[GITSecretAnnotationPrefix] != "" || dockerSecrets(secret)
 }
@@ -1057,7 +1140,7 @@ func (b *Build) setupSecretVolumesAndArgs(secrets []corev1.Secret, filter func(s
 			args = append(args, fmt.Sprintf("-dockerconfig=%s", secret.Name))
 		case secret.Type == corev1.SecretTypeDockercfg:
 			args = append(args, fmt.Sprintf("-dockercfg=%s", secret.Name))
-		case secret.Type == corev1.SecretTypeBasicAuth && secret.Annotations[GITSecretAnnotationPrefix] != "":
+		case (secret.Type == corev1.SecretTypeBasicAuth || hasGitCredentialFile(secret)) && secret.Annotations[GITSecretAnnotationPrefix] != "":
 			annotatedUrl := secret.Annotations[GITSecretAnnotationPrefix]
 			args = append(args, fmt.Sprintf("-basic-%s=%s=%s", "git", secret.Name, annotatedUrl))
 		case secret.Type == corev1.SecretTypeSSHAuth:
@@ -1180,12 +1263,15 @@ func (bc BuildContext) highestSupportedPlatformAPI(b *Build) (*semver.Version, e
 	return nil, errors.Errorf("unsupported builder platform API versions: %s", strings.Join(bc.BuildPodBuilderConfig.PlatformAPIs, ","))
 }
 
-func (b Build) nodeSelector(os string) map[string]string {
+func (b Build) nodeSelector(os, arch string) map[string]string {
 	if b.Spec.NodeSelector == nil {
 		b.Spec.NodeSelector = map[string]string{}
 	}
 
 	b.Spec.NodeSelector[k8sOSLabel] = os
+	if arch != "" {
+		b.Spec.NodeSelector[k8sArchLabel] = arch
+	}
 	return b.Spec.NodeSelector
 }
 
@@ -1,11 +1,14 @@
 package v1alpha2
 
+import "strings"
+
 type BuildPriority int
 
 var (
 	BuildPriorityNone      = BuildPriority(0)
 	BuildPriorityLow       = BuildPriority(1)
 	BuildPriorityHigh      = BuildPriority(1000)
+	BuildPriorityFirst     = BuildPriority(2000)
 	BuildPriorityClassHigh = "kpack-build-high-priority"
 	BuildPriorityClassLow  = "kpack-build-low-priority"
 )
@@ -19,3 +22,26 @@ var PriorityClasses = map[BuildPriority]string{
 func (p BuildPriority) PriorityClass() string {
 	return PriorityClasses[p]
 }
+
+// SchedulingPriority is the order in which builds waiting on a BuildQuota
+// are started. Builds for user changes go before builds for stack and
+// buildpack updates. With prioritizeFirstBuilds the first build of an
+// image goes before any other build.
+func (b *Build) SchedulingPriority(prioritizeFirstBuilds bool) BuildPriority {
+	if prioritizeFirstBuilds && b.Labels[BuildNumberLabel] == "1" {
+		return BuildPriorityFirst
+	}
+
+	reason := b.BuildReason()
+	if reason == "" {
+		return BuildPriorityHigh
+	}
+
+	for _, r := range strings.Split(reason, ",") {
+		switch r {
+		case BuildReasonCommit, BuildReasonConfig, BuildReasonTrigger:
+			return BuildPriorityHigh
+		}
+	}
+	return BuildPriorityLow
+}
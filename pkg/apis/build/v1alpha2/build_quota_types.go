@@ -0,0 +1,61 @@
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	BuildQuotaKind   = "BuildQuota"
+	BuildQuotaCRName = "buildquotas.kpack.io"
+
+	ConditionBuildCacheQuotaExceeded corev1alpha1.ConditionType = "BuildCacheQuotaExceeded"
+
+	BuildQuotaExceededReason = "BuildQuotaExceeded"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// BuildQuota limits the builds and build cache storage of the namespace it is
+// created in. Builds exceeding a quota are queued until it allows them.
+// +k8s:openapi-gen=true
+type BuildQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BuildQuotaSpec `json:"spec"`
+}
+
+// +k8s:openapi-gen=true
+type BuildQuotaSpec struct {
+	// MaxConcurrentBuilds limits the number of builds with running pods.
+	MaxConcurrentBuilds *int64 `json:"maxConcurrentBuilds,omitempty"`
+
+	// MaxBuildsPerHour limits the number of build pods started within an
+	// hour.
+	MaxBuildsPerHour *int64 `json:"maxBuildsPerHour,omitempty"`
+
+	// MaxCacheSize limits the total size of the build cache volumes of the
+	// Images.
+	MaxCacheSize *resource.Quantity `json:"maxCacheSize,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type BuildQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []BuildQuota `json:"items"`
+}
+
+func (*BuildQuota) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(BuildQuotaKind)
+}
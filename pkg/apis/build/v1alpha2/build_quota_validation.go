@@ -0,0 +1,28 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+func (q *BuildQuota) SetDefaults(context.Context) {
+}
+
+func (q *BuildQuota) Validate(ctx context.Context) *apis.FieldError {
+	return q.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (s *BuildQuotaSpec) Validate(context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if s.MaxConcurrentBuilds != nil && *s.MaxConcurrentBuilds < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*s.MaxConcurrentBuilds, "maxConcurrentBuilds"))
+	}
+	if s.MaxBuildsPerHour != nil && *s.MaxBuildsPerHour < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*s.MaxBuildsPerHour, "maxBuildsPerHour"))
+	}
+	if s.MaxCacheSize != nil && s.MaxCacheSize.Sign() < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(s.MaxCacheSize.String(), "maxCacheSize"))
+	}
+	return errs
+}
@@ -0,0 +1,69 @@
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestBuildQuotaValidation(t *testing.T) {
+	spec.Run(t, "Build Quota Validation", testBuildQuotaValidation)
+}
+
+func testBuildQuotaValidation(t *testing.T, when spec.G, it spec.S) {
+	maxConcurrentBuilds := int64(2)
+	maxBuildsPerHour := int64(10)
+	maxCacheSize := resource.MustParse("10Gi")
+
+	quota := &BuildQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-quota",
+			Namespace: "some-namespace",
+		},
+		Spec: BuildQuotaSpec{
+			MaxConcurrentBuilds: &maxConcurrentBuilds,
+			MaxBuildsPerHour:    &maxBuildsPerHour,
+			MaxCacheSize:        &maxCacheSize,
+		},
+	}
+
+	when("Validate", func() {
+		assertValidationError := func(quota *BuildQuota, expectedError *apis.FieldError) {
+			t.Helper()
+			err := quota.Validate(context.TODO())
+			assert.EqualError(t, err, expectedError.Error())
+		}
+
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, quota.Validate(context.TODO()))
+		})
+
+		it("returns nil without limits", func() {
+			quota.Spec = BuildQuotaSpec{}
+			assert.Nil(t, quota.Validate(context.TODO()))
+		})
+
+		it("negative max concurrent builds", func() {
+			negative := int64(-1)
+			quota.Spec.MaxConcurrentBuilds = &negative
+			assertValidationError(quota, apis.ErrInvalidValue(int64(-1), "maxConcurrentBuilds").ViaField("spec"))
+		})
+
+		it("negative max builds per hour", func() {
+			negative := int64(-1)
+			quota.Spec.MaxBuildsPerHour = &negative
+			assertValidationError(quota, apis.ErrInvalidValue(int64(-1), "maxBuildsPerHour").ViaField("spec"))
+		})
+
+		it("negative max cache size", func() {
+			negative := resource.MustParse("-1Gi")
+			quota.Spec.MaxCacheSize = &negative
+			assertValidationError(quota, apis.ErrInvalidValue("-1Gi", "maxCacheSize").ViaField("spec"))
+		})
+	})
+}
@@ -0,0 +1,88 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	BuildTemplateKind   = "BuildTemplate"
+	BuildTemplateCRName = "buildtemplates.kpack.io"
+
+	ClusterBuildTemplateKind   = "ClusterBuildTemplate"
+	ClusterBuildTemplateCRName = "clusterbuildtemplates.kpack.io"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// BuildTemplate holds build configuration shared by the Images in its
+// namespace that reference it.
+// +k8s:openapi-gen=true
+type BuildTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BuildTemplateSpec `json:"spec"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// ClusterBuildTemplate holds build configuration shared by the Images in any
+// namespace that reference it.
+// +k8s:openapi-gen=true
+type ClusterBuildTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BuildTemplateSpec `json:"spec"`
+}
+
+// BuildTemplateSpec is the build configuration an Image inherits from a
+// template. Configuration set on the Image takes precedence.
+// +k8s:openapi-gen=true
+type BuildTemplateSpec struct {
+	// +listType
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +listType
+	Services  Services                    `json:"services,omitempty"`
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// +listType
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	Cache       *ImageCacheConfig   `json:"cache,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type BuildTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []BuildTemplate `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type ClusterBuildTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []ClusterBuildTemplate `json:"items"`
+}
+
+func (*BuildTemplate) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(BuildTemplateKind)
+}
+
+func (*ClusterBuildTemplate) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(ClusterBuildTemplateKind)
+}
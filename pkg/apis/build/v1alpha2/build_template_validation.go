@@ -0,0 +1,33 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+func (t *BuildTemplate) SetDefaults(context.Context) {
+}
+
+func (t *BuildTemplate) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (t *ClusterBuildTemplate) SetDefaults(context.Context) {
+}
+
+func (t *ClusterBuildTemplate) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (s *BuildTemplateSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	for i, env := range s.Env {
+		if env.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("env", i))
+		}
+	}
+	return errs.
+		Also(s.Services.Validate(ctx).ViaField("services")).
+		Also(s.Cache.Validate(ctx).ViaField("cache"))
+}
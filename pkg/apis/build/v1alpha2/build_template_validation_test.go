@@ -0,0 +1,76 @@
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestBuildTemplateValidation(t *testing.T) {
+	spec.Run(t, "Build Template Validation", testBuildTemplateValidation)
+}
+
+func testBuildTemplateValidation(t *testing.T, when spec.G, it spec.S) {
+	template := &BuildTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-template",
+			Namespace: "some-namespace",
+		},
+		Spec: BuildTemplateSpec{
+			Env: []corev1.EnvVar{
+				{Name: "BP_JVM_VERSION", Value: "17"},
+			},
+			Services: Services{
+				{Name: "some-binding", Kind: "Secret", APIVersion: "v1"},
+			},
+			Tolerations: []corev1.Toleration{
+				{Key: "builds", Operator: corev1.TolerationOpExists},
+			},
+		},
+	}
+
+	clusterTemplate := &ClusterBuildTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "some-cluster-template",
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+
+	when("Validate", func() {
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, template.Validate(context.TODO()))
+			assert.Nil(t, clusterTemplate.Validate(context.TODO()))
+		})
+
+		it("returns nil on an empty spec", func() {
+			template.Spec = BuildTemplateSpec{}
+			assert.Nil(t, template.Validate(context.TODO()))
+		})
+
+		it("missing env name", func() {
+			template.Spec.Env = append(template.Spec.Env, corev1.EnvVar{Value: "some-value"})
+			err := template.Validate(context.TODO())
+			assert.EqualError(t, err, apis.ErrMissingField("spec.env[1].name").Error())
+		})
+
+		it("invalid services", func() {
+			clusterTemplate.Spec.Services = append(clusterTemplate.Spec.Services, corev1.ObjectReference{Kind: "Secret", APIVersion: "v1"})
+			err := clusterTemplate.Validate(context.TODO())
+			assert.EqualError(t, err, apis.ErrMissingField("spec.services[1].name").Error())
+		})
+
+		it("multiple cache types", func() {
+			template.Spec.Cache = &ImageCacheConfig{
+				Volume:   &ImagePersistentVolumeCache{},
+				Registry: &RegistryCache{Tag: "some-registry.io/cache"},
+			}
+			err := template.Validate(context.TODO())
+			assert.EqualError(t, err, apis.ErrGeneric("only one type of cache can be specified", "spec.cache.volume", "spec.cache.registry").Error())
+		})
+	})
+}
@@ -86,3 +86,99 @@ func TestBuildLifecycle(t *testing.T) {
 		},
 	}))
 }
+
+func TestPushedImages(t *testing.T) {
+	build := &Build{
+		Spec: BuildSpec{
+			Tags: []string{
+				"some-registry.io/some/image",
+				"some-registry.io/some/image:b1.20220101.010101",
+				"other-registry.io/other/image:tag",
+			},
+		},
+	}
+
+	require.Equal(t, []string{
+		"some-registry.io/some/image@sha256:d3eb15a6fd25cb79039594294419de2328f14b443fa0546fa9e16f5214d61686",
+		"other-registry.io/other/image@sha256:d3eb15a6fd25cb79039594294419de2328f14b443fa0546fa9e16f5214d61686",
+	}, build.PushedImages("some-registry.io/some/image@sha256:d3eb15a6fd25cb79039594294419de2328f14b443fa0546fa9e16f5214d61686"))
+
+	require.Nil(t, build.PushedImages("not-a-digest"))
+}
+
+func TestInputHash(t *testing.T) {
+	build := &Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "some-build",
+		},
+		Spec: BuildSpec{
+			Tags: []string{"some-registry.io/some/image"},
+			Builder: corev1alpha1.BuildBuilderSpec{
+				Image: "some-registry.io/builder@sha256:abc",
+			},
+			RunImage: BuildSpecImage{
+				Image: "some-registry.io/run@sha256:def",
+			},
+			Source: corev1alpha1.SourceConfig{
+				Git: &corev1alpha1.Git{
+					URL:      "https://github.com/some/repo",
+					Revision: "abcdef",
+				},
+			},
+			Env: []corev1.EnvVar{{Name: "SOME", Value: "env"}},
+		},
+	}
+
+	other := build.DeepCopy()
+	other.Name = "other-build"
+	other.Spec.Tags = []string{"other-registry.io/other/image"}
+	other.Spec.ServiceAccountName = "other-sa"
+	require.Equal(t, build.InputHash(), other.InputHash())
+	require.Len(t, build.InputHash(), 64)
+
+	other.Spec.Source.Git.Revision = "123456"
+	require.NotEqual(t, build.InputHash(), other.InputHash())
+
+	other = build.DeepCopy()
+	other.Spec.Builder.Image = "some-registry.io/builder@sha256:123"
+	require.NotEqual(t, build.InputHash(), other.InputHash())
+
+	other = build.DeepCopy()
+	other.Spec.Env = []corev1.EnvVar{{Name: "SOME", Value: "other"}}
+	require.NotEqual(t, build.InputHash(), other.InputHash())
+
+	other = build.DeepCopy()
+	other.Spec.LastBuild = &LastBuild{Image: "some-registry.io/some/image@sha256:previous"}
+	require.Equal(t, build.InputHash(), other.InputHash())
+	other.Annotations = map[string]string{BuildReasonAnnotation: BuildReasonStack}
+	require.NotEqual(t, build.InputHash(), other.InputHash())
+}
+
+func TestReproducible(t *testing.T) {
+	build := &Build{}
+	require.True(t, build.Reproducible())
+
+	build.Spec.CreationTime = "now"
+	require.False(t, build.Reproducible())
+
+	build = &Build{Spec: BuildSpec{Cosign: &CosignConfig{}}}
+	require.False(t, build.Reproducible())
+}
+
+func TestSchedulingPriority(t *testing.T) {
+	build := func(buildNumber, reason string) *Build {
+		return &Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      map[string]string{BuildNumberLabel: buildNumber},
+				Annotations: map[string]string{BuildReasonAnnotation: reason},
+			},
+		}
+	}
+
+	require.Equal(t, BuildPriorityFirst, build("1", BuildReasonConfig).SchedulingPriority(true))
+	require.Equal(t, BuildPriorityHigh, build("1", BuildReasonConfig).SchedulingPriority(false))
+	require.Equal(t, BuildPriorityHigh, build("2", "STACK,COMMIT").SchedulingPriority(true))
+	require.Equal(t, BuildPriorityHigh, build("2", BuildReasonTrigger).SchedulingPriority(true))
+	require.Equal(t, BuildPriorityLow, build("2", "BUILDPACK,STACK").SchedulingPriority(true))
+	require.Equal(t, BuildPriorityHigh, (&Build{}).SchedulingPriority(true))
+}
@@ -18,6 +18,7 @@ package v1alpha2
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmeta"
@@ -78,6 +79,10 @@ type BuildSpec struct {
 	SchedulerName     string              `json:"schedulerName,omitempty"`
 	PriorityClassName string              `json:"priorityClassName,omitempty"`
 	CreationTime      string              `json:"creationTime,omitempty"`
+	FailureRetention  *FailureRetention   `json:"failureRetention,omitempty"`
+	Breakpoint        string              `json:"breakpoint,omitempty"`
+	RelocateRunImage  bool                `json:"relocateRunImage,omitempty"`
+	ImageMetadata     map[string]string   `json:"imageMetadata,omitempty"`
 }
 
 func (bs *BuildSpec) RegistryCacheTag() string {
@@ -106,6 +111,15 @@ type BuildPersistentVolumeCache struct {
 	ClaimName string `json:"persistentVolumeClaimName,omitempty"`
 }
 
+// FailureRetention keeps the workspace and layers of a failed build on a
+// PersistentVolumeClaim and runs a debug pod with them mounted for TTLSeconds.
+// +k8s:openapi-gen=true
+type FailureRetention struct {
+	TTLSeconds       int64              `json:"ttlSeconds"`
+	Size             *resource.Quantity `json:"size,omitempty"`
+	StorageClassName string             `json:"storageClassName,omitempty"`
+}
+
 // +k8s:openapi-gen=true
 type Services []corev1.ObjectReference
 
@@ -133,12 +147,46 @@ type BuildStatus struct {
 	BuildMetadata       corev1alpha1.BuildpackMetadataList `json:"buildMetadata,omitempty"`
 	Stack               corev1alpha1.BuildStack            `json:"stack,omitempty"`
 	LatestImage         string                             `json:"latestImage,omitempty"`
-	LatestCacheImage    string                             `json:"latestCacheImage,omitempty"`
-	PodName             string                             `json:"podName,omitempty"`
+	// +listType
+	PushedImages     []string `json:"pushedImages,omitempty"`
+	LatestCacheImage string   `json:"latestCacheImage,omitempty"`
+	PodName          string   `json:"podName,omitempty"`
 	// +listType
 	StepStates []corev1.ContainerState `json:"stepStates,omitempty"`
 	// +listType
-	StepsCompleted []string `json:"stepsCompleted,omitempty"`
+	StepsCompleted []string                        `json:"stepsCompleted,omitempty"`
+	CacheMetrics   *corev1alpha1.BuildCacheMetrics `json:"cacheMetrics,omitempty"`
+	ImageDigest    string                          `json:"imageDigest,omitempty"`
+	// +listType
+	PushedTags   []string          `json:"pushedTags,omitempty"`
+	ImageLabels  map[string]string `json:"imageLabels,omitempty"`
+	DebugPodName string            `json:"debugPodName,omitempty"`
+	// +listType
+	Steps      []BuildStepReference `json:"steps,omitempty"`
+	ActiveStep string               `json:"activeStep,omitempty"`
+	// SequenceNumber orders the Build among the Builds of its Image. It is
+	// the number the Image assigned the Build when it was created and is
+	// never changed afterwards.
+	SequenceNumber int64 `json:"sequenceNumber,omitempty"`
+	// RelocatedRunImage is the run image copied into the repository of the
+	// built image.
+	RelocatedRunImage string `json:"relocatedRunImage,omitempty"`
+	// ResourceUsage is the compute reserved by the build pod, recorded once
+	// the build has finished.
+	ResourceUsage *corev1alpha1.BuildResourceUsage `json:"resourceUsage,omitempty"`
+	// DetectOutput is the end of the detect step output when no buildpack
+	// group passed detection. The full output is in the logs of the detect
+	// container of the build pod.
+	DetectOutput string `json:"detectOutput,omitempty"`
+}
+
+// BuildStepReference names the build pod container that runs a step so its
+// logs can be read and the step exec'd into.
+// +k8s:openapi-gen=true
+type BuildStepReference struct {
+	Name          string `json:"name"`
+	ContainerName string `json:"containerName"`
+	InitContainer bool   `json:"initContainer,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
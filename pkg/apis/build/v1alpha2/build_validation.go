@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	authv1 "k8s.io/api/authentication/v1"
 	"knative.dev/pkg/apis"
@@ -35,7 +36,10 @@ func (bs *BuildSpec) Validate(ctx context.Context) *apis.FieldError {
 		Also(bs.validateImmutableFields(ctx)).
 		Also(validateCnbBindings(ctx, bs.CNBBindings).ViaField("cnbBindings")).
 		Also(bs.validateNodeSelector(ctx)).
-		Also(validateNotary(ctx, bs.Notary).ViaField("notary"))
+		Also(validateNotary(ctx, bs.Notary).ViaField("notary")).
+		Also(bs.FailureRetention.Validate(ctx).ViaField("failureRetention")).
+		Also(validateBreakpoint(bs.Breakpoint)).
+		Also(validateImageMetadata(bs.ImageMetadata).ViaField("imageMetadata"))
 }
 
 func resourceCreatedByKpackController(info *authv1.UserInfo) bool {
@@ -95,6 +99,39 @@ func (c *BuildCacheConfig) Validate(context context.Context) *apis.FieldError {
 	return nil
 }
 
+func (r *FailureRetention) Validate(context context.Context) *apis.FieldError {
+	if r == nil {
+		return nil
+	}
+
+	if r.TTLSeconds <= 0 {
+		return apis.ErrInvalidValue(r.TTLSeconds, "ttlSeconds")
+	}
+	return nil
+}
+
+func validateBreakpoint(breakpoint string) *apis.FieldError {
+	if breakpoint == "" {
+		return nil
+	}
+
+	if _, _, err := parseBreakpoint(breakpoint); err != nil {
+		return apis.ErrInvalidValue(breakpoint, "breakpoint", err.Error())
+	}
+	return nil
+}
+
+// validateImageMetadata rejects keys kpack stamps on the image itself.
+func validateImageMetadata(metadata map[string]string) *apis.FieldError {
+	var errs *apis.FieldError
+	for key := range metadata {
+		if key == "" || strings.HasPrefix(key, kpackImageLabelPrefix) || key == OCISourceLabel || key == OCIRevisionLabel {
+			errs = errs.Also(apis.ErrInvalidKeyName(key, apis.CurrentField, "key is empty or reserved by kpack"))
+		}
+	}
+	return errs
+}
+
 var serviceNameRE = regexp.MustCompile(`^[a-z0-9\-\.]{1,253}$`)
 
 func (ss Services) Validate(ctx context.Context) *apis.FieldError {
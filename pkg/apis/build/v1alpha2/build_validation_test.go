@@ -326,6 +326,24 @@ func testBuildValidation(t *testing.T, when spec.G, it spec.S) {
 			assertValidationError(build, context.TODO(), apis.ErrGeneric("only one type of cache can be specified", "spec.cache.volume", "spec.cache.registry"))
 		})
 
+		it("validates the breakpoint", func() {
+			build.Spec.Breakpoint = "after nothing"
+
+			assertValidationError(build, context.TODO(), apis.ErrInvalidValue("after nothing", "spec.breakpoint", "breakpoint step must be one of prepare, detect, analyze, restore, build, export"))
+		})
+
+		it("validates image metadata keys are not reserved by kpack", func() {
+			build.Spec.ImageMetadata = map[string]string{"org.opencontainers.image.source": "some-source"}
+
+			assertValidationError(build, context.TODO(), apis.ErrInvalidKeyName("org.opencontainers.image.source", "spec.imageMetadata", "key is empty or reserved by kpack"))
+		})
+
+		it("validates failure retention ttl is positive", func() {
+			build.Spec.FailureRetention = &FailureRetention{TTLSeconds: -1}
+
+			assertValidationError(build, context.TODO(), apis.ErrInvalidValue(int64(-1), "spec.failureRetention.ttlSeconds"))
+		})
+
 		it("combining errors", func() {
 			build.Spec.Tags = []string{}
 			build.Spec.Builder.Image = ""
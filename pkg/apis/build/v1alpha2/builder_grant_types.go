@@ -0,0 +1,89 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	BuilderGrantKind   = "BuilderGrant"
+	BuilderGrantCRName = "buildergrants.kpack.io"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// BuilderGrant allows Images in other namespaces to reference Builders in the
+// namespace of the grant.
+// +k8s:openapi-gen=true
+type BuilderGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BuilderGrantSpec `json:"spec"`
+}
+
+// +k8s:openapi-gen=true
+type BuilderGrantSpec struct {
+	// From lists the namespaces whose Images may reference Builders in the
+	// namespace of the grant.
+	// +listType
+	From []BuilderGrantFrom `json:"from"`
+
+	// To lists the Builders that may be referenced. An empty list grants
+	// access to every Builder in the namespace of the grant.
+	// +listType
+	To []BuilderGrantTo `json:"to,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type BuilderGrantFrom struct {
+	Namespace string `json:"namespace"`
+}
+
+// +k8s:openapi-gen=true
+type BuilderGrantTo struct {
+	Name string `json:"name"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type BuilderGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []BuilderGrant `json:"items"`
+}
+
+func (*BuilderGrant) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(BuilderGrantKind)
+}
+
+// Allows returns true if the grant permits Images in fromNamespace to
+// reference the named Builder.
+func (g *BuilderGrant) Allows(fromNamespace, builderName string) bool {
+	namespaceAllowed := false
+	for _, from := range g.Spec.From {
+		if from.Namespace == fromNamespace {
+			namespaceAllowed = true
+			break
+		}
+	}
+	if !namespaceAllowed {
+		return false
+	}
+
+	if len(g.Spec.To) == 0 {
+		return true
+	}
+
+	for _, to := range g.Spec.To {
+		if to.Name == builderName {
+			return true
+		}
+	}
+	return false
+}
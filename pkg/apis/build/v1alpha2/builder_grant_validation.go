@@ -0,0 +1,31 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/validate"
+)
+
+func (g *BuilderGrant) SetDefaults(context.Context) {
+}
+
+func (g *BuilderGrant) Validate(ctx context.Context) *apis.FieldError {
+	return g.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (s *BuilderGrantSpec) Validate(context.Context) *apis.FieldError {
+	if len(s.From) == 0 {
+		return apis.ErrMissingField("from")
+	}
+
+	var errs *apis.FieldError
+	for i, from := range s.From {
+		errs = errs.Also(validate.FieldNotEmpty(from.Namespace, "namespace").ViaFieldIndex("from", i))
+	}
+	for i, to := range s.To {
+		errs = errs.Also(validate.FieldNotEmpty(to.Name, "name").ViaFieldIndex("to", i))
+	}
+	return errs
+}
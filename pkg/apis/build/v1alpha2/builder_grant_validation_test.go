@@ -0,0 +1,69 @@
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestBuilderGrantValidation(t *testing.T) {
+	spec.Run(t, "Builder Grant Validation", testBuilderGrantValidation)
+}
+
+func testBuilderGrantValidation(t *testing.T, when spec.G, it spec.S) {
+	grant := &BuilderGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-grant",
+			Namespace: "platform",
+		},
+		Spec: BuilderGrantSpec{
+			From: []BuilderGrantFrom{{Namespace: "app-namespace"}},
+			To:   []BuilderGrantTo{{Name: "some-builder"}},
+		},
+	}
+
+	when("Validate", func() {
+		assertValidationError := func(grant *BuilderGrant, expectedError *apis.FieldError) {
+			t.Helper()
+			err := grant.Validate(context.TODO())
+			assert.EqualError(t, err, expectedError.Error())
+		}
+
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, grant.Validate(context.TODO()))
+		})
+
+		it("missing from", func() {
+			grant.Spec.From = nil
+			assertValidationError(grant, apis.ErrMissingField("from").ViaField("spec"))
+		})
+
+		it("missing from namespace", func() {
+			grant.Spec.From = append(grant.Spec.From, BuilderGrantFrom{})
+			assertValidationError(grant, apis.ErrMissingField("namespace").ViaFieldIndex("from", 1).ViaField("spec"))
+		})
+
+		it("missing to name", func() {
+			grant.Spec.To = []BuilderGrantTo{{}}
+			assertValidationError(grant, apis.ErrMissingField("name").ViaFieldIndex("to", 0).ViaField("spec"))
+		})
+	})
+
+	when("Allows", func() {
+		it("allows granted namespaces to reference listed builders", func() {
+			assert.True(t, grant.Allows("app-namespace", "some-builder"))
+			assert.False(t, grant.Allows("app-namespace", "other-builder"))
+			assert.False(t, grant.Allows("other-namespace", "some-builder"))
+		})
+
+		it("allows every builder when to is empty", func() {
+			grant.Spec.To = nil
+			assert.True(t, grant.Allows("app-namespace", "other-builder"))
+			assert.False(t, grant.Allows("other-namespace", "other-builder"))
+		})
+	})
+}
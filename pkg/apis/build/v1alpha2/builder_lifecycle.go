@@ -1,6 +1,11 @@
 package v1alpha2
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,6 +21,8 @@ type BuilderRecord struct {
 	ObservedStoreGeneration int64
 	ObservedStackGeneration int64
 	OS                      string
+	MixinMismatches         []BuildpackMixinMismatch
+	StackDeprecation        *StackDeprecation
 }
 
 func (bs *BuilderStatus) BuilderRecord(record BuilderRecord) {
@@ -33,9 +40,20 @@ func (bs *BuilderStatus) BuilderRecord(record BuilderRecord) {
 	bs.ObservedStoreGeneration = record.ObservedStoreGeneration
 	bs.ObservedStackGeneration = record.ObservedStackGeneration
 	bs.OS = record.OS
+	bs.MixinMismatches = record.MixinMismatches
+	bs.StackDeprecation = record.StackDeprecation
+	if record.StackDeprecation != nil {
+		bs.Conditions = append(bs.Conditions, StackDeprecatedCondition(record.Stack.ID, record.StackDeprecation, time.Now()))
+	}
 }
 
 func (cb *BuilderStatus) ErrorCreate(err error) {
+	cb.MixinMismatches = nil
+	var mismatchErr *MixinMismatchError
+	if errors.As(err, &mismatchErr) {
+		cb.MixinMismatches = mismatchErr.Mismatches
+	}
+
 	cb.Status = corev1alpha1.Status{
 		Conditions: corev1alpha1.Conditions{
 			{
@@ -47,3 +65,73 @@ func (cb *BuilderStatus) ErrorCreate(err error) {
 		},
 	}
 }
+
+// MixinMismatchError is returned when buildpacks require mixins the stack
+// does not provide.
+type MixinMismatchError struct {
+	Mismatches []BuildpackMixinMismatch
+}
+
+func (e *MixinMismatchError) Error() string {
+	messages := make([]string, 0, len(e.Mismatches))
+	for _, m := range e.Mismatches {
+		messages = append(messages, fmt.Sprintf("validating buildpack %s: stack missing mixin(s): %s", m.BuildpackInfo, strings.Join(m.MissingMixins, ", ")))
+	}
+	return strings.Join(messages, "; ")
+}
+
+const (
+	ConditionRolloutComplete corev1alpha1.ConditionType = "RolloutComplete"
+
+	CanaryInProgressReason = "CanaryInProgress"
+	CanaryFailedReason     = "CanaryFailed"
+)
+
+// StableRevision returns the builder that Images outside the canary selector
+// should use while a new builder is rolled out.
+func (bs *BuilderStatus) StableRevision() (BuilderRevision, bool) {
+	if bs.Rollout != nil {
+		return bs.Rollout.Stable, true
+	}
+
+	if bs.LatestImage == "" {
+		return BuilderRevision{}, false
+	}
+
+	return BuilderRevision{
+		LatestImage:     bs.LatestImage,
+		BuilderMetadata: bs.BuilderMetadata,
+		Stack:           bs.Stack,
+	}, true
+}
+
+func (bs *BuilderStatus) RolloutComplete() {
+	bs.Rollout = nil
+	bs.Conditions = append(bs.Conditions, corev1alpha1.Condition{
+		Type:               ConditionRolloutComplete,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: corev1alpha1.VolatileTime{Inner: v1.Now()},
+	})
+}
+
+func (bs *BuilderStatus) CanaryInProgress(stable BuilderRevision, message string) {
+	bs.Rollout = &BuilderRolloutStatus{Stable: stable}
+	bs.Conditions = append(bs.Conditions, corev1alpha1.Condition{
+		Type:               ConditionRolloutComplete,
+		Status:             corev1.ConditionUnknown,
+		Reason:             CanaryInProgressReason,
+		Message:            message,
+		LastTransitionTime: corev1alpha1.VolatileTime{Inner: v1.Now()},
+	})
+}
+
+func (bs *BuilderStatus) CanaryFailed(stable BuilderRevision, message string) {
+	bs.Rollout = &BuilderRolloutStatus{Stable: stable}
+	bs.Conditions = append(bs.Conditions, corev1alpha1.Condition{
+		Type:               ConditionRolloutComplete,
+		Status:             corev1.ConditionFalse,
+		Reason:             CanaryFailedReason,
+		Message:            message,
+		LastTransitionTime: corev1alpha1.VolatileTime{Inner: v1.Now()},
+	})
+}
@@ -65,6 +65,34 @@ type BuilderStatus struct {
 	ObservedStackGeneration int64                              `json:"observedStackGeneration,omitempty"`
 	ObservedStoreGeneration int64                              `json:"observedStoreGeneration,omitempty"`
 	OS                      string                             `json:"os,omitempty"`
+	Rollout                 *BuilderRolloutStatus              `json:"rollout,omitempty"`
+	// MixinMismatches lists the buildpacks that require mixins the stack
+	// does not provide.
+	// +listType
+	MixinMismatches []BuildpackMixinMismatch `json:"mixinMismatches,omitempty"`
+	// StackDeprecation is set when the stack of the builder is deprecated.
+	StackDeprecation *StackDeprecation `json:"stackDeprecation,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type BuildpackMixinMismatch struct {
+	corev1alpha1.BuildpackInfo `json:",inline"`
+	// +listType
+	MissingMixins []string `json:"missingMixins"`
+}
+
+// +k8s:openapi-gen=true
+type BuilderRolloutStatus struct {
+	// Stable is the builder used by Images outside the canary selector until
+	// the rollout completes.
+	Stable BuilderRevision `json:"stable"`
+}
+
+// +k8s:openapi-gen=true
+type BuilderRevision struct {
+	LatestImage     string                             `json:"latestImage,omitempty"`
+	BuilderMetadata corev1alpha1.BuildpackMetadataList `json:"builderMetadata,omitempty"`
+	Stack           corev1alpha1.BuildStack            `json:"stack,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
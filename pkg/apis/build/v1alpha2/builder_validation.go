@@ -28,35 +28,39 @@ func (cb *Builder) Validate(ctx context.Context) *apis.FieldError {
 }
 
 func (s *BuilderSpec) Validate(ctx context.Context) *apis.FieldError {
+	return s.validate([]string{ClusterStackKind}, []string{ClusterStoreKind})
+}
+
+func (s *BuilderSpec) validate(stackKinds, storeKinds []string) *apis.FieldError {
 	return validate.Tag(s.Tag).
-		Also(validateStack(s.Stack).ViaField("stack")).
-		Also(validateStore(s.Store).ViaField("store")).
+		Also(validateStack(s.Stack, stackKinds).ViaField("stack")).
+		Also(validateStore(s.Store, storeKinds).ViaField("store")).
 		Also(validateOrder(s.Order).ViaField("order"))
 }
 
 func (s *NamespacedBuilderSpec) Validate(ctx context.Context) *apis.FieldError {
-	return s.BuilderSpec.Validate(ctx).
+	return s.BuilderSpec.validate([]string{ClusterStackKind, StackKind}, []string{ClusterStoreKind, StoreKind}).
 		Also(validate.FieldNotEmpty(s.ServiceAccount(), "serviceAccountName"))
 }
 
-func validateStack(stack v1.ObjectReference) *apis.FieldError {
+func validateStack(stack v1.ObjectReference, kinds []string) *apis.FieldError {
 	if stack.Name == "" {
 		return apis.ErrMissingField("name")
 	}
 
-	switch stack.Kind {
-	case ClusterStackKind:
-		return nil
-	default:
-		return apis.ErrInvalidValue(stack.Kind, "kind")
+	for _, k := range kinds {
+		if stack.Kind == k {
+			return nil
+		}
 	}
+	return apis.ErrInvalidValue(stack.Kind, "kind")
 }
 
-func validateStore(store v1.ObjectReference) *apis.FieldError {
+func validateStore(store v1.ObjectReference, kinds []string) *apis.FieldError {
 	if store.Name == "" && store.Kind == "" {
 		return nil
 	}
-	return validateObjectRef(store, []string{ClusterStoreKind})
+	return validateObjectRef(store, kinds)
 }
 
 func validateOrder(order []BuilderOrderEntry) *apis.FieldError {
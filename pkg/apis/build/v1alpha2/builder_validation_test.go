@@ -139,7 +139,13 @@ func testBuilderValidation(t *testing.T, when spec.G, it spec.S) {
 
 		it("invalid store kind", func() {
 			builder.Spec.Store.Kind = "FakeStore"
-			assertValidationError(builder, apis.ErrInvalidValue("FakeStore", "kind", "must be one of ClusterStore").ViaField("spec", "store"))
+			assertValidationError(builder, apis.ErrInvalidValue("FakeStore", "kind", "must be one of ClusterStore, Store").ViaField("spec", "store"))
+		})
+
+		it("allows namespaced stack and store kinds", func() {
+			builder.Spec.Stack.Kind = "Stack"
+			builder.Spec.Store.Kind = "Store"
+			assert.Nil(t, builder.Validate(context.TODO()))
 		})
 
 		when("order", func() {
@@ -29,6 +29,14 @@ type ClusterBuilder struct {
 type ClusterBuilderSpec struct {
 	BuilderSpec       `json:",inline"`
 	ServiceAccountRef corev1.ObjectReference `json:"serviceAccountRef,omitempty"`
+	Rollout           *BuilderRollout        `json:"rollout,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type BuilderRollout struct {
+	// CanarySelector selects the Images that are rebuilt with an updated
+	// builder before it is adopted by all other Images.
+	CanarySelector metav1.LabelSelector `json:"canarySelector"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -3,9 +3,20 @@ package v1alpha2
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 )
 
+type BuilderContextKey string
+
+// StackCompatibilityValidator is set on the context by the webhook to reject
+// builders whose buildpacks do not support the referenced stack.
+const StackCompatibilityValidator BuilderContextKey = "stackCompatibilityValidator"
+
+// StackCompatibilityValidatorFunc returns an error for every buildpack in
+// the order of the builder that does not support its stack.
+type StackCompatibilityValidatorFunc func(spec *BuilderSpec) *apis.FieldError
+
 func (ccb *ClusterBuilder) SetDefaults(context.Context) {
 }
 
@@ -20,5 +31,30 @@ func (ccbs *ClusterBuilderSpec) Validate(ctx context.Context) *apis.FieldError {
 	if ccbs.ServiceAccountRef.Namespace == "" {
 		return apis.ErrMissingField("namespace").ViaField("spec", "serviceAccountRef")
 	}
-	return ccbs.BuilderSpec.Validate(ctx)
+	if ccbs.Rollout != nil {
+		if err := ccbs.Rollout.Validate(ctx); err != nil {
+			return err.ViaField("spec", "rollout")
+		}
+	}
+	return ccbs.BuilderSpec.Validate(ctx).
+		Also(validateStackCompatibility(ctx, &ccbs.BuilderSpec).ViaField("spec"))
+}
+
+func validateStackCompatibility(ctx context.Context, spec *BuilderSpec) *apis.FieldError {
+	validator, ok := ctx.Value(StackCompatibilityValidator).(StackCompatibilityValidatorFunc)
+	if !ok {
+		return nil
+	}
+	return validator(spec)
+}
+
+func (r *BuilderRollout) Validate(context.Context) *apis.FieldError {
+	if len(r.CanarySelector.MatchLabels) == 0 && len(r.CanarySelector.MatchExpressions) == 0 {
+		return apis.ErrMissingField("canarySelector")
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(&r.CanarySelector); err != nil {
+		return apis.ErrInvalidValue(err.Error(), "canarySelector")
+	}
+	return nil
 }
@@ -61,5 +61,54 @@ func testClusterBuilderValidation(t *testing.T, when spec.G, it spec.S) {
 			clusterBuilder.Spec.ServiceAccountRef.Namespace = ""
 			assertValidationError(clusterBuilder, apis.ErrMissingField("namespace").ViaField("spec", "serviceAccountRef"))
 		})
+
+		it("namespaced stack kind", func() {
+			clusterBuilder.Spec.Stack.Kind = "Stack"
+			assertValidationError(clusterBuilder, apis.ErrInvalidValue("Stack", "kind").ViaField("stack"))
+		})
+
+		it("namespaced store kind", func() {
+			clusterBuilder.Spec.Store.Kind = "Store"
+			assertValidationError(clusterBuilder, apis.ErrInvalidValue("Store", "kind", "must be one of ClusterStore").ViaField("store"))
+		})
+
+		it("valid rollout", func() {
+			clusterBuilder.Spec.Rollout = &BuilderRollout{
+				CanarySelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"canary": "true"},
+				},
+			}
+			assert.Nil(t, clusterBuilder.Validate(context.TODO()))
+		})
+
+		it("rollout without a canary selector", func() {
+			clusterBuilder.Spec.Rollout = &BuilderRollout{}
+			assertValidationError(clusterBuilder, apis.ErrMissingField("canarySelector").ViaField("spec", "rollout"))
+		})
+
+		it("rollout with an invalid canary selector", func() {
+			clusterBuilder.Spec.Rollout = &BuilderRollout{
+				CanarySelector: metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "canary", Operator: "Bogus"},
+					},
+				},
+			}
+			err := clusterBuilder.Validate(context.TODO())
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "spec.rollout.canarySelector")
+		})
+
+		it("validates stack compatibility when a validator is on the context", func() {
+			var validated *BuilderSpec
+			ctx := context.WithValue(context.TODO(), StackCompatibilityValidator, StackCompatibilityValidatorFunc(func(spec *BuilderSpec) *apis.FieldError {
+				validated = spec
+				return apis.ErrGeneric("buildpack some-buildpack: stack some-stack is not supported", "order[0].group[0]")
+			}))
+
+			err := clusterBuilder.Validate(ctx)
+			assert.EqualError(t, err, "buildpack some-buildpack: stack some-stack is not supported: spec.order[0].group[0]")
+			assert.Equal(t, &clusterBuilder.Spec.BuilderSpec, validated)
+		})
 	})
 }
@@ -32,6 +32,21 @@ type ClusterStackSpec struct {
 	BuildImage        ClusterStackSpecImage   `json:"buildImage,omitempty"`
 	RunImage          ClusterStackSpecImage   `json:"runImage,omitempty"`
 	ServiceAccountRef *corev1.ObjectReference `json:"serviceAccountRef,omitempty"`
+	// RelaxMixinValidation allows builders to be created with buildpacks
+	// that require mixins the stack does not declare. Mismatches are still
+	// reported on the builder status.
+	RelaxMixinValidation bool `json:"relaxMixinValidation,omitempty"`
+	// Deprecation marks the stack as deprecated. It takes precedence over
+	// the deprecation labels of the build image.
+	Deprecation *StackDeprecation `json:"deprecation,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type StackDeprecation struct {
+	// Message explains the deprecation, for example the stack to migrate to.
+	Message string `json:"message,omitempty"`
+	// EndOfLife is when the stack stops being supported.
+	EndOfLife *metav1.Time `json:"endOfLife,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -51,9 +66,10 @@ type ResolvedClusterStack struct {
 	BuildImage ClusterStackStatusImage `json:"buildImage,omitempty"`
 	RunImage   ClusterStackStatusImage `json:"runImage,omitempty"`
 	// +listType
-	Mixins  []string `json:"mixins,omitempty"`
-	UserID  int      `json:"userId,omitempty"`
-	GroupID int      `json:"groupId,omitempty"`
+	Mixins      []string          `json:"mixins,omitempty"`
+	UserID      int               `json:"userId,omitempty"`
+	GroupID     int               `json:"groupId,omitempty"`
+	Deprecation *StackDeprecation `json:"deprecation,omitempty"`
 }
 
 // +k8s:openapi-gen=true
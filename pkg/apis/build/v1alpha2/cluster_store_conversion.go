@@ -13,6 +13,7 @@ import (
 
 const (
 	clusterStoreServiceAccountRefAnnotation = "kpack.io/clusterStoreServiceAccountRef"
+	clusterStoreSecretRefAnnotation         = "kpack.io/clusterStoreSecretRef"
 )
 
 func (s *ClusterStore) ConvertTo(_ context.Context, to apis.Convertible) error {
@@ -44,6 +45,13 @@ func (cs *ClusterStoreSpec) convertToAnnotations(toAnnotations map[string]string
 		}
 		toAnnotations[clusterStoreServiceAccountRefAnnotation] = string(bytes)
 	}
+	if cs.SecretRef != nil {
+		bytes, err := json.Marshal(cs.SecretRef)
+		if err != nil {
+			return err
+		}
+		toAnnotations[clusterStoreSecretRefAnnotation] = string(bytes)
+	}
 	return nil
 }
 
@@ -88,5 +96,13 @@ func (s *ClusterStore) convertFromAnnotations(fromAnnotations *map[string]string
 		s.Spec.ServiceAccountRef = serviceAccountRef
 		delete(s.Annotations, clusterStoreServiceAccountRefAnnotation)
 	}
+	if secretRefJson, ok := (*fromAnnotations)[clusterStoreSecretRefAnnotation]; ok {
+		var secretRef *corev1.SecretReference
+		if err := json.Unmarshal([]byte(secretRefJson), &secretRef); err != nil {
+			return err
+		}
+		s.Spec.SecretRef = secretRef
+		delete(s.Annotations, clusterStoreSecretRefAnnotation)
+	}
 	return nil
 }
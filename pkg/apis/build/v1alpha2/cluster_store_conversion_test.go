@@ -29,6 +29,10 @@ func testClusterStoreConversion(t *testing.T, when spec.G, it spec.S) {
 					Namespace: "some-namespace",
 					Name:      "some-service-account",
 				},
+				SecretRef: &corev1.SecretReference{
+					Namespace: "some-namespace",
+					Name:      "some-secret",
+				},
 			},
 			Status: ClusterStoreStatus{
 				Status: corev1alpha1.Status{},
@@ -62,6 +66,7 @@ func testClusterStoreConversion(t *testing.T, when spec.G, it spec.S) {
 				Annotations: map[string]string{
 					"some-key":                               "some-value",
 					"kpack.io/clusterStoreServiceAccountRef": `{"namespace":"some-namespace","name":"some-service-account"}`,
+					"kpack.io/clusterStoreSecretRef":         `{"name":"some-secret","namespace":"some-namespace"}`,
 				},
 			},
 			Spec: v1alpha1.ClusterStoreSpec{
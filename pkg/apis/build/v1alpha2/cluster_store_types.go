@@ -31,6 +31,7 @@ type ClusterStoreSpec struct {
 	// +listType
 	Sources           []corev1alpha1.ImageSource `json:"sources,omitempty"`
 	ServiceAccountRef *corev1.ObjectReference    `json:"serviceAccountRef,omitempty"`
+	SecretRef         *corev1.SecretReference    `json:"secretRef,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -24,6 +24,18 @@ func (s *ClusterStoreSpec) Validate(ctx context.Context) *apis.FieldError {
 		}
 	}
 
+	if s.SecretRef != nil {
+		if s.SecretRef.Name == "" {
+			return apis.ErrMissingField("name").ViaField("secretRef")
+		}
+		if s.SecretRef.Namespace == "" {
+			return apis.ErrMissingField("namespace").ViaField("secretRef")
+		}
+		if s.ServiceAccountRef != nil && s.ServiceAccountRef.Namespace != s.SecretRef.Namespace {
+			return apis.ErrGeneric("must be in the same namespace as serviceAccountRef", "namespace").ViaField("secretRef")
+		}
+	}
+
 	if len(s.Sources) == 0 {
 		return apis.ErrMissingField("sources")
 	}
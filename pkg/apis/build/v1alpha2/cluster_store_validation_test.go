@@ -106,5 +106,23 @@ func testClusterStoreValidation(t *testing.T, when spec.G, it spec.S) {
 			assertValidationError(clusterStore, apis.ErrMissingField("name").ViaField("serviceAccountRef").ViaField("spec"))
 		})
 
+		it("missing namespace in secretRef", func() {
+			clusterStore.Spec.SecretRef = &corev1.SecretReference{Name: "test"}
+
+			assertValidationError(clusterStore, apis.ErrMissingField("namespace").ViaField("secretRef").ViaField("spec"))
+		})
+
+		it("missing name in secretRef", func() {
+			clusterStore.Spec.SecretRef = &corev1.SecretReference{Namespace: "test"}
+
+			assertValidationError(clusterStore, apis.ErrMissingField("name").ViaField("secretRef").ViaField("spec"))
+		})
+
+		it("secretRef in a different namespace than serviceAccountRef", func() {
+			clusterStore.Spec.ServiceAccountRef = &corev1.ObjectReference{Name: "test", Namespace: "some-namespace"}
+			clusterStore.Spec.SecretRef = &corev1.SecretReference{Name: "test", Namespace: "other-namespace"}
+
+			assertValidationError(clusterStore, apis.ErrGeneric("must be in the same namespace as serviceAccountRef", "namespace").ViaField("secretRef").ViaField("spec"))
+		})
 	})
 }
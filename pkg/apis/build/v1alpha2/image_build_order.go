@@ -0,0 +1,30 @@
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// BuildsSelector selects the Builds created by the Image. Every Build an Image
+// creates carries the ImageLabel and the BuildNumberLabel.
+func (im *Image) BuildsSelector() labels.Selector {
+	return labels.SelectorFromSet(labels.Set{ImageLabel: im.Name})
+}
+
+// ByBuildNumber orders the Builds of an Image by the number the Image
+// assigned them, oldest first. Builds without a number are ordered by their
+// creation time after the numbered Builds.
+type ByBuildNumber []*Build
+
+func (o ByBuildNumber) Len() int      { return len(o) }
+func (o ByBuildNumber) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+
+func (o ByBuildNumber) Less(i, j int) bool {
+	ni, nj := o[i].SequenceNumber(), o[j].SequenceNumber()
+	if ni != nj {
+		if ni == 0 || nj == 0 {
+			return nj == 0
+		}
+		return ni < nj
+	}
+	return o[i].CreationTimestamp.Before(&o[j].CreationTimestamp)
+}
@@ -0,0 +1,98 @@
+package v1alpha2
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestImageBuildOrder(t *testing.T) {
+	spec.Run(t, "Image build order", testImageBuildOrder)
+}
+
+func testImageBuildOrder(t *testing.T, when spec.G, it spec.S) {
+	image := &Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "image-name",
+			Namespace: "some-namespace",
+		},
+	}
+
+	build := func(name, number string, created time.Time) *Build {
+		b := &Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "some-namespace",
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					ImageLabel: image.Name,
+				},
+			},
+		}
+		if number != "" {
+			b.Labels[BuildNumberLabel] = number
+		}
+		return b
+	}
+
+	when("#BuildsSelector", func() {
+		it("selects the builds of the image", func() {
+			assert.True(t, image.BuildsSelector().Matches(labels.Set{ImageLabel: "image-name", BuildNumberLabel: "1"}))
+			assert.False(t, image.BuildsSelector().Matches(labels.Set{ImageLabel: "other-image"}))
+		})
+	})
+
+	when("ByBuildNumber", func() {
+		now := time.Now()
+
+		it("orders builds by build number", func() {
+			builds := []*Build{
+				build("build-10", "10", now),
+				build("build-2", "2", now.Add(time.Minute)),
+				build("build-1", "1", now.Add(2*time.Minute)),
+			}
+
+			sort.Sort(ByBuildNumber(builds))
+
+			assert.Equal(t, []string{"build-1", "build-2", "build-10"}, names(builds))
+		})
+
+		it("orders builds without a number after numbered builds by creation time", func() {
+			builds := []*Build{
+				build("unnumbered-new", "", now.Add(time.Minute)),
+				build("unnumbered-old", "", now),
+				build("build-1", "1", now.Add(2*time.Minute)),
+			}
+
+			sort.Sort(ByBuildNumber(builds))
+
+			assert.Equal(t, []string{"build-1", "unnumbered-old", "unnumbered-new"}, names(builds))
+		})
+
+		it("prefers the sequence number in the status", func() {
+			renumbered := build("renumbered", "1", now)
+			renumbered.Status.SequenceNumber = 3
+			builds := []*Build{
+				renumbered,
+				build("build-2", "2", now.Add(time.Minute)),
+			}
+
+			sort.Sort(ByBuildNumber(builds))
+
+			assert.Equal(t, []string{"build-2", "renumbered"}, names(builds))
+		})
+	})
+}
+
+func names(builds []*Build) []string {
+	var names []string
+	for _, b := range builds {
+		names = append(names, b.Name)
+	}
+	return names
+}
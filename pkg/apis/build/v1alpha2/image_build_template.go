@@ -0,0 +1,98 @@
+package v1alpha2
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WithBuildTemplate returns a copy of the Image with the template's build
+// configuration merged in. Env vars, services and resources set on the Image
+// take precedence over the template's, tolerations are combined and the
+// template cache is only used when the Image does not configure one.
+func (im *Image) WithBuildTemplate(ctx context.Context, template *BuildTemplateSpec) *Image {
+	merged := im.DeepCopy()
+	if template == nil {
+		return merged
+	}
+	template = template.DeepCopy()
+
+	if merged.Spec.Cache == nil {
+		merged.Spec.Cache = template.Cache
+	}
+	merged.setDefaultCache(ctx)
+
+	if merged.Spec.Build == nil {
+		merged.Spec.Build = &ImageBuild{}
+	}
+	build := merged.Spec.Build
+
+	build.Env = mergeEnv(template.Env, build.Env)
+	build.Services = mergeServices(template.Services, build.Services)
+	build.Resources = mergeResources(template.Resources, build.Resources)
+	if len(template.Tolerations) > 0 {
+		build.Tolerations = append(template.Tolerations, build.Tolerations...)
+	}
+
+	return merged
+}
+
+func mergeEnv(base, overrides []corev1.EnvVar) []corev1.EnvVar {
+	if len(base) == 0 {
+		return overrides
+	}
+
+	overridden := map[string]bool{}
+	for _, env := range overrides {
+		overridden[env.Name] = true
+	}
+
+	var merged []corev1.EnvVar
+	for _, env := range base {
+		if !overridden[env.Name] {
+			merged = append(merged, env)
+		}
+	}
+	return append(merged, overrides...)
+}
+
+func mergeServices(base, overrides Services) Services {
+	if len(base) == 0 {
+		return overrides
+	}
+
+	overridden := map[string]bool{}
+	for _, service := range overrides {
+		overridden[service.Name] = true
+	}
+
+	var merged Services
+	for _, service := range base {
+		if !overridden[service.Name] {
+			merged = append(merged, service)
+		}
+	}
+	return append(merged, overrides...)
+}
+
+func mergeResources(base, overrides corev1.ResourceRequirements) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Limits:   mergeResourceList(base.Limits, overrides.Limits),
+		Requests: mergeResourceList(base.Requests, overrides.Requests),
+	}
+}
+
+func mergeResourceList(base, overrides corev1.ResourceList) corev1.ResourceList {
+	if len(base) == 0 {
+		return overrides
+	}
+
+	merged := corev1.ResourceList{}
+	for name, quantity := range base {
+		merged[name] = quantity
+	}
+	for name, quantity := range overrides {
+		merged[name] = quantity
+	}
+	return merged
+}
@@ -0,0 +1,125 @@
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestImageBuildTemplate(t *testing.T) {
+	spec.Run(t, "Image Build Template", testImageBuildTemplate)
+}
+
+func testImageBuildTemplate(t *testing.T, when spec.G, it spec.S) {
+	image := &Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-image",
+			Namespace: "some-namespace",
+		},
+		Spec: ImageSpec{
+			Tag: "some-registry.io/some-image",
+			BuildTemplate: &corev1.ObjectReference{
+				Kind: BuildTemplateKind,
+				Name: "some-template",
+			},
+		},
+	}
+
+	template := &BuildTemplateSpec{
+		Env: []corev1.EnvVar{
+			{Name: "BP_JVM_VERSION", Value: "17"},
+			{Name: "BP_MAVEN_BUILD_ARGUMENTS", Value: "package"},
+		},
+		Services: Services{
+			{Kind: "Secret", APIVersion: "v1", Name: "maven-settings"},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+		Tolerations: []corev1.Toleration{
+			{Key: "builds", Operator: corev1.TolerationOpExists},
+		},
+		Cache: &ImageCacheConfig{
+			Registry: &RegistryCache{Tag: "some-registry.io/cache"},
+		},
+	}
+
+	when("WithBuildTemplate", func() {
+		it("applies the template to an image without build configuration", func() {
+			merged := image.WithBuildTemplate(context.TODO(), template)
+
+			require.NotNil(t, merged.Spec.Build)
+			assert.Equal(t, template.Env, merged.Spec.Build.Env)
+			assert.Equal(t, template.Services, merged.Spec.Build.Services)
+			assert.Equal(t, template.Resources, merged.Spec.Build.Resources)
+			assert.Equal(t, template.Tolerations, merged.Spec.Build.Tolerations)
+			assert.Equal(t, template.Cache, merged.Spec.Cache)
+
+			assert.Nil(t, image.Spec.Build)
+			assert.Nil(t, image.Spec.Cache)
+		})
+
+		it("prefers the image build configuration", func() {
+			image.Spec.Cache = &ImageCacheConfig{
+				Volume: &ImagePersistentVolumeCache{},
+			}
+			image.Spec.Build = &ImageBuild{
+				Env: []corev1.EnvVar{
+					{Name: "BP_JVM_VERSION", Value: "21"},
+				},
+				Services: Services{
+					{Kind: "ConfigMap", APIVersion: "v1", Name: "maven-settings"},
+				},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+				},
+				Tolerations: []corev1.Toleration{
+					{Key: "gpu", Operator: corev1.TolerationOpExists},
+				},
+			}
+
+			merged := image.WithBuildTemplate(context.TODO(), template)
+
+			assert.Equal(t, []corev1.EnvVar{
+				{Name: "BP_MAVEN_BUILD_ARGUMENTS", Value: "package"},
+				{Name: "BP_JVM_VERSION", Value: "21"},
+			}, merged.Spec.Build.Env)
+			assert.Equal(t, Services{
+				{Kind: "ConfigMap", APIVersion: "v1", Name: "maven-settings"},
+			}, merged.Spec.Build.Services)
+			assert.Equal(t, corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			}, merged.Spec.Build.Resources.Requests)
+			assert.Equal(t, []corev1.Toleration{
+				{Key: "builds", Operator: corev1.TolerationOpExists},
+				{Key: "gpu", Operator: corev1.TolerationOpExists},
+			}, merged.Spec.Build.Tolerations)
+			assert.Equal(t, image.Spec.Cache, merged.Spec.Cache)
+
+			assert.Len(t, template.Tolerations, 1)
+			assert.Len(t, image.Spec.Build.Tolerations, 1)
+		})
+
+		it("defaults the cache when neither the image nor the template configure one", func() {
+			template.Cache = nil
+			ctx := context.WithValue(context.TODO(), HasDefaultStorageClass, true)
+
+			merged := image.WithBuildTemplate(ctx, template)
+
+			require.NotNil(t, merged.Spec.Cache)
+			assert.Equal(t, "2G", merged.Spec.Cache.Volume.Size.String())
+		})
+	})
+}
@@ -18,13 +18,16 @@ const (
 	ImageLabel           = "image.kpack.io/image"
 	ImageGenerationLabel = "image.kpack.io/imageGeneration"
 
-	BuildReasonAnnotation  = "image.kpack.io/reason"
-	BuildChangesAnnotation = "image.kpack.io/buildChanges"
-	BuildNeededAnnotation  = "image.kpack.io/additionalBuildNeeded"
+	BuildReasonAnnotation    = "image.kpack.io/reason"
+	BuildChangesAnnotation   = "image.kpack.io/buildChanges"
+	BuildNeededAnnotation    = "image.kpack.io/additionalBuildNeeded"
+	BuildInputHashAnnotation = "image.kpack.io/buildInputHash"
 
 	BuilderNameAnnotation = "image.kpack.io/builderName"
 	BuilderKindAnnotation = "image.kpack.io/builderKind"
 
+	RegistryPrunedAnnotation = "image.kpack.io/registryPruned"
+
 	BuildReasonConfig    = "CONFIG"
 	BuildReasonCommit    = "COMMIT"
 	BuildReasonBuildpack = "BUILDPACK"
@@ -36,7 +39,7 @@ type BuildReason string
 
 func (im *Image) Build(sourceResolver *SourceResolver, builder BuilderResource, latestBuild *Build, reasons, changes string, nextBuildNumber int64, priorityClass string) *Build {
 	buildNumber := strconv.Itoa(int(nextBuildNumber))
-	return &Build{
+	build := &Build{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: im.Namespace,
 			Name:      im.generateBuildName(buildNumber),
@@ -81,8 +84,14 @@ func (im *Image) Build(sourceResolver *SourceResolver, builder BuilderResource,
 			PriorityClassName:     priorityClass,
 			ActiveDeadlineSeconds: im.BuildTimeout(),
 			CreationTime:          im.Spec.creationTime(),
+			FailureRetention:      im.FailureRetention(),
+			Breakpoint:            im.Breakpoint(),
+			RelocateRunImage:      im.RelocateRunImage(),
+			ImageMetadata:         im.ImageMetadata(),
 		},
 	}
+	build.Annotations[BuildInputHashAnnotation] = build.InputHash()
+	return build
 }
 
 func (is *ImageSpec) NeedVolumeCache() bool {
@@ -130,6 +139,15 @@ func (im *Image) LatestForImage(build *Build) string {
 	return im.Status.LatestImage
 }
 
+// LatestRunImageForImage returns the relocated run image of the latest
+// successful build.
+func (im *Image) LatestRunImageForImage(build *Build) string {
+	if build.IsSuccess() {
+		return build.Status.RelocatedRunImage
+	}
+	return im.Status.LatestRunImage
+}
+
 func (im *Image) Services() Services {
 	if im.Spec.Build == nil {
 		return nil
@@ -201,6 +219,34 @@ func (im *Image) SchedulerName() string {
 	return im.Spec.Build.SchedulerName
 }
 
+func (im *Image) FailureRetention() *FailureRetention {
+	if im.Spec.Build == nil {
+		return nil
+	}
+	return im.Spec.Build.FailureRetention
+}
+
+func (im *Image) Breakpoint() string {
+	if im.Spec.Build == nil {
+		return ""
+	}
+	return im.Spec.Build.Breakpoint
+}
+
+func (im *Image) RelocateRunImage() bool {
+	if im.Spec.Build == nil {
+		return false
+	}
+	return im.Spec.Build.RelocateRunImage
+}
+
+func (im *Image) ImageMetadata() map[string]string {
+	if im.Spec.Build == nil {
+		return nil
+	}
+	return im.Spec.Build.ImageMetadata
+}
+
 func (im *Image) CacheName() string {
 	return kmeta.ChildName(im.Name, "-cache")
 }
@@ -131,7 +131,15 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 
 		it("propagates image's annotations onto the build", func() {
 			build := image.Build(sourceResolver, builder, latestBuild, "some-reasons", "some-changes", 27, "")
-			assert.Equal(t, map[string]string{"annotation-key": "annotation-value", "image.kpack.io/buildChanges": "some-changes", "image.kpack.io/reason": "some-reasons", "image.kpack.io/builderKind": "Builder", "image.kpack.io/builderName": "builder-Name"}, build.Annotations)
+			assert.Equal(t, map[string]string{"annotation-key": "annotation-value", "image.kpack.io/buildChanges": "some-changes", "image.kpack.io/reason": "some-reasons", "image.kpack.io/builderKind": "Builder", "image.kpack.io/builderName": "builder-Name", "image.kpack.io/buildInputHash": build.InputHash()}, build.Annotations)
+		})
+
+		it("records the build input hash", func() {
+			build := image.Build(sourceResolver, builder, latestBuild, "some-reasons", "some-changes", 27, "")
+			assert.NotEmpty(t, build.Annotations[BuildInputHashAnnotation])
+
+			otherTag := image.Build(sourceResolver, builder, latestBuild, "some-reasons", "some-changes", 28, "")
+			assert.Equal(t, build.Annotations[BuildInputHashAnnotation], otherTag.Annotations[BuildInputHashAnnotation])
 		})
 
 		it("sets labels from image metadata and propagates image labels", func() {
@@ -359,6 +367,15 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 			assert.Equal(t, "now", build.Spec.CreationTime)
 		})
 
+		it("sets the image metadata when present", func() {
+			image.Spec.Build = &ImageBuild{
+				ImageMetadata: map[string]string{"com.example.team": "payments"},
+			}
+
+			build := image.Build(sourceResolver, builder, latestBuild, "", "", 1, "")
+			assert.Equal(t, map[string]string{"com.example.team": "payments"}, build.Spec.ImageMetadata)
+		})
+
 		it("handles a nil build spec", func() {
 			image.Spec.Build = nil
 
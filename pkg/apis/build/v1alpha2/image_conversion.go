@@ -25,6 +25,7 @@ const (
 	projectDescriptorPathConversionAnnotation = "kpack.io/projectDescriptorPath"
 	cosignAnnotationConversionAnnotation      = "kpack.io/cosignAnnotation"
 	defaultProcessConversionAnnotation        = "kpack.io/defaultProcess"
+	registryGCConversionAnnotation            = "kpack.io/registryGC"
 )
 
 func (i *Image) ConvertTo(_ context.Context, to apis.Convertible) error {
@@ -190,6 +191,14 @@ func (i *Image) convertFromAnnotations(fromAnnotations *map[string]string) error
 		is.DefaultProcess = defaultProcess
 		delete(ia, defaultProcessConversionAnnotation)
 	}
+	if registryGCJson, ok := (*fromAnnotations)[registryGCConversionAnnotation]; ok {
+		var registryGC ImageRegistryGC
+		if err := json.Unmarshal([]byte(registryGCJson), &registryGC); err != nil {
+			return err
+		}
+		is.RegistryGC = &registryGC
+		delete(ia, registryGCConversionAnnotation)
+	}
 	return nil
 }
 
@@ -258,6 +267,13 @@ func (is *ImageSpec) convertToAnnotations(toAnnotations map[string]string) error
 	if is.DefaultProcess != "" {
 		toAnnotations[defaultProcessConversionAnnotation] = is.DefaultProcess
 	}
+	if is.RegistryGC != nil {
+		bytes, err := json.Marshal(is.RegistryGC)
+		if err != nil {
+			return err
+		}
+		toAnnotations[registryGCConversionAnnotation] = string(bytes)
+	}
 	return nil
 }
 
@@ -289,6 +305,7 @@ func (is *ImageSpec) convertFrom(from *v1alpha1.ImageSpec) {
 func (is *ImageStatus) convertFrom(from *v1alpha1.ImageStatus) {
 	is.LatestBuildImageGeneration = from.LatestBuildImageGeneration
 	is.BuildCounter = from.BuildCounter
+	is.BuildNumber = from.BuildCounter
 	is.BuildCacheName = from.BuildCacheName
 	is.LatestBuildReason = from.LatestBuildReason
 	is.LatestBuildRef = from.LatestBuildRef
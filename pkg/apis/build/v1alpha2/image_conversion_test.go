@@ -23,6 +23,7 @@ func testImageConversion(t *testing.T, when spec.G, it spec.S) {
 		cacheSize := resource.MustParse("5G")
 		var buildHistoryLimit int64 = 5
 		var buildTimeout int64 = 7
+		var keepLast int64 = 3
 		runtimeClassName := "some-runtime-class-name"
 		affinity := corev1.Affinity{}
 
@@ -102,6 +103,10 @@ func testImageConversion(t *testing.T, when spec.G, it spec.S) {
 					},
 				},
 				DefaultProcess: "some-default-process",
+				RegistryGC: &ImageRegistryGC{
+					KeepLast: &keepLast,
+					DryRun:   true,
+				},
 			},
 			Status: ImageStatus{
 				Status: corev1alpha1.Status{
@@ -120,6 +125,7 @@ func testImageConversion(t *testing.T, when spec.G, it spec.S) {
 				LatestImage:                "my-repo/my-image",
 				LatestStack:                "io.buildpacks.stacks.full",
 				BuildCounter:               1,
+				BuildNumber:                1,
 				BuildCacheName:             "build-pvc",
 				LatestBuildReason:          "COMMIT",
 			},
@@ -140,6 +146,7 @@ func testImageConversion(t *testing.T, when spec.G, it spec.S) {
 					"kpack.io/projectDescriptorPath":         "some-project-descriptor-path",
 					"kpack.io/cosignAnnotation":              `[{"name":"some-cosign-name","value":"some-cosign-value"}]`,
 					"kpack.io/defaultProcess":                "some-default-process",
+					"kpack.io/registryGC":                    `{"keepLast":3,"dryRun":true}`,
 				},
 			},
 			Spec: v1alpha1.ImageSpec{
@@ -294,6 +301,7 @@ func testImageConversion(t *testing.T, when spec.G, it spec.S) {
 			v1alpha2Image.Spec.ProjectDescriptorPath = ""
 			v1alpha2Image.Spec.Cosign = nil
 			v1alpha2Image.Spec.DefaultProcess = ""
+			v1alpha2Image.Spec.RegistryGC = nil
 
 			testV1alpha1Image := &v1alpha1.Image{}
 			err := v1alpha2Image.ConvertTo(context.TODO(), testV1alpha1Image)
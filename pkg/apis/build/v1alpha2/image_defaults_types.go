@@ -0,0 +1,50 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	ImageDefaultsKind   = "ImageDefaults"
+	ImageDefaultsCRName = "imagedefaults.kpack.io"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// ImageDefaults holds configuration the webhook sets on Images created in
+// its namespace that do not configure it themselves.
+// +k8s:openapi-gen=true
+type ImageDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ImageDefaultsSpec `json:"spec"`
+}
+
+// +k8s:openapi-gen=true
+type ImageDefaultsSpec struct {
+	Builder                  *corev1.ObjectReference `json:"builder,omitempty"`
+	ServiceAccountName       string                  `json:"serviceAccountName,omitempty"`
+	Cache                    *ImageCacheConfig       `json:"cache,omitempty"`
+	FailedBuildHistoryLimit  *int64                  `json:"failedBuildHistoryLimit,omitempty"`
+	SuccessBuildHistoryLimit *int64                  `json:"successBuildHistoryLimit,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type ImageDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []ImageDefaults `json:"items"`
+}
+
+func (*ImageDefaults) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(ImageDefaultsKind)
+}
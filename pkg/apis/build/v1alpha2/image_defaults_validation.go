@@ -0,0 +1,75 @@
+package v1alpha2
+
+import (
+	"context"
+	"sort"
+
+	"knative.dev/pkg/apis"
+)
+
+// ImageDefaultsLookup is set on the context by the webhook to find the
+// ImageDefaults of the namespace of an Image being defaulted.
+const ImageDefaultsLookup ImageContextKey = "imageDefaultsLookup"
+
+// ImageDefaultsLookupFunc returns the ImageDefaults in a namespace.
+type ImageDefaultsLookupFunc func(namespace string) []*ImageDefaults
+
+func (d *ImageDefaults) SetDefaults(context.Context) {
+}
+
+func (d *ImageDefaults) Validate(ctx context.Context) *apis.FieldError {
+	return d.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (s *ImageDefaultsSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if s.Builder != nil {
+		errs = errs.Also(validateBuilder(*s.Builder).ViaField("builder"))
+	}
+	if s.FailedBuildHistoryLimit != nil && *s.FailedBuildHistoryLimit < 1 {
+		errs = errs.Also(apis.ErrGeneric("build history limit must be greater than 0", "failedBuildHistoryLimit"))
+	}
+	if s.SuccessBuildHistoryLimit != nil && *s.SuccessBuildHistoryLimit < 1 {
+		errs = errs.Also(apis.ErrGeneric("build history limit must be greater than 0", "successBuildHistoryLimit"))
+	}
+	return errs.Also(s.Cache.Validate(ctx).ViaField("cache"))
+}
+
+// applyImageDefaults sets the fields the Image does not configure from the
+// ImageDefaults of its namespace. When several ImageDefaults set a field the
+// one with the lowest name wins.
+func (i *Image) applyImageDefaults(ctx context.Context) {
+	lookup, ok := ctx.Value(ImageDefaultsLookup).(ImageDefaultsLookupFunc)
+	if !ok {
+		return
+	}
+
+	defaults := lookup(i.Namespace)
+	sort.Slice(defaults, func(a, b int) bool {
+		return defaults[a].Name < defaults[b].Name
+	})
+
+	for _, d := range defaults {
+		spec := d.Spec.DeepCopy()
+
+		if i.Spec.Builder.Name == "" && spec.Builder != nil {
+			i.Spec.Builder = *spec.Builder
+		}
+
+		if i.Spec.ServiceAccountName == "" {
+			i.Spec.ServiceAccountName = spec.ServiceAccountName
+		}
+
+		if i.Spec.Cache == nil && i.Spec.BuildTemplate == nil {
+			i.Spec.Cache = spec.Cache
+		}
+
+		if i.Spec.FailedBuildHistoryLimit == nil {
+			i.Spec.FailedBuildHistoryLimit = spec.FailedBuildHistoryLimit
+		}
+
+		if i.Spec.SuccessBuildHistoryLimit == nil {
+			i.Spec.SuccessBuildHistoryLimit = spec.SuccessBuildHistoryLimit
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestImageDefaultsValidation(t *testing.T) {
+	spec.Run(t, "Image Defaults Validation", testImageDefaultsValidation)
+}
+
+func testImageDefaultsValidation(t *testing.T, when spec.G, it spec.S) {
+	failedLimit := int64(3)
+	successLimit := int64(5)
+
+	defaults := &ImageDefaults{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-defaults",
+			Namespace: "some-namespace",
+		},
+		Spec: ImageDefaultsSpec{
+			Builder: &corev1.ObjectReference{
+				Kind: ClusterBuilderKind,
+				Name: "default-builder",
+			},
+			ServiceAccountName: "builds",
+			Cache: &ImageCacheConfig{
+				Registry: &RegistryCache{Tag: "some-registry.io/cache"},
+			},
+			FailedBuildHistoryLimit:  &failedLimit,
+			SuccessBuildHistoryLimit: &successLimit,
+		},
+	}
+
+	when("Validate", func() {
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, defaults.Validate(context.TODO()))
+		})
+
+		it("returns nil on an empty spec", func() {
+			defaults.Spec = ImageDefaultsSpec{}
+			assert.Nil(t, defaults.Validate(context.TODO()))
+		})
+
+		it("invalid builder", func() {
+			defaults.Spec.Builder.Kind = "FakeBuilder"
+			err := defaults.Validate(context.TODO())
+			assert.EqualError(t, err, apis.ErrInvalidValue("FakeBuilder", "spec.builder.kind").Error())
+		})
+
+		it("history limits less than 1", func() {
+			zero := int64(0)
+			defaults.Spec.FailedBuildHistoryLimit = &zero
+			err := defaults.Validate(context.TODO())
+			assert.EqualError(t, err, apis.ErrGeneric("build history limit must be greater than 0", "spec.failedBuildHistoryLimit").Error())
+		})
+	})
+
+	when("applied to an Image", func() {
+		image := &Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-image",
+				Namespace: "some-namespace",
+			},
+			Spec: ImageSpec{
+				Tag: "some-registry.io/some-image",
+			},
+		}
+
+		var lookedUp string
+		ctx := context.WithValue(context.TODO(), ImageDefaultsLookup, ImageDefaultsLookupFunc(func(namespace string) []*ImageDefaults {
+			lookedUp = namespace
+			return []*ImageDefaults{defaults}
+		}))
+
+		it("sets the fields the image does not configure", func() {
+			image.SetDefaults(ctx)
+
+			assert.Equal(t, "some-namespace", lookedUp)
+			assert.Equal(t, *defaults.Spec.Builder, image.Spec.Builder)
+			assert.Equal(t, "builds", image.Spec.ServiceAccountName)
+			assert.Equal(t, defaults.Spec.Cache, image.Spec.Cache)
+			assert.Equal(t, int64(3), *image.Spec.FailedBuildHistoryLimit)
+			assert.Equal(t, int64(5), *image.Spec.SuccessBuildHistoryLimit)
+		})
+
+		it("prefers the configuration of the image", func() {
+			limit := int64(1)
+			image.Spec.Builder = corev1.ObjectReference{Kind: BuilderKind, Name: "some-builder"}
+			image.Spec.ServiceAccountName = "some-sa"
+			image.Spec.FailedBuildHistoryLimit = &limit
+
+			image.SetDefaults(ctx)
+
+			assert.Equal(t, corev1.ObjectReference{Kind: BuilderKind, Name: "some-builder"}, image.Spec.Builder)
+			assert.Equal(t, "some-sa", image.Spec.ServiceAccountName)
+			assert.Equal(t, int64(1), *image.Spec.FailedBuildHistoryLimit)
+			assert.Equal(t, int64(5), *image.Spec.SuccessBuildHistoryLimit)
+		})
+
+		it("leaves the cache to a referenced build template", func() {
+			image.Spec.BuildTemplate = &corev1.ObjectReference{Kind: BuildTemplateKind, Name: "some-template"}
+
+			image.SetDefaults(ctx)
+
+			assert.Nil(t, image.Spec.Cache)
+		})
+
+		it("prefers the ImageDefaults with the lowest name", func() {
+			other := defaults.DeepCopy()
+			other.Name = "a-defaults"
+			other.Spec.ServiceAccountName = "other-sa"
+			other.Spec.Builder = nil
+
+			ctx := context.WithValue(context.TODO(), ImageDefaultsLookup, ImageDefaultsLookupFunc(func(string) []*ImageDefaults {
+				return []*ImageDefaults{defaults, other}
+			}))
+			image.SetDefaults(ctx)
+
+			assert.Equal(t, "other-sa", image.Spec.ServiceAccountName)
+			assert.Equal(t, "default-builder", image.Spec.Builder.Name)
+		})
+
+		it("falls back to the built in defaults", func() {
+			ctx := context.WithValue(context.TODO(), ImageDefaultsLookup, ImageDefaultsLookupFunc(func(string) []*ImageDefaults {
+				return nil
+			}))
+			image.SetDefaults(ctx)
+
+			assert.Equal(t, "default", image.Spec.ServiceAccountName)
+			assert.Equal(t, int64(10), *image.Spec.FailedBuildHistoryLimit)
+		})
+	})
+}
@@ -10,8 +10,11 @@ import (
 )
 
 const (
-	BuilderNotFound = "BuilderNotFound"
-	BuilderNotReady = "BuilderNotReady"
+	BuilderNotFound   = "BuilderNotFound"
+	BuilderNotReady   = "BuilderNotReady"
+	BuilderNotGranted = "BuilderNotGranted"
+
+	BuildTemplateNotFound = "BuildTemplateNotFound"
 )
 
 func (im *Image) BuilderNotFound() corev1alpha1.Conditions {
@@ -25,3 +28,27 @@ func (im *Image) BuilderNotFound() corev1alpha1.Conditions {
 		},
 	}
 }
+
+func (im *Image) BuilderNotGranted() corev1alpha1.Conditions {
+	return corev1alpha1.Conditions{
+		{
+			Type:               corev1alpha1.ConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             BuilderNotGranted,
+			Message:            fmt.Sprintf("No BuilderGrant in namespace %s allows namespace %s to use builder %s.", im.Spec.Builder.Namespace, im.Namespace, im.Spec.Builder.Name),
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
+
+func (im *Image) BuildTemplateNotFound() corev1alpha1.Conditions {
+	return corev1alpha1.Conditions{
+		{
+			Type:               corev1alpha1.ConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             BuildTemplateNotFound,
+			Message:            fmt.Sprintf("Unable to find %s %s.", im.Spec.BuildTemplate.Kind, im.Spec.BuildTemplate.Name),
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
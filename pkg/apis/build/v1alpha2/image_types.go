@@ -55,9 +55,12 @@ type ImageSpec struct {
 	ImageTaggingStrategy     corev1alpha1.ImageTaggingStrategy `json:"imageTaggingStrategy,omitempty"`
 	ProjectDescriptorPath    string                            `json:"projectDescriptorPath,omitempty"`
 	Build                    *ImageBuild                       `json:"build,omitempty"`
+	BuildTemplate            *corev1.ObjectReference           `json:"buildTemplate,omitempty"`
 	Notary                   *corev1alpha1.NotaryConfig        `json:"notary,omitempty"`
 	Cosign                   *CosignConfig                     `json:"cosign,omitempty"`
 	DefaultProcess           string                            `json:"defaultProcess,omitempty"`
+	RegistryGC               *ImageRegistryGC                  `json:"registryGC,omitempty"`
+	DriftDetection           *ImageDriftDetection              `json:"driftDetection,omitempty"`
 	// +listType
 	AdditionalTags []string `json:"additionalTags,omitempty"`
 }
@@ -79,6 +82,37 @@ type ImageBuild struct {
 	SchedulerName    string              `json:"schedulerName,omitempty"`
 	BuildTimeout     *int64              `json:"buildTimeout,omitempty"`
 	CreationTime     string              `json:"creationTime,omitempty"`
+	FailureRetention *FailureRetention   `json:"failureRetention,omitempty"`
+	Breakpoint       string              `json:"breakpoint,omitempty"`
+	// RelocateRunImage copies the run image into the repository of the
+	// image so runtime clusters only need pull access to one registry.
+	RelocateRunImage bool `json:"relocateRunImage,omitempty"`
+	// NetworkPolicy restricts the egress of build pods. It takes precedence
+	// over the build network policy of the namespace.
+	NetworkPolicy *BuildNetworkPolicy `json:"networkPolicy,omitempty"`
+	// ImageMetadata is stamped on the built image as labels and written to
+	// /workspace/.kpack/metadata.json together with the build info so
+	// processes in the image can read it.
+	ImageMetadata map[string]string `json:"imageMetadata,omitempty"`
+}
+
+// BuildNetworkPolicy lists the destinations build pods may reach, such as git
+// hosts, registries and the proxy. DNS is always allowed.
+// +k8s:openapi-gen=true
+type BuildNetworkPolicy struct {
+	// +listType
+	Egress []BuildNetworkEgress `json:"egress,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type BuildNetworkEgress struct {
+	CIDR string `json:"cidr"`
+	// +listType
+	Except []string `json:"except,omitempty"`
+	// Ports limits the egress to these TCP ports. All ports are allowed when
+	// empty.
+	// +listType
+	Ports []int32 `json:"ports,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -98,6 +132,32 @@ type RegistryCache struct {
 	Tag string `json:"tag"`
 }
 
+// +k8s:openapi-gen=true
+type ImageRegistryGC struct {
+	KeepLast *int64 `json:"keepLast,omitempty"`
+	TTLHours *int64 `json:"ttlHours,omitempty"`
+	DryRun   bool   `json:"dryRun,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type ImageDriftDetection struct {
+	// IntervalSeconds is how often the tag is compared with the latest
+	// image. Defaults to 300.
+	IntervalSeconds int64 `json:"intervalSeconds,omitempty"`
+	// Action is taken when the tag was overwritten outside of kpack: Report
+	// only sets the Drifted condition, Repush pushes the latest image to
+	// the tag again and Rebuild schedules a new build. Defaults to Report.
+	Action DriftAction `json:"action,omitempty"`
+}
+
+type DriftAction string
+
+const (
+	DriftActionReport  DriftAction = "Report"
+	DriftActionRepush  DriftAction = "Repush"
+	DriftActionRebuild DriftAction = "Rebuild"
+)
+
 // +k8s:openapi-gen=true
 type ImageBuilder struct {
 	metav1.TypeMeta `json:",inline"`
@@ -107,13 +167,30 @@ type ImageBuilder struct {
 // +k8s:openapi-gen=true
 type ImageStatus struct {
 	corev1alpha1.Status        `json:",inline"`
-	LatestBuildRef             string `json:"latestBuildRef,omitempty"`
-	LatestBuildImageGeneration int64  `json:"latestBuildImageGeneration,omitempty"`
-	LatestImage                string `json:"latestImage,omitempty"`
-	LatestStack                string `json:"latestStack,omitempty"`
-	BuildCounter               int64  `json:"buildCounter,omitempty"`
-	BuildCacheName             string `json:"buildCacheName,omitempty"`
-	LatestBuildReason          string `json:"latestBuildReason,omitempty"`
+	LatestBuildRef             string                 `json:"latestBuildRef,omitempty"`
+	LatestBuildImageGeneration int64                  `json:"latestBuildImageGeneration,omitempty"`
+	LatestImage                string                 `json:"latestImage,omitempty"`
+	LatestStack                string                 `json:"latestStack,omitempty"`
+	BuildCounter               int64                  `json:"buildCounter,omitempty"`
+	BuildCacheName             string                 `json:"buildCacheName,omitempty"`
+	LatestBuildReason          string                 `json:"latestBuildReason,omitempty"`
+	RegistryGC                 *ImageRegistryGCStatus `json:"registryGC,omitempty"`
+	// BuildNumber is the number of the latest Build created for the Image.
+	// It increases by one with every Build and never decreases, even when
+	// Builds are pruned or deleted.
+	BuildNumber int64 `json:"buildNumber,omitempty"`
+	// LatestRunImage is the run image of the latest image relocated into
+	// the repository of the image.
+	LatestRunImage string `json:"latestRunImage,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type ImageRegistryGCStatus struct {
+	// +listType
+	PrunedImages []string `json:"prunedImages,omitempty"`
+	// +listType
+	PrunableImages []string `json:"prunableImages,omitempty"`
+	Message        string   `json:"message,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -136,3 +213,7 @@ func (i *Image) NamespacedName() types.NamespacedName {
 }
 
 const ConditionBuilderReady corev1alpha1.ConditionType = "BuilderReady"
+
+// ConditionDrifted is True when the tag of an Image no longer points at the
+// latest image kpack pushed.
+const ConditionDrifted corev1alpha1.ConditionType = "Drifted"
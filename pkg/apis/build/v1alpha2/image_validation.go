@@ -3,10 +3,10 @@ package v1alpha2
 import (
 	"context"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 
-	"github.com/google/go-containerregistry/pkg/name"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -27,6 +27,7 @@ var (
 	defaultFailedBuildHistoryLimit     int64 = 10
 	defaultSuccessfulBuildHistoryLimit int64 = 10
 	defaultCacheSize                   resource.Quantity
+	defaultDriftIntervalSeconds        int64 = 300
 )
 
 func init() {
@@ -34,6 +35,8 @@ func init() {
 }
 
 func (i *Image) SetDefaults(ctx context.Context) {
+	i.applyImageDefaults(ctx)
+
 	if i.Spec.ServiceAccountName == "" {
 		i.Spec.ServiceAccountName = "default"
 	}
@@ -50,6 +53,23 @@ func (i *Image) SetDefaults(ctx context.Context) {
 		i.Spec.SuccessBuildHistoryLimit = &defaultSuccessfulBuildHistoryLimit
 	}
 
+	if i.Spec.DriftDetection != nil {
+		if i.Spec.DriftDetection.IntervalSeconds == 0 {
+			i.Spec.DriftDetection.IntervalSeconds = defaultDriftIntervalSeconds
+		}
+		if i.Spec.DriftDetection.Action == "" {
+			i.Spec.DriftDetection.Action = DriftActionReport
+		}
+	}
+
+	// the cache of an Image referencing a build template is defaulted
+	// when the template is applied
+	if i.Spec.BuildTemplate == nil {
+		i.setDefaultCache(ctx)
+	}
+}
+
+func (i *Image) setDefaultCache(ctx context.Context) {
 	if i.Spec.Cache == nil && ctx.Value(HasDefaultStorageClass) != nil {
 		i.Spec.Cache = &ImageCacheConfig{
 			Volume: &ImagePersistentVolumeCache{
@@ -86,11 +106,14 @@ func (is *ImageSpec) ValidateSpec(ctx context.Context) *apis.FieldError {
 		Also(validateBuilder(is.Builder).ViaField("builder")).
 		Also(is.Source.Validate(ctx).ViaField("source")).
 		Also(is.Build.Validate(ctx).ViaField("build")).
+		Also(validateBuildTemplate(is.BuildTemplate).ViaField("buildTemplate")).
 		Also(is.Cache.Validate(ctx).ViaField("cache")).
 		Also(is.validateVolumeCache(ctx)).
 		Also(validateNotary(ctx, is.Notary).ViaField("notary")).
 		Also(is.Cosign.Validate(ctx).ViaField("cosign")).
-		Also(is.validateBuildHistoryLimit())
+		Also(is.validateBuildHistoryLimit()).
+		Also(is.validateRegistryGC(ctx).ViaField("registryGC")).
+		Also(is.DriftDetection.Validate(ctx).ViaField("driftDetection"))
 }
 
 func (is *ImageSpec) validateTag(ctx context.Context) *apis.FieldError {
@@ -103,28 +126,7 @@ func (is *ImageSpec) validateTag(ctx context.Context) *apis.FieldError {
 }
 
 func (is *ImageSpec) validateAdditionalTags(ctx context.Context) *apis.FieldError {
-	return validate.Tags(is.AdditionalTags, "additionalTags").Also(is.validateSameRegistry())
-}
-
-func (is *ImageSpec) validateSameRegistry() *apis.FieldError {
-	tag, err := name.NewTag(is.Tag, name.WeakValidation)
-	// We only care about the non-nil error cases here as we validate
-	// the tag validity in other methods which should display appropriate errors.
-	if err == nil {
-		for _, t := range is.AdditionalTags {
-			addT, err := name.NewTag(t, name.WeakValidation)
-			if err == nil {
-				if addT.RegistryStr() != tag.RegistryStr() {
-					return &apis.FieldError{
-						Message: "all additionalTags must have the same registry as tag",
-						Paths:   []string{"additionalTags"},
-						Details: fmt.Sprintf("expected registry: %s, got: %s", tag.RegistryStr(), addT.RegistryStr()),
-					}
-				}
-			}
-		}
-	}
-	return nil
+	return validate.Tags(is.AdditionalTags, "additionalTags")
 }
 
 func (is *ImageSpec) validateVolumeCache(ctx context.Context) *apis.FieldError {
@@ -176,7 +178,45 @@ func (ib *ImageBuild) Validate(ctx context.Context) *apis.FieldError {
 	}
 
 	return ib.Services.Validate(ctx).ViaField("services").
-		Also(validateCnbBindings(ctx, ib.CNBBindings).ViaField("cnbBindings"))
+		Also(validateCnbBindings(ctx, ib.CNBBindings).ViaField("cnbBindings")).
+		Also(ib.FailureRetention.Validate(ctx).ViaField("failureRetention")).
+		Also(validateBreakpoint(ib.Breakpoint)).
+		Also(ib.NetworkPolicy.Validate(ctx).ViaField("networkPolicy")).
+		Also(validateImageMetadata(ib.ImageMetadata).ViaField("imageMetadata"))
+}
+
+func (p *BuildNetworkPolicy) Validate(context.Context) *apis.FieldError {
+	if p == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+	for i, egress := range p.Egress {
+		errs = errs.Also(egress.validate().ViaFieldIndex("egress", i))
+	}
+	return errs
+}
+
+func (e BuildNetworkEgress) validate() *apis.FieldError {
+	var errs *apis.FieldError
+	if e.CIDR == "" {
+		errs = errs.Also(apis.ErrMissingField("cidr"))
+	} else if _, _, err := net.ParseCIDR(e.CIDR); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(e.CIDR, "cidr"))
+	}
+
+	for i, except := range e.Except {
+		if _, _, err := net.ParseCIDR(except); err != nil {
+			errs = errs.Also(apis.ErrInvalidArrayValue(except, "except", i))
+		}
+	}
+
+	for i, port := range e.Ports {
+		if port < 1 || port > 65535 {
+			errs = errs.Also(apis.ErrInvalidArrayValue(port, "ports", i))
+		}
+	}
+	return errs
 }
 
 func validateBuilder(builder v1.ObjectReference) *apis.FieldError {
@@ -185,14 +225,38 @@ func validateBuilder(builder v1.ObjectReference) *apis.FieldError {
 	}
 
 	switch builder.Kind {
-	case BuilderKind,
-		ClusterBuilderKind:
+	case BuilderKind:
+		return nil
+	case ClusterBuilderKind:
+		if builder.Namespace != "" {
+			return apis.ErrDisallowedFields("namespace")
+		}
 		return nil
 	default:
 		return apis.ErrInvalidValue(builder.Kind, "kind")
 	}
 }
 
+func validateBuildTemplate(template *v1.ObjectReference) *apis.FieldError {
+	if template == nil {
+		return nil
+	}
+
+	if template.Name == "" {
+		return apis.ErrMissingField("name")
+	}
+
+	switch template.Kind {
+	case BuildTemplateKind, ClusterBuildTemplateKind:
+		if template.Namespace != "" {
+			return apis.ErrDisallowedFields("namespace")
+		}
+		return nil
+	default:
+		return apis.ErrInvalidValue(template.Kind, "kind")
+	}
+}
+
 func (is *ImageSpec) validateBuildHistoryLimit() *apis.FieldError {
 	errMsg := "build history limit must be greater than 0"
 
@@ -205,6 +269,60 @@ func (is *ImageSpec) validateBuildHistoryLimit() *apis.FieldError {
 	return nil
 }
 
+func (is *ImageSpec) validateRegistryGC(ctx context.Context) *apis.FieldError {
+	if err := is.RegistryGC.Validate(ctx); err != nil {
+		return err
+	}
+
+	if is.RegistryGC != nil && is.RegistryGC.KeepLast != nil && is.SuccessBuildHistoryLimit != nil &&
+		*is.RegistryGC.KeepLast > *is.SuccessBuildHistoryLimit {
+		return apis.ErrGeneric("keepLast cannot be greater than successBuildHistoryLimit", "keepLast")
+	}
+	return nil
+}
+
+func (d *ImageDriftDetection) Validate(context.Context) *apis.FieldError {
+	if d == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+	if d.IntervalSeconds < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(d.IntervalSeconds, "intervalSeconds"))
+	}
+
+	switch d.Action {
+	case "", DriftActionReport, DriftActionRepush, DriftActionRebuild:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(d.Action, "action"))
+	}
+	return errs
+}
+
+func (gc *ImageRegistryGC) Validate(ctx context.Context) *apis.FieldError {
+	if gc == nil {
+		return nil
+	}
+
+	if gc.KeepLast != nil && gc.TTLHours != nil {
+		return apis.ErrMultipleOneOf("keepLast", "ttlHours")
+	}
+
+	if gc.KeepLast == nil && gc.TTLHours == nil {
+		return apis.ErrMissingOneOf("keepLast", "ttlHours")
+	}
+
+	if gc.KeepLast != nil && *gc.KeepLast < 1 {
+		return apis.ErrInvalidValue(*gc.KeepLast, "keepLast")
+	}
+
+	if gc.TTLHours != nil && *gc.TTLHours < 1 {
+		return apis.ErrInvalidValue(*gc.TTLHours, "ttlHours")
+	}
+
+	return nil
+}
+
 func (c *ImageCacheConfig) Validate(ctx context.Context) *apis.FieldError {
 	if c != nil && c.Volume != nil && c.Registry != nil {
 		return apis.ErrGeneric("only one type of cache can be specified", "volume", "registry")
@@ -90,6 +90,14 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 			assert.Equal(t, image.Spec.ImageTaggingStrategy, corev1alpha1.BuildNumber)
 		})
 
+		it("defaults the drift detection interval and action", func() {
+			image.Spec.DriftDetection = &ImageDriftDetection{}
+
+			image.SetDefaults(ctx)
+
+			assert.Equal(t, &ImageDriftDetection{IntervalSeconds: 300, Action: DriftActionReport}, image.Spec.DriftDetection)
+		})
+
 		it("defaults SuccessBuildHistoryLimit,FailedBuildHistoryLimit to 10", func() {
 			image.Spec.SuccessBuildHistoryLimit = nil
 			image.Spec.FailedBuildHistoryLimit = nil
@@ -119,6 +127,15 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 					assert.Nil(t, image.Spec.Cache)
 				})
 			})
+
+			when("the image references a build template", func() {
+				it("leaves the cache to the template", func() {
+					image.Spec.BuildTemplate = &corev1.ObjectReference{Kind: BuildTemplateKind, Name: "some-template"}
+					image.SetDefaults(ctx)
+
+					assert.Nil(t, image.Spec.Cache)
+				})
+			})
 		})
 
 		when("registry cache is provided", func() {
@@ -173,7 +190,7 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 
 		it("tags from multiple registries", func() {
 			image.Spec.AdditionalTags = []string{"valid/tag", "gcr.io/valid/tag"}
-			assertValidationError(image, ctx, errors.New("all additionalTags must have the same registry as tag: spec.additionalTags\nexpected registry: index.docker.io, got: gcr.io"))
+			assert.Nil(t, image.Validate(ctx))
 		})
 
 		it("tag does not contain fully qualified digest", func() {
@@ -192,6 +209,34 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 			assertValidationError(image, ctx, apis.ErrInvalidValue("FakeBuilder", "kind").ViaField("spec", "builder"))
 		})
 
+		it("allows a Builder in another namespace", func() {
+			image.Spec.Builder.Kind = BuilderKind
+			image.Spec.Builder.Namespace = "platform"
+			assert.Nil(t, image.Validate(ctx))
+		})
+
+		it("namespace on a ClusterBuilder", func() {
+			image.Spec.Builder.Kind = ClusterBuilderKind
+			image.Spec.Builder.Namespace = "platform"
+			assertValidationError(image, ctx, apis.ErrDisallowedFields("namespace").ViaField("spec", "builder"))
+		})
+
+		it("allows a build template reference", func() {
+			image.Spec.BuildTemplate = &corev1.ObjectReference{Kind: ClusterBuildTemplateKind, Name: "some-template"}
+			assert.Nil(t, image.Validate(ctx))
+		})
+
+		it("invalid build template reference", func() {
+			image.Spec.BuildTemplate = &corev1.ObjectReference{Kind: "Template", Name: "some-template"}
+			assertValidationError(image, ctx, apis.ErrInvalidValue("Template", "kind").ViaField("spec", "buildTemplate"))
+
+			image.Spec.BuildTemplate = &corev1.ObjectReference{Kind: BuildTemplateKind}
+			assertValidationError(image, ctx, apis.ErrMissingField("name").ViaField("spec", "buildTemplate"))
+
+			image.Spec.BuildTemplate = &corev1.ObjectReference{Kind: BuildTemplateKind, Name: "some-template", Namespace: "platform"}
+			assertValidationError(image, ctx, apis.ErrDisallowedFields("namespace").ViaField("spec", "buildTemplate"))
+		})
+
 		it("multiple sources", func() {
 			image.Spec.Source.Git = &corev1alpha1.Git{
 				URL:      "http://github.com/repo",
@@ -232,6 +277,16 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 			assertValidationError(image, ctx, apis.ErrMissingField("revision").ViaField("spec", "source", "git"))
 		})
 
+		it("validates git compatibility mode", func() {
+			image.Spec.Source.Git = &corev1alpha1.Git{
+				URL:               "http://github.com/url",
+				Revision:          "master",
+				CompatibilityMode: "go-git",
+			}
+
+			assertValidationError(image, ctx, apis.ErrInvalidValue("go-git", "compatibilityMode").ViaField("spec", "source", "git"))
+		})
+
 		it("validates blob url", func() {
 			image.Spec.Source.Git = nil
 			image.Spec.Source.Blob = &corev1alpha1.Blob{URL: ""}
@@ -280,6 +335,49 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
+		it("validates the breakpoint", func() {
+			image.Spec.Build.Breakpoint = "before build"
+			assert.Nil(t, image.Validate(ctx))
+
+			image.Spec.Build.Breakpoint = "during build"
+			assertValidationError(image, ctx, apis.ErrInvalidValue("during build", "breakpoint", "breakpoint must be 'before <step>' or 'after <step>'").ViaField("spec", "build"))
+
+			image.Spec.Build.Breakpoint = "after completion"
+			assertValidationError(image, ctx, apis.ErrInvalidValue("after completion", "breakpoint", "breakpoint step must be one of prepare, detect, analyze, restore, build, export").ViaField("spec", "build"))
+		})
+
+		it("validates image metadata keys are not reserved by kpack", func() {
+			image.Spec.Build.ImageMetadata = map[string]string{"com.example.team": "payments"}
+			assert.Nil(t, image.Validate(ctx))
+
+			image.Spec.Build.ImageMetadata = map[string]string{"io.kpack.build.name": "some-name"}
+			assertValidationError(image, ctx, apis.ErrInvalidKeyName("io.kpack.build.name", "imageMetadata", "key is empty or reserved by kpack").ViaField("spec", "build"))
+		})
+
+		it("validates failure retention ttl is positive", func() {
+			image.Spec.Build.FailureRetention = &FailureRetention{TTLSeconds: 0}
+			assertValidationError(image, ctx, apis.ErrInvalidValue(int64(0), "ttlSeconds").ViaField("spec", "build", "failureRetention"))
+
+			image.Spec.Build.FailureRetention.TTLSeconds = 3600
+			assert.Nil(t, image.Validate(ctx))
+		})
+
+		it("validates the network policy", func() {
+			image.Spec.Build.NetworkPolicy = &BuildNetworkPolicy{
+				Egress: []BuildNetworkEgress{
+					{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}, Ports: []int32{443}},
+				},
+			}
+			assert.Nil(t, image.Validate(ctx))
+
+			image.Spec.Build.NetworkPolicy.Egress[0].CIDR = ""
+			assertValidationError(image, ctx, apis.ErrMissingField("cidr").ViaFieldIndex("egress", 0).ViaField("spec", "build", "networkPolicy"))
+
+			image.Spec.Build.NetworkPolicy.Egress[0].CIDR = "10.0.0.0/8"
+			image.Spec.Build.NetworkPolicy.Egress[0].Ports = []int32{0}
+			assertValidationError(image, ctx, apis.ErrInvalidArrayValue(int32(0), "ports", 0).ViaFieldIndex("egress", 0).ViaField("spec", "build", "networkPolicy"))
+		})
+
 		it("image name is too long", func() {
 			image.ObjectMeta.Name = "this-image-name-that-is-too-long-some-sha-that-is-long-82cb521d636b282340378d80a6307a08e3d4a4c4"
 			assertValidationError(image, ctx, errors.New("invalid image name: this-image-name-that-is-too-long-some-sha-that-is-long-82cb521d636b282340378d80a6307a08e3d4a4c4, name must be a a valid label: metadata.name\nmust be no more than 63 characters"))
@@ -546,5 +644,73 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 			image.Spec.Build.NodeSelector = map[string]string{k8sOSLabel: "some-os"}
 			assertValidationError(image, ctx, apis.ErrInvalidKeyName(k8sOSLabel, "spec.build.nodeSelector", "os is determined automatically"))
 		})
+
+		when("validating drift detection", func() {
+			it("allows the supported actions", func() {
+				for _, action := range []DriftAction{DriftActionReport, DriftActionRepush, DriftActionRebuild} {
+					image.Spec.DriftDetection = &ImageDriftDetection{IntervalSeconds: 60, Action: action}
+					assert.Nil(t, image.Validate(ctx))
+				}
+			})
+
+			it("does not allow a negative interval", func() {
+				image.Spec.DriftDetection = &ImageDriftDetection{IntervalSeconds: -1}
+				assertValidationError(image, ctx, apis.ErrInvalidValue(int64(-1), "intervalSeconds").ViaField("spec", "driftDetection"))
+			})
+
+			it("does not allow unknown actions", func() {
+				image.Spec.DriftDetection = &ImageDriftDetection{Action: "Delete"}
+				assertValidationError(image, ctx, apis.ErrInvalidValue(DriftAction("Delete"), "action").ViaField("spec", "driftDetection"))
+			})
+		})
+
+		when("validating the registry gc policy", func() {
+			it("handles nil registry gc", func() {
+				image.Spec.RegistryGC = nil
+				assert.Nil(t, image.Validate(ctx))
+			})
+
+			it("allows keepLast", func() {
+				keepLast := int64(3)
+				image.Spec.RegistryGC = &ImageRegistryGC{KeepLast: &keepLast}
+				assert.Nil(t, image.Validate(ctx))
+			})
+
+			it("allows ttlHours", func() {
+				ttlHours := int64(72)
+				image.Spec.RegistryGC = &ImageRegistryGC{TTLHours: &ttlHours}
+				assert.Nil(t, image.Validate(ctx))
+			})
+
+			it("requires keepLast or ttlHours", func() {
+				image.Spec.RegistryGC = &ImageRegistryGC{DryRun: true}
+				assertValidationError(image, ctx, apis.ErrMissingOneOf("keepLast", "ttlHours").ViaField("spec", "registryGC"))
+			})
+
+			it("does not allow both keepLast and ttlHours", func() {
+				keepLast := int64(3)
+				ttlHours := int64(72)
+				image.Spec.RegistryGC = &ImageRegistryGC{KeepLast: &keepLast, TTLHours: &ttlHours}
+				assertValidationError(image, ctx, apis.ErrMultipleOneOf("keepLast", "ttlHours").ViaField("spec", "registryGC"))
+			})
+
+			it("requires keepLast to be greater than 0", func() {
+				keepLast := int64(0)
+				image.Spec.RegistryGC = &ImageRegistryGC{KeepLast: &keepLast}
+				assertValidationError(image, ctx, apis.ErrInvalidValue(keepLast, "keepLast").ViaField("spec", "registryGC"))
+			})
+
+			it("requires ttlHours to be greater than 0", func() {
+				ttlHours := int64(0)
+				image.Spec.RegistryGC = &ImageRegistryGC{TTLHours: &ttlHours}
+				assertValidationError(image, ctx, apis.ErrInvalidValue(ttlHours, "ttlHours").ViaField("spec", "registryGC"))
+			})
+
+			it("does not allow keepLast greater than successBuildHistoryLimit", func() {
+				keepLast := limit + 1
+				image.Spec.RegistryGC = &ImageRegistryGC{KeepLast: &keepLast}
+				assertValidationError(image, ctx, apis.ErrGeneric("keepLast cannot be greater than successBuildHistoryLimit", "spec.registryGC.keepLast"))
+			})
+		})
 	})
 }
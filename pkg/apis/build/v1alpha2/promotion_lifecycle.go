@@ -0,0 +1,31 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const PromotionReasonBuildNotSucceeded = "BuildNotSucceeded"
+
+func (p *Promotion) Promoted(sourceImage string) bool {
+	return p.Status.GetCondition(corev1alpha1.ConditionReady).IsTrue() &&
+		p.Status.ObservedGeneration == p.Generation &&
+		p.Status.SourceImage == sourceImage
+}
+
+func (ps *PromotionStatus) Waiting(generation int64, message string) {
+	ps.Status = corev1alpha1.Status{
+		ObservedGeneration: generation,
+		Conditions: corev1alpha1.Conditions{
+			{
+				Type:               corev1alpha1.ConditionReady,
+				Status:             corev1.ConditionUnknown,
+				Reason:             PromotionReasonBuildNotSucceeded,
+				Message:            message,
+				LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+			},
+		},
+	}
+}
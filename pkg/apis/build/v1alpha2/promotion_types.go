@@ -0,0 +1,59 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	PromotionKind   = "Promotion"
+	PromotionCRName = "promotions.kpack.io"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type Promotion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PromotionSpec   `json:"spec"`
+	Status            PromotionStatus `json:"status,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type PromotionSpec struct {
+	Build              corev1.LocalObjectReference `json:"build"`
+	Tag                string                      `json:"tag"`
+	ServiceAccountName string                      `json:"serviceAccountName,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type PromotionStatus struct {
+	corev1alpha1.Status `json:",inline"`
+	SourceImage         string `json:"sourceImage,omitempty"`
+	LatestImage         string `json:"latestImage,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type PromotionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []Promotion `json:"items"`
+}
+
+func (*Promotion) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(PromotionKind)
+}
+
+func (p *Promotion) NamespacedName() types.NamespacedName {
+	return types.NamespacedName{Namespace: p.Namespace, Name: p.Name}
+}
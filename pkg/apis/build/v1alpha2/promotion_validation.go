@@ -0,0 +1,27 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/validate"
+)
+
+func (p *Promotion) SetDefaults(context.Context) {
+	if p.Spec.ServiceAccountName == "" {
+		p.Spec.ServiceAccountName = "default"
+	}
+}
+
+func (p *Promotion) Validate(ctx context.Context) *apis.FieldError {
+	return p.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ps *PromotionSpec) Validate(ctx context.Context) *apis.FieldError {
+	if ps.Build.Name == "" {
+		return apis.ErrMissingField("name").ViaField("build")
+	}
+
+	return validate.Tag(ps.Tag)
+}
@@ -0,0 +1,71 @@
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestPromotionValidation(t *testing.T) {
+	spec.Run(t, "Promotion Validation", testPromotionValidation)
+}
+
+func testPromotionValidation(t *testing.T, when spec.G, it spec.S) {
+	promotion := &Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-promotion",
+			Namespace: "some-namespace",
+		},
+		Spec: PromotionSpec{
+			Build: corev1.LocalObjectReference{Name: "some-build"},
+			Tag:   "some-other-registry.io/production/app",
+		},
+	}
+
+	when("Default", func() {
+		it("defaults service account to default", func() {
+			promotion.SetDefaults(context.TODO())
+
+			assert.Equal(t, "default", promotion.Spec.ServiceAccountName)
+		})
+
+		it("does not overwrite a provided service account", func() {
+			promotion.Spec.ServiceAccountName = "some-sa"
+			promotion.SetDefaults(context.TODO())
+
+			assert.Equal(t, "some-sa", promotion.Spec.ServiceAccountName)
+		})
+	})
+
+	when("Validate", func() {
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, promotion.Validate(context.TODO()))
+		})
+
+		assertValidationError := func(promotion *Promotion, expectedError *apis.FieldError) {
+			t.Helper()
+			err := promotion.Validate(context.TODO())
+			assert.EqualError(t, err, expectedError.Error())
+		}
+
+		it("missing build name", func() {
+			promotion.Spec.Build.Name = ""
+			assertValidationError(promotion, apis.ErrMissingField("name").ViaField("build").ViaField("spec"))
+		})
+
+		it("missing tag", func() {
+			promotion.Spec.Tag = ""
+			assertValidationError(promotion, apis.ErrMissingField("tag").ViaField("spec"))
+		})
+
+		it("invalid tag", func() {
+			promotion.Spec.Tag = "ftp//invalid/tag@@"
+			assertValidationError(promotion, apis.ErrInvalidValue(promotion.Spec.Tag, "tag").ViaField("spec"))
+		})
+	})
+}
@@ -54,6 +54,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ImageList{},
 		&SourceResolver{},
 		&SourceResolverList{},
+		&Stack{},
+		&StackList{},
+		&Store{},
+		&StoreList{},
 		&ClusterStack{},
 		&ClusterStackList{},
 		&ClusterStore{},
@@ -63,6 +67,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterBuilderList{},
 		&Builder{},
 		&BuilderList{},
+		&BuilderGrant{},
+		&BuilderGrantList{},
+		&BuildQuota{},
+		&BuildQuotaList{},
+		&BuildTemplate{},
+		&BuildTemplateList{},
+		&ClusterBuildTemplate{},
+		&ClusterBuildTemplateList{},
+		&ImageDefaults{},
+		&ImageDefaultsList{},
+		&Promotion{},
+		&PromotionList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
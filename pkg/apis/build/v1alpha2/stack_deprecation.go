@@ -0,0 +1,50 @@
+package v1alpha2
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	ConditionStackDeprecated corev1alpha1.ConditionType = "StackDeprecated"
+
+	StackDeprecatedReason = "StackDeprecated"
+	StackEndOfLifeReason  = "StackEndOfLife"
+)
+
+// EndOfLifeReached reports whether the end of life of the stack has passed.
+func (d *StackDeprecation) EndOfLifeReached(now time.Time) bool {
+	return d.EndOfLife != nil && !now.Before(d.EndOfLife.Time)
+}
+
+// StackDeprecatedCondition returns a warning condition for resources that
+// use the deprecated stack.
+func StackDeprecatedCondition(stackId string, deprecation *StackDeprecation, now time.Time) corev1alpha1.Condition {
+	reason := StackDeprecatedReason
+	message := fmt.Sprintf("stack %s is deprecated", stackId)
+	if deprecation.EndOfLife != nil {
+		if deprecation.EndOfLifeReached(now) {
+			reason = StackEndOfLifeReason
+			message = fmt.Sprintf("stack %s reached its end of life on %s", stackId, deprecation.EndOfLife.UTC().Format(time.RFC3339))
+		} else {
+			message = fmt.Sprintf("stack %s is deprecated and reaches its end of life on %s", stackId, deprecation.EndOfLife.UTC().Format(time.RFC3339))
+		}
+	}
+	if deprecation.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, deprecation.Message)
+	}
+
+	return corev1alpha1.Condition{
+		Type:               ConditionStackDeprecated,
+		Status:             corev1.ConditionTrue,
+		Severity:           corev1alpha1.ConditionSeverityWarning,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: corev1alpha1.VolatileTime{Inner: v1.Now()},
+	}
+}
@@ -0,0 +1,87 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	StackKind   = "Stack"
+	StackCRName = "stacks.kpack.io"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// +k8s:openapi-gen=true
+type Stack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackSpec   `json:"spec"`
+	Status StackStatus `json:"status"`
+}
+
+// +k8s:openapi-gen=true
+type StackSpec struct {
+	Id                 string                `json:"id,omitempty"`
+	BuildImage         ClusterStackSpecImage `json:"buildImage,omitempty"`
+	RunImage           ClusterStackSpecImage `json:"runImage,omitempty"`
+	ServiceAccountName string                `json:"serviceAccountName,omitempty"`
+	// RelaxMixinValidation allows builders to be created with buildpacks
+	// that require mixins the stack does not declare.
+	RelaxMixinValidation bool `json:"relaxMixinValidation,omitempty"`
+	// Deprecation marks the stack as deprecated.
+	Deprecation *StackDeprecation `json:"deprecation,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type StackStatus struct {
+	corev1alpha1.Status  `json:",inline"`
+	ResolvedClusterStack `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type StackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []Stack `json:"items"`
+}
+
+func (*Stack) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(StackKind)
+}
+
+func (s *Stack) NamespacedName() types.NamespacedName {
+	return types.NamespacedName{Namespace: s.Namespace, Name: s.Name}
+}
+
+// ClusterStack returns the stack as a ClusterStack so that builders can be
+// created from either stack scope. The service account is carried over as a
+// serviceAccountRef in the stack's namespace.
+func (s *Stack) ClusterStack() *ClusterStack {
+	return &ClusterStack{
+		TypeMeta:   s.TypeMeta,
+		ObjectMeta: s.ObjectMeta,
+		Spec: ClusterStackSpec{
+			Id:         s.Spec.Id,
+			BuildImage: s.Spec.BuildImage,
+			RunImage:   s.Spec.RunImage,
+			ServiceAccountRef: &corev1.ObjectReference{
+				Name:      s.Spec.ServiceAccountName,
+				Namespace: s.Namespace,
+			},
+			RelaxMixinValidation: s.Spec.RelaxMixinValidation,
+			Deprecation:          s.Spec.Deprecation,
+		},
+		Status: ClusterStackStatus(s.Status),
+	}
+}
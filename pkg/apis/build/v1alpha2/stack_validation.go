@@ -0,0 +1,26 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/validate"
+)
+
+func (s *Stack) SetDefaults(context.Context) {
+	if s.Spec.ServiceAccountName == "" {
+		s.Spec.ServiceAccountName = "default"
+	}
+}
+
+func (s *Stack) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ss *StackSpec) Validate(ctx context.Context) *apis.FieldError {
+	return validate.FieldNotEmpty(ss.Id, "id").
+		Also(ss.BuildImage.Validate(ctx).ViaField("buildImage")).
+		Also(ss.RunImage.Validate(ctx).ViaField("runImage")).
+		Also(validate.FieldNotEmpty(ss.ServiceAccountName, "serviceAccountName"))
+}
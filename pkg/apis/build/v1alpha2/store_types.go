@@ -0,0 +1,79 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	StoreKind   = "Store"
+	StoreCRName = "stores.kpack.io"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// +k8s:openapi-gen=true
+type Store struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StoreSpec   `json:"spec"`
+	Status StoreStatus `json:"status"`
+}
+
+// +k8s:openapi-gen=true
+type StoreSpec struct {
+	// +listType
+	Sources            []corev1alpha1.ImageSource `json:"sources,omitempty"`
+	ServiceAccountName string                     `json:"serviceAccountName,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type StoreStatus struct {
+	corev1alpha1.Status `json:",inline"`
+
+	// +listType
+	Buildpacks []corev1alpha1.BuildpackStatus `json:"buildpacks,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type StoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []Store `json:"items"`
+}
+
+func (*Store) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(StoreKind)
+}
+
+func (s *Store) NamespacedName() types.NamespacedName {
+	return types.NamespacedName{Namespace: s.Namespace, Name: s.Name}
+}
+
+// ClusterStore returns the store as a ClusterStore so that builders can
+// resolve buildpacks from either store scope. The service account is carried
+// over as a serviceAccountRef in the store's namespace.
+func (s *Store) ClusterStore() *ClusterStore {
+	return &ClusterStore{
+		TypeMeta:   s.TypeMeta,
+		ObjectMeta: s.ObjectMeta,
+		Spec: ClusterStoreSpec{
+			Sources: s.Spec.Sources,
+			ServiceAccountRef: &corev1.ObjectReference{
+				Name:      s.Spec.ServiceAccountName,
+				Namespace: s.Namespace,
+			},
+		},
+		Status: ClusterStoreStatus(s.Status),
+	}
+}
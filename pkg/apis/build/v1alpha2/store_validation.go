@@ -0,0 +1,35 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/validate"
+)
+
+func (s *Store) SetDefaults(context.Context) {
+	if s.Spec.ServiceAccountName == "" {
+		s.Spec.ServiceAccountName = "default"
+	}
+}
+
+func (s *Store) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (s *StoreSpec) Validate(ctx context.Context) *apis.FieldError {
+	if len(s.Sources) == 0 {
+		return apis.ErrMissingField("sources")
+	}
+	var errors *apis.FieldError = nil
+	for i, source := range s.Sources {
+		_, err := name.ParseReference(source.Image, name.WeakValidation)
+		if err != nil {
+			//noinspection GoNilness
+			errors = errors.Also(apis.ErrInvalidArrayValue(source, "sources", i))
+		}
+	}
+	return errors.Also(validate.FieldNotEmpty(s.ServiceAccountName, "serviceAccountName"))
+}
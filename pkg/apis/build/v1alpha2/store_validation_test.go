@@ -0,0 +1,77 @@
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+func TestStoreValidation(t *testing.T) {
+	spec.Run(t, "Store Validation", testStoreValidation)
+}
+
+func testStoreValidation(t *testing.T, when spec.G, it spec.S) {
+	store := &Store{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "store-name",
+			Namespace: "store-namespace",
+		},
+		Spec: StoreSpec{
+			Sources: []corev1alpha1.ImageSource{
+				{
+					Image: "some-registry.io/store-image-1@sha256:78c1b9419976227e05be9d243b7fa583bea44a5258e52018b2af4cdfe23d148d",
+				},
+				{
+					Image: "some-registry.io/store-image-2@sha256:78c1b9419976227e05be9d243b7fa583bea44a5258e52018b2af4cdfe23d148d",
+				},
+			},
+			ServiceAccountName: "some-sa",
+		},
+	}
+
+	when("Default", func() {
+		it("defaults service account name to default", func() {
+			store.Spec.ServiceAccountName = ""
+
+			store.SetDefaults(context.TODO())
+
+			assert.Equal(t, "default", store.Spec.ServiceAccountName)
+		})
+	})
+
+	when("Validate", func() {
+		assertValidationError := func(store *Store, expectedError *apis.FieldError) {
+			t.Helper()
+			err := store.Validate(context.TODO())
+			assert.EqualError(t, err, expectedError.Error())
+		}
+
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, store.Validate(context.TODO()))
+		})
+
+		it("missing sources", func() {
+			store.Spec.Sources = nil
+
+			assertValidationError(store, apis.ErrMissingField("sources").ViaField("spec"))
+		})
+
+		it("invalid source image", func() {
+			store.Spec.Sources = append(store.Spec.Sources, corev1alpha1.ImageSource{Image: "@INVALID!"})
+
+			assertValidationError(store, apis.ErrInvalidArrayValue(corev1alpha1.ImageSource{Image: "@INVALID!"}, "sources", 2).ViaField("spec"))
+		})
+
+		it("missing service account name", func() {
+			store.Spec.ServiceAccountName = ""
+
+			assertValidationError(store, apis.ErrMissingField("serviceAccountName").ViaField("spec"))
+		})
+	})
+}
@@ -131,6 +131,55 @@ func (in *BuildList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildNetworkEgress) DeepCopyInto(out *BuildNetworkEgress) {
+	*out = *in
+	if in.Except != nil {
+		in, out := &in.Except, &out.Except
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildNetworkEgress.
+func (in *BuildNetworkEgress) DeepCopy() *BuildNetworkEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildNetworkEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildNetworkPolicy) DeepCopyInto(out *BuildNetworkPolicy) {
+	*out = *in
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]BuildNetworkEgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildNetworkPolicy.
+func (in *BuildNetworkPolicy) DeepCopy() *BuildNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildPersistentVolumeCache) DeepCopyInto(out *BuildPersistentVolumeCache) {
 	*out = *in
@@ -184,6 +233,105 @@ func (in *BuildPodImages) DeepCopy() *BuildPodImages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuota) DeepCopyInto(out *BuildQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuota.
+func (in *BuildQuota) DeepCopy() *BuildQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *BuildQuota) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuotaList) DeepCopyInto(out *BuildQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BuildQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuotaList.
+func (in *BuildQuotaList) DeepCopy() *BuildQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuotaSpec) DeepCopyInto(out *BuildQuotaSpec) {
+	*out = *in
+	if in.MaxConcurrentBuilds != nil {
+		in, out := &in.MaxConcurrentBuilds, &out.MaxConcurrentBuilds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxBuildsPerHour != nil {
+		in, out := &in.MaxBuildsPerHour, &out.MaxBuildsPerHour
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxCacheSize != nil {
+		in, out := &in.MaxCacheSize, &out.MaxCacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuotaSpec.
+func (in *BuildQuotaSpec) DeepCopy() *BuildQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 	*out = *in
@@ -264,6 +412,18 @@ func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailureRetention != nil {
+		in, out := &in.FailureRetention, &out.FailureRetention
+		*out = new(FailureRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageMetadata != nil {
+		in, out := &in.ImageMetadata, &out.ImageMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -319,6 +479,11 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 		copy(*out, *in)
 	}
 	out.Stack = in.Stack
+	if in.PushedImages != nil {
+		in, out := &in.PushedImages, &out.PushedImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.StepStates != nil {
 		in, out := &in.StepStates, &out.StepStates
 		*out = make([]v1.ContainerState, len(*in))
@@ -331,6 +496,33 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CacheMetrics != nil {
+		in, out := &in.CacheMetrics, &out.CacheMetrics
+		*out = new(v1alpha1.BuildCacheMetrics)
+		**out = **in
+	}
+	if in.PushedTags != nil {
+		in, out := &in.PushedTags, &out.PushedTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageLabels != nil {
+		in, out := &in.ImageLabels, &out.ImageLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]BuildStepReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = new(v1alpha1.BuildResourceUsage)
+		**out = **in
+	}
 	return
 }
 
@@ -345,27 +537,42 @@ func (in *BuildStatus) DeepCopy() *BuildStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Builder) DeepCopyInto(out *Builder) {
+func (in *BuildStepReference) DeepCopyInto(out *BuildStepReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildStepReference.
+func (in *BuildStepReference) DeepCopy() *BuildStepReference {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildStepReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildTemplate) DeepCopyInto(out *BuildTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Builder.
-func (in *Builder) DeepCopy() *Builder {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildTemplate.
+func (in *BuildTemplate) DeepCopy() *BuildTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(Builder)
+	out := new(BuildTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
-func (in *Builder) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+func (in *BuildTemplate) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -373,7 +580,7 @@ func (in *Builder) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Builder) DeepCopyObject() runtime.Object {
+func (in *BuildTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -381,31 +588,13 @@ func (in *Builder) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuilderBuildpackRef) DeepCopyInto(out *BuilderBuildpackRef) {
-	*out = *in
-	out.BuildpackRef = in.BuildpackRef
-	out.ObjectReference = in.ObjectReference
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderBuildpackRef.
-func (in *BuilderBuildpackRef) DeepCopy() *BuilderBuildpackRef {
-	if in == nil {
-		return nil
-	}
-	out := new(BuilderBuildpackRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuilderList) DeepCopyInto(out *BuilderList) {
+func (in *BuildTemplateList) DeepCopyInto(out *BuildTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Builder, len(*in))
+		*out = make([]BuildTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -413,18 +602,18 @@ func (in *BuilderList) DeepCopyInto(out *BuilderList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderList.
-func (in *BuilderList) DeepCopy() *BuilderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildTemplateList.
+func (in *BuildTemplateList) DeepCopy() *BuildTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(BuilderList)
+	out := new(BuildTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BuilderList) DeepCopyObject() runtime.Object {
+func (in *BuildTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -432,132 +621,121 @@ func (in *BuilderList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuilderOrderEntry) DeepCopyInto(out *BuilderOrderEntry) {
+func (in *BuildTemplateSpec) DeepCopyInto(out *BuildTemplateSpec) {
 	*out = *in
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = make([]BuilderBuildpackRef, len(*in))
-		copy(*out, *in)
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderOrderEntry.
-func (in *BuilderOrderEntry) DeepCopy() *BuilderOrderEntry {
-	if in == nil {
-		return nil
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(BuilderOrderEntry)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuilderRecord) DeepCopyInto(out *BuilderRecord) {
-	*out = *in
-	out.Stack = in.Stack
-	if in.Buildpacks != nil {
-		in, out := &in.Buildpacks, &out.Buildpacks
-		*out = make(v1alpha1.BuildpackMetadataList, len(*in))
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make(Services, len(*in))
 		copy(*out, *in)
 	}
-	if in.Order != nil {
-		in, out := &in.Order, &out.Order
-		*out = make([]v1alpha1.OrderEntry, len(*in))
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(ImageCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderRecord.
-func (in *BuilderRecord) DeepCopy() *BuilderRecord {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildTemplateSpec.
+func (in *BuildTemplateSpec) DeepCopy() *BuildTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BuilderRecord)
+	out := new(BuildTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuilderSpec) DeepCopyInto(out *BuilderSpec) {
+func (in *Builder) DeepCopyInto(out *Builder) {
 	*out = *in
-	out.Stack = in.Stack
-	out.Store = in.Store
-	if in.Order != nil {
-		in, out := &in.Order, &out.Order
-		*out = make([]BuilderOrderEntry, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderSpec.
-func (in *BuilderSpec) DeepCopy() *BuilderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Builder.
+func (in *Builder) DeepCopy() *Builder {
 	if in == nil {
 		return nil
 	}
-	out := new(BuilderSpec)
+	out := new(Builder)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuilderStatus) DeepCopyInto(out *BuilderStatus) {
-	*out = *in
-	in.Status.DeepCopyInto(&out.Status)
-	if in.BuilderMetadata != nil {
-		in, out := &in.BuilderMetadata, &out.BuilderMetadata
-		*out = make(v1alpha1.BuildpackMetadataList, len(*in))
-		copy(*out, *in)
-	}
-	if in.Order != nil {
-		in, out := &in.Order, &out.Order
-		*out = make([]v1alpha1.OrderEntry, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *Builder) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out.Stack = in.Stack
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Builder) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuilderBuildpackRef) DeepCopyInto(out *BuilderBuildpackRef) {
+	*out = *in
+	out.BuildpackRef = in.BuildpackRef
+	out.ObjectReference = in.ObjectReference
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderStatus.
-func (in *BuilderStatus) DeepCopy() *BuilderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderBuildpackRef.
+func (in *BuilderBuildpackRef) DeepCopy() *BuilderBuildpackRef {
 	if in == nil {
 		return nil
 	}
-	out := new(BuilderStatus)
+	out := new(BuilderBuildpackRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Buildpack) DeepCopyInto(out *Buildpack) {
+func (in *BuilderGrant) DeepCopyInto(out *BuilderGrant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Buildpack.
-func (in *Buildpack) DeepCopy() *Buildpack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderGrant.
+func (in *BuilderGrant) DeepCopy() *BuilderGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(Buildpack)
+	out := new(BuilderGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
-func (in *Buildpack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+func (in *BuilderGrant) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -565,7 +743,7 @@ func (in *Buildpack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Buildpack) DeepCopyObject() runtime.Object {
+func (in *BuilderGrant) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -573,13 +751,29 @@ func (in *Buildpack) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildpackList) DeepCopyInto(out *BuildpackList) {
+func (in *BuilderGrantFrom) DeepCopyInto(out *BuilderGrantFrom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderGrantFrom.
+func (in *BuilderGrantFrom) DeepCopy() *BuilderGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(BuilderGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuilderGrantList) DeepCopyInto(out *BuilderGrantList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Buildpack, len(*in))
+		*out = make([]BuilderGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -587,18 +781,18 @@ func (in *BuildpackList) DeepCopyInto(out *BuildpackList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackList.
-func (in *BuildpackList) DeepCopy() *BuildpackList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderGrantList.
+func (in *BuilderGrantList) DeepCopy() *BuilderGrantList {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildpackList)
+	out := new(BuilderGrantList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BuildpackList) DeepCopyObject() runtime.Object {
+func (in *BuilderGrantList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -606,90 +800,55 @@ func (in *BuildpackList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildpackSpec) DeepCopyInto(out *BuildpackSpec) {
+func (in *BuilderGrantSpec) DeepCopyInto(out *BuilderGrantSpec) {
 	*out = *in
-	out.ImageSource = in.ImageSource
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackSpec.
-func (in *BuildpackSpec) DeepCopy() *BuildpackSpec {
-	if in == nil {
-		return nil
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]BuilderGrantFrom, len(*in))
+		copy(*out, *in)
 	}
-	out := new(BuildpackSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildpackStatus) DeepCopyInto(out *BuildpackStatus) {
-	*out = *in
-	in.Status.DeepCopyInto(&out.Status)
-	if in.Buildpacks != nil {
-		in, out := &in.Buildpacks, &out.Buildpacks
-		*out = make([]v1alpha1.BuildpackStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]BuilderGrantTo, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackStatus.
-func (in *BuildpackStatus) DeepCopy() *BuildpackStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderGrantSpec.
+func (in *BuilderGrantSpec) DeepCopy() *BuilderGrantSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildpackStatus)
+	out := new(BuilderGrantSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBuilder) DeepCopyInto(out *ClusterBuilder) {
+func (in *BuilderGrantTo) DeepCopyInto(out *BuilderGrantTo) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuilder.
-func (in *ClusterBuilder) DeepCopy() *ClusterBuilder {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderGrantTo.
+func (in *BuilderGrantTo) DeepCopy() *BuilderGrantTo {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBuilder)
+	out := new(BuilderGrantTo)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
-func (in *ClusterBuilder) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterBuilder) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBuilderList) DeepCopyInto(out *ClusterBuilderList) {
+func (in *BuilderList) DeepCopyInto(out *BuilderList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterBuilder, len(*in))
+		*out = make([]Builder, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -697,18 +856,18 @@ func (in *ClusterBuilderList) DeepCopyInto(out *ClusterBuilderList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuilderList.
-func (in *ClusterBuilderList) DeepCopy() *ClusterBuilderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderList.
+func (in *BuilderList) DeepCopy() *BuilderList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBuilderList)
+	out := new(BuilderList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterBuilderList) DeepCopyObject() runtime.Object {
+func (in *BuilderList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -716,160 +875,217 @@ func (in *ClusterBuilderList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBuilderSpec) DeepCopyInto(out *ClusterBuilderSpec) {
+func (in *BuilderOrderEntry) DeepCopyInto(out *BuilderOrderEntry) {
 	*out = *in
-	in.BuilderSpec.DeepCopyInto(&out.BuilderSpec)
-	out.ServiceAccountRef = in.ServiceAccountRef
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]BuilderBuildpackRef, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuilderSpec.
-func (in *ClusterBuilderSpec) DeepCopy() *ClusterBuilderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderOrderEntry.
+func (in *BuilderOrderEntry) DeepCopy() *BuilderOrderEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBuilderSpec)
+	out := new(BuilderOrderEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBuildpack) DeepCopyInto(out *ClusterBuildpack) {
+func (in *BuilderRecord) DeepCopyInto(out *BuilderRecord) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Stack = in.Stack
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = make(v1alpha1.BuildpackMetadataList, len(*in))
+		copy(*out, *in)
+	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]v1alpha1.OrderEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MixinMismatches != nil {
+		in, out := &in.MixinMismatches, &out.MixinMismatches
+		*out = make([]BuildpackMixinMismatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StackDeprecation != nil {
+		in, out := &in.StackDeprecation, &out.StackDeprecation
+		*out = new(StackDeprecation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildpack.
-func (in *ClusterBuildpack) DeepCopy() *ClusterBuildpack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderRecord.
+func (in *BuilderRecord) DeepCopy() *BuilderRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBuildpack)
+	out := new(BuilderRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
-func (in *ClusterBuildpack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterBuildpack) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBuildpackList) DeepCopyInto(out *ClusterBuildpackList) {
+func (in *BuilderRevision) DeepCopyInto(out *BuilderRevision) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ClusterBuildpack, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BuilderMetadata != nil {
+		in, out := &in.BuilderMetadata, &out.BuilderMetadata
+		*out = make(v1alpha1.BuildpackMetadataList, len(*in))
+		copy(*out, *in)
 	}
+	out.Stack = in.Stack
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildpackList.
-func (in *ClusterBuildpackList) DeepCopy() *ClusterBuildpackList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderRevision.
+func (in *BuilderRevision) DeepCopy() *BuilderRevision {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBuildpackList)
+	out := new(BuilderRevision)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterBuildpackList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBuildpackSpec) DeepCopyInto(out *ClusterBuildpackSpec) {
+func (in *BuilderRollout) DeepCopyInto(out *BuilderRollout) {
 	*out = *in
-	out.ImageSource = in.ImageSource
-	if in.ServiceAccountRef != nil {
-		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
-		*out = new(v1.ObjectReference)
-		**out = **in
-	}
+	in.CanarySelector.DeepCopyInto(&out.CanarySelector)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildpackSpec.
-func (in *ClusterBuildpackSpec) DeepCopy() *ClusterBuildpackSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderRollout.
+func (in *BuilderRollout) DeepCopy() *BuilderRollout {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBuildpackSpec)
+	out := new(BuilderRollout)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterBuildpackStatus) DeepCopyInto(out *ClusterBuildpackStatus) {
+func (in *BuilderRolloutStatus) DeepCopyInto(out *BuilderRolloutStatus) {
 	*out = *in
-	in.Status.DeepCopyInto(&out.Status)
-	if in.Buildpacks != nil {
-		in, out := &in.Buildpacks, &out.Buildpacks
-		*out = make([]v1alpha1.BuildpackStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.Stable.DeepCopyInto(&out.Stable)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildpackStatus.
-func (in *ClusterBuildpackStatus) DeepCopy() *ClusterBuildpackStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderRolloutStatus.
+func (in *BuilderRolloutStatus) DeepCopy() *BuilderRolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterBuildpackStatus)
+	out := new(BuilderRolloutStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStack) DeepCopyInto(out *ClusterStack) {
+func (in *BuilderSpec) DeepCopyInto(out *BuilderSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Stack = in.Stack
+	out.Store = in.Store
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]BuilderOrderEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStack.
-func (in *ClusterStack) DeepCopy() *ClusterStack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderSpec.
+func (in *BuilderSpec) DeepCopy() *BuilderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStack)
+	out := new(BuilderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
-func (in *ClusterStack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuilderStatus) DeepCopyInto(out *BuilderStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.BuilderMetadata != nil {
+		in, out := &in.BuilderMetadata, &out.BuilderMetadata
+		*out = make(v1alpha1.BuildpackMetadataList, len(*in))
+		copy(*out, *in)
+	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]v1alpha1.OrderEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Stack = in.Stack
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(BuilderRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MixinMismatches != nil {
+		in, out := &in.MixinMismatches, &out.MixinMismatches
+		*out = make([]BuildpackMixinMismatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StackDeprecation != nil {
+		in, out := &in.StackDeprecation, &out.StackDeprecation
+		*out = new(StackDeprecation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderStatus.
+func (in *BuilderStatus) DeepCopy() *BuilderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BuilderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Buildpack) DeepCopyInto(out *Buildpack) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Buildpack.
+func (in *Buildpack) DeepCopy() *Buildpack {
+	if in == nil {
+		return nil
+	}
+	out := new(Buildpack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *Buildpack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -877,7 +1093,7 @@ func (in *ClusterStack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterStack) DeepCopyObject() runtime.Object {
+func (in *Buildpack) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -885,13 +1101,13 @@ func (in *ClusterStack) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStackList) DeepCopyInto(out *ClusterStackList) {
+func (in *BuildpackList) DeepCopyInto(out *BuildpackList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterStack, len(*in))
+		*out = make([]Buildpack, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -899,18 +1115,18 @@ func (in *ClusterStackList) DeepCopyInto(out *ClusterStackList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackList.
-func (in *ClusterStackList) DeepCopy() *ClusterStackList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackList.
+func (in *BuildpackList) DeepCopy() *BuildpackList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStackList)
+	out := new(BuildpackList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterStackList) DeepCopyObject() runtime.Object {
+func (in *BuildpackList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -918,100 +1134,89 @@ func (in *ClusterStackList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStackSpec) DeepCopyInto(out *ClusterStackSpec) {
+func (in *BuildpackMixinMismatch) DeepCopyInto(out *BuildpackMixinMismatch) {
 	*out = *in
-	out.BuildImage = in.BuildImage
-	out.RunImage = in.RunImage
-	if in.ServiceAccountRef != nil {
-		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
-		*out = new(v1.ObjectReference)
-		**out = **in
+	out.BuildpackInfo = in.BuildpackInfo
+	if in.MissingMixins != nil {
+		in, out := &in.MissingMixins, &out.MissingMixins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackSpec.
-func (in *ClusterStackSpec) DeepCopy() *ClusterStackSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackMixinMismatch.
+func (in *BuildpackMixinMismatch) DeepCopy() *BuildpackMixinMismatch {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStackSpec)
+	out := new(BuildpackMixinMismatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStackSpecImage) DeepCopyInto(out *ClusterStackSpecImage) {
+func (in *BuildpackSpec) DeepCopyInto(out *BuildpackSpec) {
 	*out = *in
+	out.ImageSource = in.ImageSource
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackSpecImage.
-func (in *ClusterStackSpecImage) DeepCopy() *ClusterStackSpecImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackSpec.
+func (in *BuildpackSpec) DeepCopy() *BuildpackSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStackSpecImage)
+	out := new(BuildpackSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStackStatus) DeepCopyInto(out *ClusterStackStatus) {
+func (in *BuildpackStatus) DeepCopyInto(out *BuildpackStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	in.ResolvedClusterStack.DeepCopyInto(&out.ResolvedClusterStack)
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackStatus.
-func (in *ClusterStackStatus) DeepCopy() *ClusterStackStatus {
-	if in == nil {
-		return nil
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = make([]v1alpha1.BuildpackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(ClusterStackStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStackStatusImage) DeepCopyInto(out *ClusterStackStatusImage) {
-	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackStatusImage.
-func (in *ClusterStackStatusImage) DeepCopy() *ClusterStackStatusImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackStatus.
+func (in *BuildpackStatus) DeepCopy() *BuildpackStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStackStatusImage)
+	out := new(BuildpackStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStore) DeepCopyInto(out *ClusterStore) {
+func (in *ClusterBuildTemplate) DeepCopyInto(out *ClusterBuildTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStore.
-func (in *ClusterStore) DeepCopy() *ClusterStore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildTemplate.
+func (in *ClusterBuildTemplate) DeepCopy() *ClusterBuildTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStore)
+	out := new(ClusterBuildTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
-func (in *ClusterStore) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+func (in *ClusterBuildTemplate) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1019,7 +1224,7 @@ func (in *ClusterStore) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterStore) DeepCopyObject() runtime.Object {
+func (in *ClusterBuildTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1027,13 +1232,13 @@ func (in *ClusterStore) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStoreList) DeepCopyInto(out *ClusterStoreList) {
+func (in *ClusterBuildTemplateList) DeepCopyInto(out *ClusterBuildTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterStore, len(*in))
+		*out = make([]ClusterBuildTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1041,18 +1246,18 @@ func (in *ClusterStoreList) DeepCopyInto(out *ClusterStoreList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStoreList.
-func (in *ClusterStoreList) DeepCopy() *ClusterStoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildTemplateList.
+func (in *ClusterBuildTemplateList) DeepCopy() *ClusterBuildTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStoreList)
+	out := new(ClusterBuildTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterStoreList) DeepCopyObject() runtime.Object {
+func (in *ClusterBuildTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1060,38 +1265,49 @@ func (in *ClusterStoreList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStoreSpec) DeepCopyInto(out *ClusterStoreSpec) {
+func (in *ClusterBuilder) DeepCopyInto(out *ClusterBuilder) {
 	*out = *in
-	if in.Sources != nil {
-		in, out := &in.Sources, &out.Sources
-		*out = make([]v1alpha1.ImageSource, len(*in))
-		copy(*out, *in)
-	}
-	if in.ServiceAccountRef != nil {
-		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
-		*out = new(v1.ObjectReference)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStoreSpec.
-func (in *ClusterStoreSpec) DeepCopy() *ClusterStoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuilder.
+func (in *ClusterBuilder) DeepCopy() *ClusterBuilder {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStoreSpec)
+	out := new(ClusterBuilder)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *ClusterBuilder) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBuilder) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStoreStatus) DeepCopyInto(out *ClusterStoreStatus) {
+func (in *ClusterBuilderList) DeepCopyInto(out *ClusterBuilderList) {
 	*out = *in
-	in.Status.DeepCopyInto(&out.Status)
-	if in.Buildpacks != nil {
-		in, out := &in.Buildpacks, &out.Buildpacks
-		*out = make([]v1alpha1.BuildpackStatus, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterBuilder, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1099,55 +1315,49 @@ func (in *ClusterStoreStatus) DeepCopyInto(out *ClusterStoreStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStoreStatus.
-func (in *ClusterStoreStatus) DeepCopy() *ClusterStoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuilderList.
+func (in *ClusterBuilderList) DeepCopy() *ClusterBuilderList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStoreStatus)
+	out := new(ClusterBuilderList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CosignAnnotation) DeepCopyInto(out *CosignAnnotation) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignAnnotation.
-func (in *CosignAnnotation) DeepCopy() *CosignAnnotation {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBuilderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(CosignAnnotation)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CosignConfig) DeepCopyInto(out *CosignConfig) {
+func (in *ClusterBuilderSpec) DeepCopyInto(out *ClusterBuilderSpec) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make([]CosignAnnotation, len(*in))
-		copy(*out, *in)
+	in.BuilderSpec.DeepCopyInto(&out.BuilderSpec)
+	out.ServiceAccountRef = in.ServiceAccountRef
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(BuilderRollout)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignConfig.
-func (in *CosignConfig) DeepCopy() *CosignConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuilderSpec.
+func (in *ClusterBuilderSpec) DeepCopy() *ClusterBuilderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CosignConfig)
+	out := new(ClusterBuilderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *ClusterBuildpack) DeepCopyInto(out *ClusterBuildpack) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1156,18 +1366,26 @@ func (in *Image) DeepCopyInto(out *Image) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
-func (in *Image) DeepCopy() *Image {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildpack.
+func (in *ClusterBuildpack) DeepCopy() *ClusterBuildpack {
 	if in == nil {
 		return nil
 	}
-	out := new(Image)
+	out := new(ClusterBuildpack)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *ClusterBuildpack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Image) DeepCopyObject() runtime.Object {
+func (in *ClusterBuildpack) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1175,121 +1393,1134 @@ func (in *Image) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageBuild) DeepCopyInto(out *ImageBuild) {
+func (in *ClusterBuildpackList) DeepCopyInto(out *ClusterBuildpackList) {
 	*out = *in
-	if in.Services != nil {
-		in, out := &in.Services, &out.Services
-		*out = make(Services, len(*in))
-		copy(*out, *in)
-	}
-	if in.CNBBindings != nil {
-		in, out := &in.CNBBindings, &out.CNBBindings
-		*out = make(v1alpha1.CNBBindings, len(*in))
-		for i := range *in {
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterBuildpack, len(*in))
+		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildpackList.
+func (in *ClusterBuildpackList) DeepCopy() *ClusterBuildpackList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBuildpackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBuildpackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBuildpackSpec) DeepCopyInto(out *ClusterBuildpackSpec) {
+	*out = *in
+	out.ImageSource = in.ImageSource
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildpackSpec.
+func (in *ClusterBuildpackSpec) DeepCopy() *ClusterBuildpackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBuildpackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBuildpackStatus) DeepCopyInto(out *ClusterBuildpackStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = make([]v1alpha1.BuildpackStatus, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.Resources.DeepCopyInto(&out.Resources)
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBuildpackStatus.
+func (in *ClusterBuildpackStatus) DeepCopy() *ClusterBuildpackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBuildpackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStack) DeepCopyInto(out *ClusterStack) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStack.
+func (in *ClusterStack) DeepCopy() *ClusterStack {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *ClusterStack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStackList) DeepCopyInto(out *ClusterStackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterStack, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackList.
+func (in *ClusterStackList) DeepCopy() *ClusterStackList {
+	if in == nil {
+		return nil
 	}
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
-		(*in).DeepCopyInto(*out)
+	out := new(ClusterStackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.RuntimeClassName != nil {
-		in, out := &in.RuntimeClassName, &out.RuntimeClassName
-		*out = new(string)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStackSpec) DeepCopyInto(out *ClusterStackSpec) {
+	*out = *in
+	out.BuildImage = in.BuildImage
+	out.RunImage = in.RunImage
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(v1.ObjectReference)
 		**out = **in
 	}
-	if in.BuildTimeout != nil {
-		in, out := &in.BuildTimeout, &out.BuildTimeout
-		*out = new(int64)
-		**out = **in
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(StackDeprecation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackSpec.
+func (in *ClusterStackSpec) DeepCopy() *ClusterStackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStackSpecImage) DeepCopyInto(out *ClusterStackSpecImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackSpecImage.
+func (in *ClusterStackSpecImage) DeepCopy() *ClusterStackSpecImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStackSpecImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStackStatus) DeepCopyInto(out *ClusterStackStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	in.ResolvedClusterStack.DeepCopyInto(&out.ResolvedClusterStack)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackStatus.
+func (in *ClusterStackStatus) DeepCopy() *ClusterStackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStackStatusImage) DeepCopyInto(out *ClusterStackStatusImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStackStatusImage.
+func (in *ClusterStackStatusImage) DeepCopy() *ClusterStackStatusImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStackStatusImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStore) DeepCopyInto(out *ClusterStore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStore.
+func (in *ClusterStore) DeepCopy() *ClusterStore {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *ClusterStore) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStoreList) DeepCopyInto(out *ClusterStoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterStore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStoreList.
+func (in *ClusterStoreList) DeepCopy() *ClusterStoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStoreSpec) DeepCopyInto(out *ClusterStoreSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]v1alpha1.ImageSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStoreSpec.
+func (in *ClusterStoreSpec) DeepCopy() *ClusterStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStoreStatus) DeepCopyInto(out *ClusterStoreStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = make([]v1alpha1.BuildpackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStoreStatus.
+func (in *ClusterStoreStatus) DeepCopy() *ClusterStoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignAnnotation) DeepCopyInto(out *CosignAnnotation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignAnnotation.
+func (in *CosignAnnotation) DeepCopy() *CosignAnnotation {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignAnnotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignConfig) DeepCopyInto(out *CosignConfig) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]CosignAnnotation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignConfig.
+func (in *CosignConfig) DeepCopy() *CosignConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureRetention) DeepCopyInto(out *FailureRetention) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureRetention.
+func (in *FailureRetention) DeepCopy() *FailureRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
+	if in == nil {
+		return nil
+	}
+	out := new(Image)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Image) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageBuild) DeepCopyInto(out *ImageBuild) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make(Services, len(*in))
+		copy(*out, *in)
+	}
+	if in.CNBBindings != nil {
+		in, out := &in.CNBBindings, &out.CNBBindings
+		*out = make(v1alpha1.CNBBindings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildTimeout != nil {
+		in, out := &in.BuildTimeout, &out.BuildTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailureRetention != nil {
+		in, out := &in.FailureRetention, &out.FailureRetention
+		*out = new(FailureRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(BuildNetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageMetadata != nil {
+		in, out := &in.ImageMetadata, &out.ImageMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageBuild.
+func (in *ImageBuild) DeepCopy() *ImageBuild {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageBuild)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageBuilder) DeepCopyInto(out *ImageBuilder) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageBuilder.
+func (in *ImageBuilder) DeepCopy() *ImageBuilder {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageBuilder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCacheConfig) DeepCopyInto(out *ImageCacheConfig) {
+	*out = *in
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(ImagePersistentVolumeCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistryCache)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCacheConfig.
+func (in *ImageCacheConfig) DeepCopy() *ImageCacheConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCacheConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageDefaults) DeepCopyInto(out *ImageDefaults) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDefaults.
+func (in *ImageDefaults) DeepCopy() *ImageDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *ImageDefaults) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageDefaults) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageDefaultsList) DeepCopyInto(out *ImageDefaultsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageDefaults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDefaultsList.
+func (in *ImageDefaultsList) DeepCopy() *ImageDefaultsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageDefaultsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageDefaultsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageDefaultsSpec) DeepCopyInto(out *ImageDefaultsSpec) {
+	*out = *in
+	if in.Builder != nil {
+		in, out := &in.Builder, &out.Builder
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(ImageCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailedBuildHistoryLimit != nil {
+		in, out := &in.FailedBuildHistoryLimit, &out.FailedBuildHistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessBuildHistoryLimit != nil {
+		in, out := &in.SuccessBuildHistoryLimit, &out.SuccessBuildHistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDefaultsSpec.
+func (in *ImageDefaultsSpec) DeepCopy() *ImageDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageDriftDetection) DeepCopyInto(out *ImageDriftDetection) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDriftDetection.
+func (in *ImageDriftDetection) DeepCopy() *ImageDriftDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageDriftDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageList) DeepCopyInto(out *ImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Image, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageList.
+func (in *ImageList) DeepCopy() *ImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePersistentVolumeCache) DeepCopyInto(out *ImagePersistentVolumeCache) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePersistentVolumeCache.
+func (in *ImagePersistentVolumeCache) DeepCopy() *ImagePersistentVolumeCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePersistentVolumeCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistryGC) DeepCopyInto(out *ImageRegistryGC) {
+	*out = *in
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TTLHours != nil {
+		in, out := &in.TTLHours, &out.TTLHours
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryGC.
+func (in *ImageRegistryGC) DeepCopy() *ImageRegistryGC {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistryGC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistryGCStatus) DeepCopyInto(out *ImageRegistryGCStatus) {
+	*out = *in
+	if in.PrunedImages != nil {
+		in, out := &in.PrunedImages, &out.PrunedImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrunableImages != nil {
+		in, out := &in.PrunableImages, &out.PrunableImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryGCStatus.
+func (in *ImageRegistryGCStatus) DeepCopy() *ImageRegistryGCStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistryGCStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+	out.Builder = in.Builder
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(ImageCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailedBuildHistoryLimit != nil {
+		in, out := &in.FailedBuildHistoryLimit, &out.FailedBuildHistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessBuildHistoryLimit != nil {
+		in, out := &in.SuccessBuildHistoryLimit, &out.SuccessBuildHistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Build != nil {
+		in, out := &in.Build, &out.Build
+		*out = new(ImageBuild)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildTemplate != nil {
+		in, out := &in.BuildTemplate, &out.BuildTemplate
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.Notary != nil {
+		in, out := &in.Notary, &out.Notary
+		*out = new(v1alpha1.NotaryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(CosignConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegistryGC != nil {
+		in, out := &in.RegistryGC, &out.RegistryGC
+		*out = new(ImageRegistryGC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(ImageDriftDetection)
+		**out = **in
+	}
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.RegistryGC != nil {
+		in, out := &in.RegistryGC, &out.RegistryGC
+		*out = new(ImageRegistryGCStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatus.
+func (in *ImageStatus) DeepCopy() *ImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastBuild) DeepCopyInto(out *LastBuild) {
+	*out = *in
+	out.Cache = in.Cache
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastBuild.
+func (in *LastBuild) DeepCopy() *LastBuild {
+	if in == nil {
+		return nil
+	}
+	out := new(LastBuild)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MixinMismatchError) DeepCopyInto(out *MixinMismatchError) {
+	*out = *in
+	if in.Mismatches != nil {
+		in, out := &in.Mismatches, &out.Mismatches
+		*out = make([]BuildpackMixinMismatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MixinMismatchError.
+func (in *MixinMismatchError) DeepCopy() *MixinMismatchError {
+	if in == nil {
+		return nil
+	}
+	out := new(MixinMismatchError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedBuilderSpec) DeepCopyInto(out *NamespacedBuilderSpec) {
+	*out = *in
+	in.BuilderSpec.DeepCopyInto(&out.BuilderSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedBuilderSpec.
+func (in *NamespacedBuilderSpec) DeepCopy() *NamespacedBuilderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedBuilderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Promotion) DeepCopyInto(out *Promotion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Promotion.
+func (in *Promotion) DeepCopy() *Promotion {
+	if in == nil {
+		return nil
+	}
+	out := new(Promotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Promotion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionList) DeepCopyInto(out *PromotionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Promotion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionList.
+func (in *PromotionList) DeepCopy() *PromotionList {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PromotionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
+	*out = *in
+	out.Build = in.Build
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
+func (in *PromotionSpec) DeepCopy() *PromotionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
+func (in *PromotionStatus) DeepCopy() *PromotionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryCache) DeepCopyInto(out *RegistryCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryCache.
+func (in *RegistryCache) DeepCopy() *RegistryCache {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedClusterStack) DeepCopyInto(out *ResolvedClusterStack) {
+	*out = *in
+	out.BuildImage = in.BuildImage
+	out.RunImage = in.RunImage
+	if in.Mixins != nil {
+		in, out := &in.Mixins, &out.Mixins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(StackDeprecation)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageBuild.
-func (in *ImageBuild) DeepCopy() *ImageBuild {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedClusterStack.
+func (in *ResolvedClusterStack) DeepCopy() *ResolvedClusterStack {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageBuild)
+	out := new(ResolvedClusterStack)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageBuilder) DeepCopyInto(out *ImageBuilder) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	return
+func (in Services) DeepCopyInto(out *Services) {
+	{
+		in := &in
+		*out = make(Services, len(*in))
+		copy(*out, *in)
+		return
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageBuilder.
-func (in *ImageBuilder) DeepCopy() *ImageBuilder {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Services.
+func (in Services) DeepCopy() Services {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageBuilder)
+	out := new(Services)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageCacheConfig) DeepCopyInto(out *ImageCacheConfig) {
+func (in *SourceResolver) DeepCopyInto(out *SourceResolver) {
 	*out = *in
-	if in.Volume != nil {
-		in, out := &in.Volume, &out.Volume
-		*out = new(ImagePersistentVolumeCache)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Registry != nil {
-		in, out := &in.Registry, &out.Registry
-		*out = new(RegistryCache)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCacheConfig.
-func (in *ImageCacheConfig) DeepCopy() *ImageCacheConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceResolver.
+func (in *SourceResolver) DeepCopy() *SourceResolver {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageCacheConfig)
+	out := new(SourceResolver)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SourceResolver) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageList) DeepCopyInto(out *ImageList) {
+func (in *SourceResolverList) DeepCopyInto(out *SourceResolverList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Image, len(*in))
+		*out = make([]SourceResolver, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1297,18 +2528,18 @@ func (in *ImageList) DeepCopyInto(out *ImageList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageList.
-func (in *ImageList) DeepCopy() *ImageList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceResolverList.
+func (in *SourceResolverList) DeepCopy() *SourceResolverList {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageList)
+	out := new(SourceResolverList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ImageList) DeepCopyObject() runtime.Object {
+func (in *SourceResolverList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1316,191 +2547,172 @@ func (in *ImageList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImagePersistentVolumeCache) DeepCopyInto(out *ImagePersistentVolumeCache) {
+func (in *SourceResolverSpec) DeepCopyInto(out *SourceResolverSpec) {
 	*out = *in
-	if in.Size != nil {
-		in, out := &in.Size, &out.Size
-		x := (*in).DeepCopy()
-		*out = &x
-	}
+	in.Source.DeepCopyInto(&out.Source)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePersistentVolumeCache.
-func (in *ImagePersistentVolumeCache) DeepCopy() *ImagePersistentVolumeCache {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceResolverSpec.
+func (in *SourceResolverSpec) DeepCopy() *SourceResolverSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ImagePersistentVolumeCache)
+	out := new(SourceResolverSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+func (in *SourceResolverStatus) DeepCopyInto(out *SourceResolverStatus) {
 	*out = *in
-	out.Builder = in.Builder
+	in.Status.DeepCopyInto(&out.Status)
 	in.Source.DeepCopyInto(&out.Source)
-	if in.Cache != nil {
-		in, out := &in.Cache, &out.Cache
-		*out = new(ImageCacheConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.FailedBuildHistoryLimit != nil {
-		in, out := &in.FailedBuildHistoryLimit, &out.FailedBuildHistoryLimit
-		*out = new(int64)
-		**out = **in
-	}
-	if in.SuccessBuildHistoryLimit != nil {
-		in, out := &in.SuccessBuildHistoryLimit, &out.SuccessBuildHistoryLimit
-		*out = new(int64)
-		**out = **in
-	}
-	if in.Build != nil {
-		in, out := &in.Build, &out.Build
-		*out = new(ImageBuild)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Notary != nil {
-		in, out := &in.Notary, &out.Notary
-		*out = new(v1alpha1.NotaryConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Cosign != nil {
-		in, out := &in.Cosign, &out.Cosign
-		*out = new(CosignConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AdditionalTags != nil {
-		in, out := &in.AdditionalTags, &out.AdditionalTags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
-func (in *ImageSpec) DeepCopy() *ImageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceResolverStatus.
+func (in *SourceResolverStatus) DeepCopy() *SourceResolverStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSpec)
+	out := new(SourceResolverStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
+func (in *Stack) DeepCopyInto(out *Stack) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatus.
-func (in *ImageStatus) DeepCopy() *ImageStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stack.
+func (in *Stack) DeepCopy() *Stack {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageStatus)
+	out := new(Stack)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastBuild) DeepCopyInto(out *LastBuild) {
-	*out = *in
-	out.Cache = in.Cache
-	return
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *Stack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastBuild.
-func (in *LastBuild) DeepCopy() *LastBuild {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Stack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(LastBuild)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespacedBuilderSpec) DeepCopyInto(out *NamespacedBuilderSpec) {
+func (in *StackList) DeepCopyInto(out *StackList) {
 	*out = *in
-	in.BuilderSpec.DeepCopyInto(&out.BuilderSpec)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Stack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedBuilderSpec.
-func (in *NamespacedBuilderSpec) DeepCopy() *NamespacedBuilderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackList.
+func (in *StackList) DeepCopy() *StackList {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespacedBuilderSpec)
+	out := new(StackList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistryCache) DeepCopyInto(out *RegistryCache) {
+func (in *StackDeprecation) DeepCopyInto(out *StackDeprecation) {
 	*out = *in
+	if in.EndOfLife != nil {
+		in, out := &in.EndOfLife, &out.EndOfLife
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryCache.
-func (in *RegistryCache) DeepCopy() *RegistryCache {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackDeprecation.
+func (in *StackDeprecation) DeepCopy() *StackDeprecation {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistryCache)
+	out := new(StackDeprecation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResolvedClusterStack) DeepCopyInto(out *ResolvedClusterStack) {
+func (in *StackSpec) DeepCopyInto(out *StackSpec) {
 	*out = *in
 	out.BuildImage = in.BuildImage
 	out.RunImage = in.RunImage
-	if in.Mixins != nil {
-		in, out := &in.Mixins, &out.Mixins
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(StackDeprecation)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedClusterStack.
-func (in *ResolvedClusterStack) DeepCopy() *ResolvedClusterStack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackSpec.
+func (in *StackSpec) DeepCopy() *StackSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ResolvedClusterStack)
+	out := new(StackSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in Services) DeepCopyInto(out *Services) {
-	{
-		in := &in
-		*out = make(Services, len(*in))
-		copy(*out, *in)
-		return
-	}
+func (in *StackStatus) DeepCopyInto(out *StackStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	in.ResolvedClusterStack.DeepCopyInto(&out.ResolvedClusterStack)
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Services.
-func (in Services) DeepCopy() Services {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackStatus.
+func (in *StackStatus) DeepCopy() *StackStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Services)
+	out := new(StackStatus)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SourceResolver) DeepCopyInto(out *SourceResolver) {
+func (in *Store) DeepCopyInto(out *Store) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1509,18 +2721,26 @@ func (in *SourceResolver) DeepCopyInto(out *SourceResolver) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceResolver.
-func (in *SourceResolver) DeepCopy() *SourceResolver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Store.
+func (in *Store) DeepCopy() *Store {
 	if in == nil {
 		return nil
 	}
-	out := new(SourceResolver)
+	out := new(Store)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *Store) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SourceResolver) DeepCopyObject() runtime.Object {
+func (in *Store) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1528,13 +2748,13 @@ func (in *SourceResolver) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SourceResolverList) DeepCopyInto(out *SourceResolverList) {
+func (in *StoreList) DeepCopyInto(out *StoreList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SourceResolver, len(*in))
+		*out = make([]Store, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1542,18 +2762,18 @@ func (in *SourceResolverList) DeepCopyInto(out *SourceResolverList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceResolverList.
-func (in *SourceResolverList) DeepCopy() *SourceResolverList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreList.
+func (in *StoreList) DeepCopy() *StoreList {
 	if in == nil {
 		return nil
 	}
-	out := new(SourceResolverList)
+	out := new(StoreList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SourceResolverList) DeepCopyObject() runtime.Object {
+func (in *StoreList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1561,36 +2781,46 @@ func (in *SourceResolverList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SourceResolverSpec) DeepCopyInto(out *SourceResolverSpec) {
+func (in *StoreSpec) DeepCopyInto(out *StoreSpec) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]v1alpha1.ImageSource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceResolverSpec.
-func (in *SourceResolverSpec) DeepCopy() *SourceResolverSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreSpec.
+func (in *StoreSpec) DeepCopy() *StoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SourceResolverSpec)
+	out := new(StoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SourceResolverStatus) DeepCopyInto(out *SourceResolverStatus) {
+func (in *StoreStatus) DeepCopyInto(out *StoreStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	in.Source.DeepCopyInto(&out.Source)
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = make([]v1alpha1.BuildpackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceResolverStatus.
-func (in *SourceResolverStatus) DeepCopy() *SourceResolverStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreStatus.
+func (in *StoreStatus) DeepCopy() *StoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SourceResolverStatus)
+	out := new(StoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -11,6 +11,32 @@ type BuildStack struct {
 	ID       string `json:"id,omitempty"`
 }
 
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen=true
+type BuildCacheMetrics struct {
+	// Layers is the number of app layers above the run image.
+	Layers int64 `json:"layers"`
+	// ReusedLayers is the number of app layers reused from the previous image.
+	ReusedLayers int64 `json:"reusedLayers"`
+	// CacheLayers is the number of layers in the cache image.
+	CacheLayers int64 `json:"cacheLayers,omitempty"`
+	// RestoredCacheLayers is the number of cache layers unchanged from the previous cache image.
+	RestoredCacheLayers int64 `json:"restoredCacheLayers,omitempty"`
+	// HitRatioPercent is the percentage of app and cache layers that were reused.
+	HitRatioPercent int64 `json:"hitRatioPercent"`
+}
+
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen=true
+type BuildResourceUsage struct {
+	// CPUMillicoreSeconds is the cpu reserved by the build steps in millicores
+	// multiplied by the seconds they ran.
+	CPUMillicoreSeconds int64 `json:"cpuMillicoreSeconds"`
+	// MemoryByteSeconds is the memory reserved by the build steps in bytes
+	// multiplied by the seconds they ran.
+	MemoryByteSeconds int64 `json:"memoryByteSeconds"`
+}
+
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen=true
 type BuildBuilderSpec struct {
@@ -1,5 +1,6 @@
 package v1alpha1
 
+// +enum
 type ImageTaggingStrategy string
 
 const (
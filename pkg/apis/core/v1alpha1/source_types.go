@@ -13,6 +13,9 @@ type SourceConfig struct {
 	Blob     *Blob     `json:"blob,omitempty"`
 	Registry *Registry `json:"registry,omitempty"`
 	SubPath  string    `json:"subPath,omitempty"`
+	// DereferenceSymlinks replaces symlinks in the source with copies of the
+	// files and directories they point to.
+	DereferenceSymlinks bool `json:"dereferenceSymlinks,omitempty"`
 }
 
 func (sc *SourceConfig) Source() Source {
@@ -36,10 +39,25 @@ type Source interface {
 type Git struct {
 	URL      string `json:"url"`
 	Revision string `json:"revision"`
+	// Branch is checked out at Revision instead of a detached HEAD. It is
+	// set on builds of branch revisions so the branch is visible to
+	// buildpacks.
+	Branch string `json:"branch,omitempty"`
+	// CompatibilityMode selects how source is fetched from servers with
+	// protocol quirks. GitCLI fetches with the git command line client.
+	CompatibilityMode GitCompatibilityMode `json:"compatibilityMode,omitempty"`
 }
 
+// +enum
+type GitCompatibilityMode string
+
+const (
+	GitCompatibilityModeDefault GitCompatibilityMode = ""
+	GitCompatibilityModeCLI     GitCompatibilityMode = "GitCLI"
+)
+
 func (g *Git) BuildEnvVars() []corev1.EnvVar {
-	return []corev1.EnvVar{
+	envVars := []corev1.EnvVar{
 		{
 			Name:  "GIT_URL",
 			Value: g.URL,
@@ -49,6 +67,19 @@ func (g *Git) BuildEnvVars() []corev1.EnvVar {
 			Value: g.Revision,
 		},
 	}
+	if g.Branch != "" {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "GIT_BRANCH",
+			Value: g.Branch,
+		})
+	}
+	if g.CompatibilityMode != GitCompatibilityModeDefault {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "GIT_COMPATIBILITY_MODE",
+			Value: string(g.CompatibilityMode),
+		})
+	}
+	return envVars
 }
 
 func (in *Git) ImagePullSecretsVolume(name string) corev1.Volume {
@@ -153,6 +184,7 @@ type ResolvedSource interface {
 	SourceConfig() SourceConfig
 }
 
+// +enum
 type GitSourceKind string
 
 const (
@@ -165,19 +197,25 @@ const (
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen=true
 type ResolvedGitSource struct {
-	URL      string        `json:"url"`
-	Revision string        `json:"revision"`
-	SubPath  string        `json:"subPath,omitempty"`
-	Type     GitSourceKind `json:"type"`
+	URL                 string               `json:"url"`
+	Revision            string               `json:"revision"`
+	Branch              string               `json:"branch,omitempty"`
+	SubPath             string               `json:"subPath,omitempty"`
+	Type                GitSourceKind        `json:"type"`
+	CompatibilityMode   GitCompatibilityMode `json:"compatibilityMode,omitempty"`
+	DereferenceSymlinks bool                 `json:"dereferenceSymlinks,omitempty"`
 }
 
 func (gs *ResolvedGitSource) SourceConfig() SourceConfig {
 	return SourceConfig{
 		Git: &Git{
-			URL:      gs.URL,
-			Revision: gs.Revision,
+			URL:               gs.URL,
+			Revision:          gs.Revision,
+			Branch:            gs.Branch,
+			CompatibilityMode: gs.CompatibilityMode,
 		},
-		SubPath: gs.SubPath,
+		SubPath:             gs.SubPath,
+		DereferenceSymlinks: gs.DereferenceSymlinks,
 	}
 }
 
@@ -192,9 +230,10 @@ func (gs *ResolvedGitSource) IsPollable() bool {
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen=true
 type ResolvedBlobSource struct {
-	URL             string `json:"url"`
-	SubPath         string `json:"subPath,omitempty"`
-	StripComponents int64  `json:"stripComponents,omitempty"`
+	URL                 string `json:"url"`
+	SubPath             string `json:"subPath,omitempty"`
+	StripComponents     int64  `json:"stripComponents,omitempty"`
+	DereferenceSymlinks bool   `json:"dereferenceSymlinks,omitempty"`
 }
 
 func (bs *ResolvedBlobSource) SourceConfig() SourceConfig {
@@ -203,7 +242,8 @@ func (bs *ResolvedBlobSource) SourceConfig() SourceConfig {
 			URL:             bs.URL,
 			StripComponents: bs.StripComponents,
 		},
-		SubPath: bs.SubPath,
+		SubPath:             bs.SubPath,
+		DereferenceSymlinks: bs.DereferenceSymlinks,
 	}
 }
 
@@ -223,7 +263,8 @@ type ResolvedRegistrySource struct {
 	// +patchMergeKey=name
 	// +patchStrategy=merge
 	// +listType
-	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,15,rep,name=imagePullSecrets"`
+	ImagePullSecrets    []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,15,rep,name=imagePullSecrets"`
+	DereferenceSymlinks bool                          `json:"dereferenceSymlinks,omitempty"`
 }
 
 func (rs *ResolvedRegistrySource) SourceConfig() SourceConfig {
@@ -232,7 +273,8 @@ func (rs *ResolvedRegistrySource) SourceConfig() SourceConfig {
 			Image:            rs.Image,
 			ImagePullSecrets: rs.ImagePullSecrets,
 		},
-		SubPath: rs.SubPath,
+		SubPath:             rs.SubPath,
+		DereferenceSymlinks: rs.DereferenceSymlinks,
 	}
 }
 
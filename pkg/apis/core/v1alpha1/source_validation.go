@@ -39,7 +39,17 @@ func (g *Git) Validate(ctx context.Context) *apis.FieldError {
 	}
 
 	return validate.FieldNotEmpty(g.URL, "url").
-		Also(validate.FieldNotEmpty(g.Revision, "revision"))
+		Also(validate.FieldNotEmpty(g.Revision, "revision")).
+		Also(g.validateCompatibilityMode())
+}
+
+func (g *Git) validateCompatibilityMode() *apis.FieldError {
+	switch g.CompatibilityMode {
+	case GitCompatibilityModeDefault, GitCompatibilityModeCLI:
+		return nil
+	default:
+		return apis.ErrInvalidValue(g.CompatibilityMode, "compatibilityMode")
+	}
 }
 
 func (b *Blob) Validate(ctx context.Context) *apis.FieldError {
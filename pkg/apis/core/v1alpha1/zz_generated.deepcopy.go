@@ -62,6 +62,38 @@ func (in *BuildBuilderSpec) DeepCopy() *BuildBuilderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildCacheMetrics) DeepCopyInto(out *BuildCacheMetrics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildCacheMetrics.
+func (in *BuildCacheMetrics) DeepCopy() *BuildCacheMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildCacheMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildResourceUsage) DeepCopyInto(out *BuildResourceUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildResourceUsage.
+func (in *BuildResourceUsage) DeepCopy() *BuildResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildStack) DeepCopyInto(out *BuildStack) {
 	*out = *in
@@ -5,6 +5,7 @@ import (
 	"archive/zip"
 	"compress/gzip"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -28,6 +29,7 @@ func IsTar(fileName string) bool {
 
 func ExtractTar(reader io.Reader, dir string, stripComponents int) error {
 	tarReader := tar.NewReader(reader)
+	e := &extraction{dir: dir}
 	for {
 		header, err := tarReader.Next()
 		if err == io.EOF {
@@ -44,30 +46,24 @@ func ExtractTar(reader io.Reader, dir string, stripComponents int) error {
 		filePath := filepath.Join(dir, strippedFileName)
 		switch header.Typeflag {
 		case tar.TypeDir:
-			err := os.MkdirAll(filePath, header.FileInfo().Mode())
-			if err != nil {
+			if err := e.directory(filePath, header.FileInfo().Mode(), header.ModTime); err != nil {
 				return err
 			}
 		case tar.TypeReg:
-			if err = os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
-				return err
-			}
-
-			outFile, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, header.FileInfo().Mode())
-			if err != nil {
-				return err
-			}
-
-			if _, err := io.Copy(outFile, tarReader); err != nil {
+			if err := e.file(filePath, header.FileInfo().Mode(), header.ModTime, tarReader); err != nil {
 				return err
 			}
-
-			if err := outFile.Close(); err != nil {
-				return err
+		case tar.TypeSymlink:
+			e.symlink(filePath, header.Linkname)
+		case tar.TypeLink:
+			strippedLinkName := stripPath(header.Linkname, stripComponents)
+			if strippedLinkName == "" {
+				continue
 			}
+			e.hardlink(filePath, filepath.Join(dir, strippedLinkName))
 		}
 	}
-	return nil
+	return e.finish()
 }
 
 func ExtractTarGZ(reader io.Reader, dir string, stripComponents int) error {
@@ -102,6 +98,7 @@ func ExtractZip(reader io.ReaderAt, size int64, dir string, stripComponents int)
 		return err
 	}
 
+	e := &extraction{dir: dir}
 	for _, file := range zipReader.File {
 
 		strippedFileName := stripPath(file.Name, stripComponents)
@@ -116,32 +113,24 @@ func ExtractZip(reader io.ReaderAt, size int64, dir string, stripComponents int)
 		}
 
 		if file.FileInfo().IsDir() {
-			err := os.MkdirAll(filePath, fileMode)
-			if err != nil {
+			if err := e.directory(filePath, fileMode, file.Modified); err != nil {
 				return err
 			}
 			continue
 		}
 
-		if err = os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
-			return err
-		}
-
-		outFile, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileMode)
-		if err != nil {
-			return err
-		}
-
 		srcFile, err := file.Open()
 		if err != nil {
 			return err
 		}
 
-		if _, err := io.Copy(outFile, srcFile); err != nil {
-			return err
-		}
-
-		if err := outFile.Close(); err != nil {
+		if fileMode&os.ModeSymlink != 0 {
+			target, err := ioutil.ReadAll(srcFile)
+			if err != nil {
+				return err
+			}
+			e.symlink(filePath, string(target))
+		} else if err := e.file(filePath, fileMode, file.Modified, srcFile); err != nil {
 			return err
 		}
 
@@ -149,7 +138,7 @@ func ExtractZip(reader io.ReaderAt, size int64, dir string, stripComponents int)
 			return err
 		}
 	}
-	return nil
+	return e.finish()
 }
 
 func isFatFile(header zip.FileHeader) bool {
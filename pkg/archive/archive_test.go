@@ -0,0 +1,183 @@
+package archive_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/archive"
+)
+
+func TestArchive(t *testing.T) {
+	spec.Run(t, "Archive", testArchive)
+}
+
+func testArchive(t *testing.T, when spec.G, it spec.S) {
+	var (
+		dir     string
+		modTime = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	)
+
+	it.Before(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "archive_test")
+		require.NoError(t, err)
+	})
+
+	it.After(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	tarball := func(headers ...*tar.Header) *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		tw := tar.NewWriter(buf)
+		for _, header := range headers {
+			if header.Typeflag == 0 {
+				header.Typeflag = tar.TypeReg
+			}
+			if header.Typeflag == tar.TypeReg {
+				header.Size = int64(len(header.Name))
+			}
+			require.NoError(t, tw.WriteHeader(header))
+			if header.Typeflag == tar.TypeReg {
+				_, err := tw.Write([]byte(header.Name))
+				require.NoError(t, err)
+			}
+		}
+		require.NoError(t, tw.Close())
+		return buf
+	}
+
+	when("#ExtractTar", func() {
+		it("preserves modes and modification times", func() {
+			err := archive.ExtractTar(tarball(
+				&tar.Header{Name: "bin/", Typeflag: tar.TypeDir, Mode: 0750, ModTime: modTime},
+				&tar.Header{Name: "bin/run", Mode: 0755, ModTime: modTime},
+				&tar.Header{Name: "config", Mode: 0600, ModTime: modTime},
+			), dir, 0)
+			require.NoError(t, err)
+
+			info, err := os.Stat(filepath.Join(dir, "bin"))
+			require.NoError(t, err)
+			require.Equal(t, os.ModeDir|0750, info.Mode())
+			require.True(t, modTime.Equal(info.ModTime()))
+
+			info, err = os.Stat(filepath.Join(dir, "bin", "run"))
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(0755), info.Mode())
+			require.True(t, modTime.Equal(info.ModTime()))
+
+			info, err = os.Stat(filepath.Join(dir, "config"))
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(0600), info.Mode())
+		})
+
+		it("creates empty directories", func() {
+			err := archive.ExtractTar(tarball(
+				&tar.Header{Name: "empty/", Typeflag: tar.TypeDir, Mode: 0755},
+			), dir, 0)
+			require.NoError(t, err)
+
+			entries, err := ioutil.ReadDir(filepath.Join(dir, "empty"))
+			require.NoError(t, err)
+			require.Empty(t, entries)
+		})
+
+		it("preserves symlinks and hard links", func() {
+			err := archive.ExtractTar(tarball(
+				&tar.Header{Name: "current", Typeflag: tar.TypeSymlink, Linkname: "releases/v1"},
+				&tar.Header{Name: "releases/v1/app", Mode: 0644},
+				&tar.Header{Name: "releases/v1/link", Typeflag: tar.TypeLink, Linkname: "releases/v1/app"},
+			), dir, 0)
+			require.NoError(t, err)
+
+			target, err := os.Readlink(filepath.Join(dir, "current"))
+			require.NoError(t, err)
+			require.Equal(t, "releases/v1", target)
+
+			contents, err := ioutil.ReadFile(filepath.Join(dir, "current", "app"))
+			require.NoError(t, err)
+			require.Equal(t, "releases/v1/app", string(contents))
+
+			app, err := os.Stat(filepath.Join(dir, "releases", "v1", "app"))
+			require.NoError(t, err)
+			link, err := os.Stat(filepath.Join(dir, "releases", "v1", "link"))
+			require.NoError(t, err)
+			require.True(t, os.SameFile(app, link))
+		})
+
+		it("does not write files through symlinks", func() {
+			outside, err := ioutil.TempDir("", "archive_test_outside")
+			require.NoError(t, err)
+			defer os.RemoveAll(outside)
+
+			require.NoError(t, os.Symlink(outside, filepath.Join(dir, "escape")))
+
+			err = archive.ExtractTar(tarball(
+				&tar.Header{Name: "escape/file", Mode: 0644},
+			), dir, 0)
+			require.EqualError(t, err, "archive entry "+filepath.Join(dir, "escape")+" is outside of "+dir)
+
+			entries, err := ioutil.ReadDir(outside)
+			require.NoError(t, err)
+			require.Empty(t, entries)
+		})
+
+		it("returns an error for hard links outside of the directory", func() {
+			err := archive.ExtractTar(tarball(
+				&tar.Header{Name: "passwd", Typeflag: tar.TypeLink, Linkname: "../../etc/passwd"},
+			), dir, 0)
+			require.EqualError(t, err, "archive entry "+filepath.Join(dir, "../../etc/passwd")+" is outside of "+dir)
+		})
+	})
+
+	when("#DereferenceSymlinks", func() {
+		it("replaces symlinks with copies of their targets", func() {
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "shared", "lib"), 0755))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "shared", "lib", "run.sh"), []byte("run"), 0755))
+			require.NoError(t, os.Chtimes(filepath.Join(dir, "shared", "lib", "run.sh"), modTime, modTime))
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "app"), 0755))
+			require.NoError(t, os.Symlink("../shared/lib", filepath.Join(dir, "app", "lib")))
+			require.NoError(t, os.Symlink("lib/run.sh", filepath.Join(dir, "app", "run.sh")))
+
+			require.NoError(t, archive.DereferenceSymlinks(dir))
+
+			info, err := os.Lstat(filepath.Join(dir, "app", "lib"))
+			require.NoError(t, err)
+			require.True(t, info.IsDir())
+
+			for _, path := range []string{filepath.Join(dir, "app", "lib", "run.sh"), filepath.Join(dir, "app", "run.sh")} {
+				info, err := os.Lstat(path)
+				require.NoError(t, err)
+				require.Equal(t, os.FileMode(0755), info.Mode())
+				require.True(t, modTime.Equal(info.ModTime()))
+
+				contents, err := ioutil.ReadFile(path)
+				require.NoError(t, err)
+				require.Equal(t, "run", string(contents))
+			}
+		})
+
+		it("returns an error for symlinks outside of the source", func() {
+			require.NoError(t, os.Symlink("/etc", filepath.Join(dir, "etc")))
+
+			err := archive.DereferenceSymlinks(dir)
+			require.EqualError(t, err, "symlink "+filepath.Join(dir, "etc")+" points outside of the source")
+		})
+
+		it("returns an error for symlink cycles", func() {
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "app"), 0755))
+			require.NoError(t, os.Symlink("..", filepath.Join(dir, "app", "parent")))
+
+			err := archive.DereferenceSymlinks(dir)
+			require.EqualError(t, err, "symlink "+filepath.Join(dir, "app", "parent")+" creates a cycle")
+		})
+	})
+}
@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// DereferenceSymlinks replaces every symlink in dir with a copy of the file
+// or directory it points to, preserving modes and modification times.
+// Symlinks that point outside of dir or form a cycle are an error.
+func DereferenceSymlinks(dir string) error {
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+
+	var symlinks []string
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			symlinks = append(symlinks, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, symlink := range symlinks {
+		if err := dereference(root, symlink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dereference(root, symlink string) error {
+	target, err := filepath.EvalSymlinks(symlink)
+	if err != nil {
+		return errors.Wrapf(err, "dereferencing symlink %s", symlink)
+	}
+
+	if !within(root, target) {
+		return errors.Errorf("symlink %s points outside of the source", symlink)
+	}
+
+	if err := os.Remove(symlink); err != nil {
+		return err
+	}
+	return copyPath(root, target, symlink)
+}
+
+func copyPath(root, src, dest string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return copyFile(src, dest, info)
+	}
+
+	if realDest, err := filepath.EvalSymlinks(filepath.Dir(dest)); err == nil && within(src, realDest) {
+		return errors.Errorf("symlink %s creates a cycle", dest)
+	}
+
+	if err := os.MkdirAll(dest, os.ModePerm); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entrySrc := filepath.Join(src, entry.Name())
+		if entry.Type()&os.ModeSymlink != 0 {
+			entrySrc, err = filepath.EvalSymlinks(entrySrc)
+			if err != nil {
+				return errors.Wrapf(err, "dereferencing symlink %s", filepath.Join(src, entry.Name()))
+			}
+			if !within(root, entrySrc) {
+				return errors.Errorf("symlink %s points outside of the source", filepath.Join(src, entry.Name()))
+			}
+		}
+
+		if err := copyPath(root, entrySrc, filepath.Join(dest, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return setAttributes(dest, info.Mode(), info.ModTime())
+}
+
+func copyFile(src, dest string, info os.FileInfo) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	destFile, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(destFile, srcFile); err != nil {
+		destFile.Close()
+		return err
+	}
+
+	if err := destFile.Close(); err != nil {
+		return err
+	}
+
+	return setAttributes(dest, info.Mode(), info.ModTime())
+}
@@ -0,0 +1,182 @@
+package archive
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// extraction writes the entries of an archive into dir, preserving file
+// modes, modification times, symlinks and hard links. Links are created
+// after all files are written so no entry is ever written through a link,
+// and directory attributes are applied last so writing their contents does
+// not change them.
+type extraction struct {
+	dir         string
+	symlinks    []link
+	hardlinks   []link
+	directories []directory
+}
+
+type link struct {
+	path   string
+	target string
+}
+
+type directory struct {
+	path    string
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (e *extraction) directory(path string, mode os.FileMode, modTime time.Time) error {
+	if err := e.mkdirAll(path); err != nil {
+		return err
+	}
+
+	e.directories = append(e.directories, directory{path: path, mode: mode, modTime: modTime})
+	return nil
+}
+
+func (e *extraction) file(path string, mode os.FileMode, modTime time.Time, reader io.Reader) error {
+	if err := e.mkdirAll(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	outFile, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(outFile, reader); err != nil {
+		outFile.Close()
+		return err
+	}
+
+	if err := outFile.Close(); err != nil {
+		return err
+	}
+
+	return setAttributes(path, mode, modTime)
+}
+
+func (e *extraction) symlink(path, target string) {
+	e.symlinks = append(e.symlinks, link{path: path, target: target})
+}
+
+func (e *extraction) hardlink(path, target string) {
+	e.hardlinks = append(e.hardlinks, link{path: path, target: target})
+}
+
+func (e *extraction) finish() error {
+	for _, l := range e.hardlinks {
+		if err := e.contains(l.target); err != nil {
+			return err
+		}
+		if err := e.replace(l.path); err != nil {
+			return err
+		}
+		if err := os.Link(l.target, l.path); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range e.symlinks {
+		if err := e.replace(l.path); err != nil {
+			return err
+		}
+		if err := os.Symlink(l.target, l.path); err != nil {
+			return err
+		}
+	}
+
+	for i := len(e.directories) - 1; i >= 0; i-- {
+		d := e.directories[i]
+		if err := setAttributes(d.path, d.mode, d.modTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replace prepares path to be created as a link, removing a file left by
+// an earlier entry for the same path.
+func (e *extraction) replace(path string) error {
+	if err := e.mkdirAll(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (e *extraction) mkdirAll(path string) error {
+	if err := e.contains(path); err != nil {
+		return err
+	}
+	if err := e.resolvesWithin(path); err != nil {
+		return err
+	}
+	return os.MkdirAll(path, os.ModePerm)
+}
+
+// resolvesWithin guards against writing through a symlink left in dir by an
+// earlier extraction, such as a previous layer of the same source image.
+func (e *extraction) resolvesWithin(path string) error {
+	for p := path; ; p = filepath.Dir(p) {
+		resolved, err := filepath.EvalSymlinks(p)
+		if err == nil {
+			root, err := filepath.EvalSymlinks(e.dir)
+			if err != nil {
+				return err
+			}
+			if !within(root, resolved) {
+				return errors.Errorf("archive entry %s is outside of %s", path, e.dir)
+			}
+			return nil
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+
+		if p == e.dir || p == filepath.Dir(p) {
+			return nil
+		}
+	}
+}
+
+func (e *extraction) contains(path string) error {
+	if !within(e.dir, path) {
+		return errors.Errorf("archive entry %s is outside of %s", path, e.dir)
+	}
+	return nil
+}
+
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// setAttributes applies the exact mode, unaffected by the umask, and the
+// modification time of an archive entry.
+func setAttributes(path string, mode os.FileMode, modTime time.Time) error {
+	if err := os.Chmod(path, mode.Perm()|(mode&(os.ModeSetuid|os.ModeSetgid|os.ModeSticky))); err != nil {
+		return err
+	}
+
+	if modTime.IsZero() {
+		return nil
+	}
+	return os.Chtimes(path, modTime, modTime)
+}
@@ -13,8 +13,9 @@ type Resolver struct {
 func (*Resolver) Resolve(ctx context.Context, sourceResolver *buildapi.SourceResolver) (corev1alpha1.ResolvedSourceConfig, error) {
 	return corev1alpha1.ResolvedSourceConfig{
 		Blob: &corev1alpha1.ResolvedBlobSource{
-			URL:     sourceResolver.Spec.Source.Blob.URL,
-			SubPath: sourceResolver.Spec.Source.SubPath,
+			URL:                 sourceResolver.Spec.Source.Blob.URL,
+			SubPath:             sourceResolver.Spec.Source.SubPath,
+			DereferenceSymlinks: sourceResolver.Spec.Source.DereferenceSymlinks,
 		},
 	}, nil
 }
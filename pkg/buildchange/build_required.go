@@ -0,0 +1,151 @@
+package buildchange
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+// Result describes whether an Image needs a new Build and why.
+type Result struct {
+	// ConditionStatus is True when a build is required, False when it is
+	// not and Unknown while the source or builder are not ready.
+	ConditionStatus corev1.ConditionStatus
+	// Reasons are the reasons of the build, such as CONFIG or COMMIT.
+	Reasons []string
+	// Changes hold the old and new value of every change that requires the
+	// build.
+	Changes []GenericChange
+	// ReasonsStr is Reasons as recorded in the build reason annotation.
+	ReasonsStr string
+	// ChangesStr is Changes as recorded in the build changes annotation.
+	ChangesStr    string
+	PriorityClass string
+}
+
+// BuildRequired compares the desired Image with its last Build to decide
+// whether a new Build is needed. lastBuild is nil when the Image has not
+// been built yet. srcResolver and builder are the resolved source and
+// builder the next Build would use.
+//
+// The controller schedules a Build exactly when BuildRequired returns a
+// Result with ConditionStatus True, so clients can use it to predict builds.
+func BuildRequired(img *buildapi.Image,
+	lastBuild *buildapi.Build,
+	srcResolver *buildapi.SourceResolver,
+	builder buildapi.BuilderResource) (Result, error) {
+
+	result := Result{ConditionStatus: corev1.ConditionUnknown}
+	if !srcResolver.Ready() || !builder.Ready() {
+		return result, nil
+	}
+
+	processor := NewChangeProcessor().
+		Process(triggerChangeFor(lastBuild)).
+		Process(commitChangeFor(lastBuild, srcResolver)).
+		Process(configChangeFor(img, lastBuild, srcResolver)).
+		Process(buildpackChangeFor(lastBuild, builder)).
+		Process(stackChangeFor(lastBuild, builder))
+
+	summary, err := processor.Summarize()
+	if err != nil {
+		return result, err
+	}
+
+	if summary.HasChanges {
+		result.ConditionStatus = corev1.ConditionTrue
+	} else {
+		result.ConditionStatus = corev1.ConditionFalse
+	}
+	for _, change := range processor.changes {
+		result.Reasons = append(result.Reasons, change.Reason)
+	}
+	result.Changes = processor.changes
+	result.ReasonsStr = summary.ReasonsStr
+	result.ChangesStr = summary.ChangesStr
+	result.PriorityClass = summary.Priority.PriorityClass()
+	return result, nil
+}
+
+func triggerChangeFor(lastBuild *buildapi.Build) Change {
+	if lastBuild == nil || lastBuild.Annotations == nil {
+		return nil
+	}
+
+	_, ok := lastBuild.Annotations[buildapi.BuildNeededAnnotation]
+	if !ok {
+		return nil
+	}
+
+	time := time.Now().Format(time.RFC1123Z)
+	return NewTriggerChange(time)
+}
+
+func commitChangeFor(lastBuild *buildapi.Build, srcResolver *buildapi.SourceResolver) Change {
+	// If the lastBuild was not a Git source, then it is not a COMMIT change
+	if lastBuild == nil || lastBuild.Spec.Source.Git == nil || srcResolver.Status.Source.Git == nil {
+		return nil
+	}
+
+	oldRevision := lastBuild.Spec.Source.Git.Revision
+	newRevision := srcResolver.Status.Source.Git.Revision
+	return NewCommitChange(oldRevision, newRevision)
+}
+
+func configChangeFor(img *buildapi.Image, lastBuild *buildapi.Build, srcResolver *buildapi.SourceResolver) Change {
+	var old Config
+	var new Config
+
+	if lastBuild != nil {
+		old = Config{
+			Env:           lastBuild.Spec.Env,
+			Resources:     lastBuild.Spec.Resources,
+			Services:      lastBuild.Spec.Services,
+			CNBBindings:   lastBuild.Spec.CNBBindings,
+			Source:        lastBuild.Spec.Source,
+			ImageMetadata: lastBuild.Spec.ImageMetadata,
+		}
+	}
+
+	new = Config{
+		Env:           img.Env(),
+		Resources:     img.Resources(),
+		Services:      img.Services(),
+		CNBBindings:   img.CNBBindings(),
+		Source:        srcResolver.Status.Source.ResolvedSource().SourceConfig(),
+		ImageMetadata: img.ImageMetadata(),
+	}
+
+	return NewConfigChange(old, new)
+}
+
+func buildpackChangeFor(lastBuild *buildapi.Build, builder buildapi.BuilderResource) Change {
+	if lastBuild == nil || !lastBuild.IsSuccess() {
+		return nil
+	}
+
+	var old []corev1alpha1.BuildpackInfo
+	var new []corev1alpha1.BuildpackInfo
+
+	builderBuildpacks := builder.BuildpackMetadata()
+	for _, lastBuildBp := range lastBuild.Status.BuildMetadata {
+		if !builderBuildpacks.Include(lastBuildBp) {
+			old = append(old, corev1alpha1.BuildpackInfo{Id: lastBuildBp.Id, Version: lastBuildBp.Version})
+		}
+	}
+
+	return NewBuildpackChange(old, new)
+}
+
+func stackChangeFor(lastBuild *buildapi.Build, builder buildapi.BuilderResource) Change {
+	if lastBuild == nil || !lastBuild.IsSuccess() {
+		return nil
+	}
+
+	oldRunImageRefStr := lastBuild.Status.Stack.RunImage
+	newRunImageRefStr := builder.RunImage()
+	return NewStackChange(oldRunImageRefStr, newRunImageRefStr)
+}
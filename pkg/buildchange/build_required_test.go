@@ -1,4 +1,4 @@
-package image
+package buildchange_test
 
 import (
 	"encoding/json"
@@ -7,6 +7,7 @@ import (
 
 	"github.com/sclevine/spec"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -16,11 +17,11 @@ import (
 	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
 )
 
-func TestImageBuilds(t *testing.T) {
-	spec.Run(t, "Image build Needed", testImageBuilds)
+func TestBuildRequired(t *testing.T) {
+	spec.Run(t, "Build Required", testBuildRequired)
 }
 
-func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
+func testBuildRequired(t *testing.T, when spec.G, it spec.S) {
 	image := &buildapi.Image{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "image-name",
@@ -112,18 +113,20 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 		}
 
 		it("false for no changes", func() {
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
 			assert.Equal(t, "", result.ReasonsStr)
 			assert.Equal(t, "", result.ChangesStr)
 			assert.Equal(t, "", result.PriorityClass)
+			assert.Empty(t, result.Reasons)
+			assert.Empty(t, result.Changes)
 		})
 
 		it("false for different ServiceAccount", func() {
 			image.Spec.ServiceAccountName = "different"
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
 			assert.Equal(t, "", result.ReasonsStr)
@@ -167,12 +170,55 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 			assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
 			assert.Equal(t, expectedChanges, result.ChangesStr)
 			assert.Equal(t, buildapi.BuildPriorityClassHigh, result.PriorityClass)
+
+			assert.Equal(t, []string{buildapi.BuildReasonConfig}, result.Reasons)
+			require.Len(t, result.Changes, 1)
+			assert.Equal(t, buildapi.BuildReasonConfig, result.Changes[0].Reason)
+			assert.Equal(t, []corev1.EnvVar{{Name: "keyA", Value: "previous-value"}}, result.Changes[0].Old.(buildchange.Config).Env)
+		})
+
+		it("true if image metadata changes", func() {
+			image.Spec.Build = &buildapi.ImageBuild{ImageMetadata: map[string]string{"com.example.team": "payments"}}
+
+			expectedChanges := testhelpers.CompactJSON(`
+[
+  {
+    "reason": "CONFIG",
+    "old": {
+      "resources": {},
+      "source": {
+        "git": {
+          "url": "https://some.git/url",
+          "revision": "revision"
+        }
+      }
+    },
+    "new": {
+      "resources": {},
+      "source": {
+        "git": {
+          "url": "https://some.git/url",
+          "revision": "revision"
+        }
+      },
+      "imageMetadata": {
+        "com.example.team": "payments"
+      }
+    }
+  }
+]`)
+
+			result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
+			assert.NoError(t, err)
+			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
+			assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
+			assert.Equal(t, expectedChanges, result.ChangesStr)
 		})
 
 		it("true if build service bindings changes", func() {
@@ -212,7 +258,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 			assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
@@ -257,7 +303,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 			assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
@@ -278,7 +324,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				Stack: corev1alpha1.BuildStack{},
 			}
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
 			assert.Equal(t, "", result.ReasonsStr)
@@ -291,7 +337,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				buildapi.BuildNeededAnnotation: "true",
 			}
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 			assert.Equal(t, buildapi.BuildReasonTrigger, result.ReasonsStr)
@@ -313,7 +359,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 					{Id: "buildpack.unused", Version: "unused"},
 				}
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
 				assert.Equal(t, "", result.PriorityClass)
@@ -341,7 +387,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonBuildpack, result.ReasonsStr)
@@ -369,7 +415,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonBuildpack, result.ReasonsStr)
@@ -389,7 +435,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonStack, result.ReasonsStr)
@@ -427,7 +473,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
@@ -464,7 +510,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
@@ -484,7 +530,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonCommit, result.ReasonsStr)
@@ -492,6 +538,14 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				assert.Equal(t, expectedChanges, result.ChangesStr)
 			})
 
+			it("false when only the resolved Git branch is new", func() {
+				sourceResolver.Status.Source.Git.Branch = "main"
+
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
+				assert.NoError(t, err)
+				assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
+			})
+
 			it("false if source resolver is not ready", func() {
 				sourceResolver.Status.Source.Git.Revision = "different"
 				sourceResolver.Status.Conditions = []corev1alpha1.Condition{
@@ -500,7 +554,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 						Status: corev1.ConditionFalse,
 					}}
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.PriorityClass)
@@ -512,7 +566,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				sourceResolver.Status.Source.Git.URL = "some-change"
 				builder.BuilderReady = false
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.PriorityClass)
@@ -524,7 +578,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				sourceResolver.Status.Source.Git.Revision = "different"
 				sourceResolver.Status.Conditions = []corev1alpha1.Condition{}
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.PriorityClass)
@@ -536,7 +590,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				sourceResolver.Status.Source.Git.Revision = "different"
 				sourceResolver.Status.Conditions = []corev1alpha1.Condition{}
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.PriorityClass)
@@ -549,7 +603,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				sourceResolver.ObjectMeta.Generation = 2
 				sourceResolver.Status.ObservedGeneration = 1
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.PriorityClass)
@@ -605,7 +659,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
@@ -625,7 +679,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonCommit, result.ReasonsStr)
@@ -671,7 +725,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
@@ -706,7 +760,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
@@ -752,7 +806,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
@@ -786,7 +840,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, buildapi.BuildReasonConfig, result.ReasonsStr)
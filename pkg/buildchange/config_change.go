@@ -21,38 +21,34 @@ type configChange struct {
 }
 
 type Config struct {
-	Env         []corev1.EnvVar             `json:"env,omitempty"`
-	Resources   corev1.ResourceRequirements `json:"resources,omitempty"`
-	Services    buildapi.Services           `json:"services,omitempty"`
-	CNBBindings corev1alpha1.CNBBindings    `json:"cnbBindings,omitempty"`
-	Source      corev1alpha1.SourceConfig   `json:"source,omitempty"`
+	Env           []corev1.EnvVar             `json:"env,omitempty"`
+	Resources     corev1.ResourceRequirements `json:"resources,omitempty"`
+	Services      buildapi.Services           `json:"services,omitempty"`
+	CNBBindings   corev1alpha1.CNBBindings    `json:"cnbBindings,omitempty"`
+	Source        corev1alpha1.SourceConfig   `json:"source,omitempty"`
+	ImageMetadata map[string]string           `json:"imageMetadata,omitempty"`
 }
 
 func (c configChange) Reason() buildapi.BuildReason { return buildapi.BuildReasonConfig }
 
 func (c configChange) IsBuildRequired() (bool, error) {
-	// Git revision changes are considered as COMMIT change
+	// Git revision and branch changes are considered as COMMIT change
 	// Ignore them as part of CONFIG Change
-	var oldGitRevision, newGitRevision string
+	c.old.Source.Git = withoutRevision(c.old.Source.Git)
+	c.new.Source.Git = withoutRevision(c.new.Source.Git)
 
-	if c.old.Source.Git != nil {
-		oldGitRevision = c.old.Source.Git.Revision
-		c.old.Source.Git.Revision = ""
-	}
-	if c.new.Source.Git != nil {
-		newGitRevision = c.new.Source.Git.Revision
-		c.new.Source.Git.Revision = ""
-	}
-
-	valid := !equality.Semantic.DeepEqual(c.old, c.new)
+	return !equality.Semantic.DeepEqual(c.old, c.new), nil
+}
 
-	if c.old.Source.Git != nil {
-		c.old.Source.Git.Revision = oldGitRevision
-	}
-	if c.new.Source.Git != nil {
-		c.new.Source.Git.Revision = newGitRevision
+func withoutRevision(git *corev1alpha1.Git) *corev1alpha1.Git {
+	if git == nil {
+		return nil
 	}
-	return valid, nil
+
+	withoutRevision := *git
+	withoutRevision.Revision = ""
+	withoutRevision.Branch = ""
+	return &withoutRevision
 }
 
 func (c configChange) Old() interface{} { return c.old }
@@ -0,0 +1,9 @@
+// Package buildchange decides whether an Image needs a new Build and records
+// why. BuildRequired is the same check the kpack controller uses to schedule
+// builds, so CLIs and other controllers can call it to predict when and why
+// kpack will build an Image.
+//
+// The reasons and changes of a Build are stored in its
+// image.kpack.io/reason and image.kpack.io/buildChanges annotations, in the format of
+// Result.ReasonsStr and Result.ChangesStr.
+package buildchange
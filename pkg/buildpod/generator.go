@@ -21,6 +21,7 @@ import (
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
 	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/config"
 	"github.com/pivotal/kpack/pkg/duckprovisionedserviceable"
 	"github.com/pivotal/kpack/pkg/registry"
 	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
@@ -37,13 +38,14 @@ type ImageFetcher interface {
 }
 
 type Generator struct {
-	BuildPodConfig            buildapi.BuildPodImages
+	KpackConfig               *config.KpackConfigStore
 	K8sClient                 k8sclient.Interface
 	KeychainFactory           registry.KeychainFactory
 	ImageFetcher              ImageFetcher
 	DynamicClient             dynamic.Interface
 	MaximumPlatformApiVersion *semver.Version
-	InjectedSidecarSupport    bool
+	SystemNamespace           string
+	KpackVersion              string
 }
 
 type BuildPodable interface {
@@ -73,14 +75,29 @@ func (g *Generator) Generate(ctx context.Context, build BuildPodable) (*v1.Pod,
 		return nil, err
 	}
 
-	return build.BuildPod(g.BuildPodConfig, buildapi.BuildContext{
-		BuildPodBuilderConfig:     buildPodBuilderConfig,
-		Secrets:                   secrets,
-		Bindings:                  bindings,
-		ImagePullSecrets:          imagePullSecrets,
-		MaximumPlatformApiVersion: g.MaximumPlatformApiVersion,
-		InjectedSidecarSupport:    g.InjectedSidecarSupport,
+	gitHostKeys, err := config.FetchGitHostKeys(ctx, g.K8sClient, g.SystemNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	kpackConfig := g.KpackConfig.Load()
+
+	pod, err := build.BuildPod(kpackConfig.BuildPodImagesFor(buildPodBuilderConfig.OS, buildPodBuilderConfig.Arch), buildapi.BuildContext{
+		BuildPodBuilderConfig:              buildPodBuilderConfig,
+		Secrets:                            secrets,
+		Bindings:                           bindings,
+		ImagePullSecrets:                   imagePullSecrets,
+		MaximumPlatformApiVersion:          g.MaximumPlatformApiVersion,
+		InjectedSidecarSupport:             kpackConfig.FeatureGates.InjectedSidecarSupport,
+		GitKnownHosts:                      gitHostKeys.KnownHosts,
+		InsecureSkipGitHostKeyVerification: gitHostKeys.InsecureSkipVerify,
+		KpackVersion:                       g.KpackVersion,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pod, applyKpackConfig(pod, kpackConfig)
 }
 
 func (g *Generator) fetchServiceBindings(ctx context.Context, build BuildPodable) ([]buildapi.ServiceBinding, error) {
@@ -259,6 +276,7 @@ func (g *Generator) fetchBuilderConfig(ctx context.Context, build BuildPodable)
 		Uid:          uid,
 		Gid:          gid,
 		OS:           config.OS,
+		Arch:         config.Architecture,
 	}, nil
 }
 
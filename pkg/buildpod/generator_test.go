@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -24,6 +25,7 @@ import (
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
 	"github.com/pivotal/kpack/pkg/buildpod"
+	"github.com/pivotal/kpack/pkg/config"
 	psfakes "github.com/pivotal/kpack/pkg/duckprovisionedserviceable/fake"
 	"github.com/pivotal/kpack/pkg/registry"
 	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
@@ -55,6 +57,7 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 			namespace           = "some-namespace"
 			windowsBuilderImage = "builder/windows"
 			linuxBuilderImage   = "builder/linux"
+			arm64BuilderImage   = "builder/linux-arm64"
 		)
 
 		var (
@@ -182,7 +185,7 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 		fakeDynamicClient := dynamicfakes.NewSimpleDynamicClient(scheme, ps)
 
 		generator := &buildpod.Generator{
-			BuildPodConfig:  buildPodConfig,
+			KpackConfig:     config.NewKpackConfigStore(config.KpackConfig{BuildPodImages: buildPodConfig}),
 			K8sClient:       fakeK8sClient,
 			KeychainFactory: keychainFactory,
 			ImageFetcher:    imageFetcher,
@@ -192,8 +195,9 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 		it.Before(func() {
 			keychainFactory.AddKeychainForSecretRef(t, secretRef, keychain)
 
-			imageFetcher.AddImage(linuxBuilderImage, createImage(t, "linux"), keychain)
-			imageFetcher.AddImage(windowsBuilderImage, createImage(t, "windows"), keychain)
+			imageFetcher.AddImage(linuxBuilderImage, createImage(t, "linux", "amd64"), keychain)
+			imageFetcher.AddImage(arm64BuilderImage, createImage(t, "linux", "arm64"), keychain)
+			imageFetcher.AddImage(windowsBuilderImage, createImage(t, "windows", "amd64"), keychain)
 		})
 
 		it("invokes the BuildPod with the builder and env config", func() {
@@ -224,6 +228,7 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 						Gid:          5678,
 						PlatformAPIs: []string{"0.4", "0.5", "0.6"},
 						OS:           "linux",
+						Arch:         "amd64",
 					},
 					Bindings: []buildapi.ServiceBinding{},
 					ImagePullSecrets: []corev1.LocalObjectReference{
@@ -539,7 +544,9 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 				},
 			}
 
-			generator.InjectedSidecarSupport = true
+			generator.KpackConfig = config.NewKpackConfigStore(config.KpackConfig{
+				FeatureGates: config.FeatureGates{InjectedSidecarSupport: true},
+			})
 
 			_, err := generator.Generate(context.TODO(), build)
 			require.NoError(t, err)
@@ -547,6 +554,181 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 			require.Len(t, build.buildPodCalls, 1)
 			assert.True(t, build.buildPodCalls[0].BuildContext.InjectedSidecarSupport)
 		})
+
+		it("passes the git host key config through the build context", func() {
+			var build = &testBuildPodable{
+				serviceAccount: serviceAccountName,
+				namespace:      namespace,
+				buildBuilderSpec: corev1alpha1.BuildBuilderSpec{
+					Image:            linuxBuilderImage,
+					ImagePullSecrets: builderPullSecrets,
+				},
+			}
+
+			_, err := fakeK8sClient.CoreV1().ConfigMaps("kpack").Create(context.TODO(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      config.GitHostKeysConfigName,
+					Namespace: "kpack",
+				},
+				Data: map[string]string{
+					config.GitKnownHostsKey:                      "github.com ssh-ed25519 AAAA",
+					config.GitInsecureSkipHostKeyVerificationKey: "true",
+				},
+			}, metav1.CreateOptions{})
+			require.NoError(t, err)
+			generator.SystemNamespace = "kpack"
+
+			_, err = generator.Generate(context.TODO(), build)
+			require.NoError(t, err)
+
+			require.Len(t, build.buildPodCalls, 1)
+			assert.Equal(t, "github.com ssh-ed25519 AAAA", build.buildPodCalls[0].BuildContext.GitKnownHosts)
+			assert.True(t, build.buildPodCalls[0].BuildContext.InsecureSkipGitHostKeyVerification)
+		})
+
+		it("applies the kpack config to the build pod", func() {
+			var build = &testBuildPodable{
+				serviceAccount: serviceAccountName,
+				namespace:      namespace,
+				buildBuilderSpec: corev1alpha1.BuildBuilderSpec{
+					Image:            linuxBuilderImage,
+					ImagePullSecrets: builderPullSecrets,
+				},
+				pod: &corev1.Pod{
+					Spec: corev1.PodSpec{
+						InitContainers: []corev1.Container{
+							{
+								Name:  "prepare",
+								Image: "gcr.io/kpack/build-init@sha256:3e8cbbd5b4a7f7a8e8d3e0aa1c7a3e1ab39f1e9a3f2c5e0e0f6ddd64e2c4b9b1",
+							},
+							{
+								Name:  "build",
+								Image: "index.docker.io/paketobuildpacks/builder:base",
+								Env:   []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://build-proxy"}},
+								Resources: corev1.ResourceRequirements{
+									Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+								},
+							},
+						},
+						Containers: []corev1.Container{
+							{
+								Name:  "completion",
+								Image: "some-registry.io/completion",
+							},
+						},
+					},
+				},
+			}
+
+			defaultResources := corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+			}
+			generator.KpackConfig = config.NewKpackConfigStore(config.KpackConfig{
+				DefaultResources: defaultResources,
+				Proxy: config.Proxy{
+					HttpProxy: "http://proxy",
+					NoProxy:   "localhost",
+				},
+				RegistryMirrors: map[string]string{
+					"gcr.io":          "mirror.example.com/gcr",
+					"index.docker.io": "mirror.example.com/dockerhub",
+				},
+			})
+
+			pod, err := generator.Generate(context.TODO(), build)
+			require.NoError(t, err)
+
+			prepare := pod.Spec.InitContainers[0]
+			assert.Equal(t, "mirror.example.com/gcr/kpack/build-init@sha256:3e8cbbd5b4a7f7a8e8d3e0aa1c7a3e1ab39f1e9a3f2c5e0e0f6ddd64e2c4b9b1", prepare.Image)
+			assert.Equal(t, defaultResources, prepare.Resources)
+			assert.Equal(t, []corev1.EnvVar{
+				{Name: "HTTP_PROXY", Value: "http://proxy"},
+				{Name: "http_proxy", Value: "http://proxy"},
+				{Name: "NO_PROXY", Value: "localhost"},
+				{Name: "no_proxy", Value: "localhost"},
+			}, prepare.Env)
+
+			buildContainer := pod.Spec.InitContainers[1]
+			assert.Equal(t, "mirror.example.com/dockerhub/paketobuildpacks/builder:base", buildContainer.Image)
+			assert.Equal(t, resource.MustParse("2Gi"), buildContainer.Resources.Limits[corev1.ResourceMemory])
+			assert.Nil(t, buildContainer.Resources.Requests)
+			assert.Equal(t, []corev1.EnvVar{
+				{Name: "HTTP_PROXY", Value: "http://build-proxy"},
+				{Name: "http_proxy", Value: "http://proxy"},
+				{Name: "NO_PROXY", Value: "localhost"},
+				{Name: "no_proxy", Value: "localhost"},
+			}, buildContainer.Env)
+
+			completion := pod.Spec.Containers[0]
+			assert.Equal(t, "some-registry.io/completion", completion.Image)
+			assert.Equal(t, defaultResources, completion.Resources)
+		})
+
+		it("passes the configured helper images to the build pod", func() {
+			var build = &testBuildPodable{
+				serviceAccount: serviceAccountName,
+				namespace:      namespace,
+				buildBuilderSpec: corev1alpha1.BuildBuilderSpec{
+					Image:            linuxBuilderImage,
+					ImagePullSecrets: builderPullSecrets,
+				},
+			}
+
+			kpackConfig := config.NewKpackConfigStore(config.KpackConfig{})
+			generator.KpackConfig = kpackConfig
+
+			require.NoError(t, kpackConfig.Update(&corev1.ConfigMap{
+				Data: map[string]string{
+					config.BuildInitImageKey: "some-registry.io/build-init",
+				},
+			}))
+
+			_, err := generator.Generate(context.TODO(), build)
+			require.NoError(t, err)
+
+			require.Len(t, build.buildPodCalls, 1)
+			assert.Equal(t, "some-registry.io/build-init", build.buildPodCalls[0].BuildPodImages.BuildInitImage)
+		})
+
+		it("passes the helper images configured for the builder platform", func() {
+			var build = &testBuildPodable{
+				serviceAccount: serviceAccountName,
+				namespace:      namespace,
+				buildBuilderSpec: corev1alpha1.BuildBuilderSpec{
+					Image:            arm64BuilderImage,
+					ImagePullSecrets: builderPullSecrets,
+				},
+			}
+
+			kpackConfig := config.NewKpackConfigStore(config.KpackConfig{
+				BuildPodImages: buildapi.BuildPodImages{
+					BuildInitImage: "some-registry.io/build-init",
+					RebaseImage:    "some-registry.io/rebase",
+				},
+			})
+			generator.KpackConfig = kpackConfig
+
+			require.NoError(t, kpackConfig.Update(&corev1.ConfigMap{
+				Data: map[string]string{
+					config.PlatformImagesKey: `
+linux/arm64:
+  buildInitImage: some-registry.io/build-init-arm64
+  completionImage: some-registry.io/completion-arm64
+`,
+				},
+			}))
+
+			_, err := generator.Generate(context.TODO(), build)
+			require.NoError(t, err)
+
+			require.Len(t, build.buildPodCalls, 1)
+			assert.Equal(t, "arm64", build.buildPodCalls[0].BuildContext.BuildPodBuilderConfig.Arch)
+
+			images := build.buildPodCalls[0].BuildPodImages
+			assert.Equal(t, "some-registry.io/build-init-arm64", images.BuildInitImage)
+			assert.Equal(t, "some-registry.io/completion-arm64", images.CompletionImage)
+			assert.Equal(t, "some-registry.io/rebase", images.RebaseImage)
+		})
 	})
 }
 
@@ -563,6 +745,7 @@ type testBuildPodable struct {
 	buildPodCalls    []buildPodCall
 	services         buildapi.Services
 	cnbBindings      corev1alpha1.CNBBindings
+	pod              *corev1.Pod
 }
 
 type buildPodCall struct {
@@ -591,6 +774,9 @@ func (tb *testBuildPodable) BuildPod(images buildapi.BuildPodImages, buildContex
 		BuildPodImages: images,
 		BuildContext:   buildContext,
 	})
+	if tb.pod != nil {
+		return tb.pod, nil
+	}
 	return &corev1.Pod{}, nil
 }
 
@@ -602,7 +788,7 @@ func (tb *testBuildPodable) Services() buildapi.Services {
 	return tb.services
 }
 
-func createImage(t *testing.T, os string) ggcrv1.Image {
+func createImage(t *testing.T, os, arch string) ggcrv1.Image {
 	image := randomImage(t)
 	var err error
 
@@ -610,6 +796,7 @@ func createImage(t *testing.T, os string) ggcrv1.Image {
 	require.NoError(t, err)
 
 	config.OS = os
+	config.Architecture = arch
 	image, err = mutate.ConfigFile(image, config)
 	require.NoError(t, err)
 
@@ -0,0 +1,90 @@
+package buildpod
+
+import (
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/pivotal/kpack/pkg/config"
+)
+
+// applyKpackConfig applies the cluster wide defaults of the kpack-config
+// ConfigMap to every container of a build pod.
+func applyKpackConfig(pod *corev1.Pod, kpackConfig config.KpackConfig) error {
+	proxyEnv := proxyEnvVars(kpackConfig.Proxy)
+
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i := range containers {
+			c := &containers[i]
+
+			if len(c.Resources.Limits) == 0 && len(c.Resources.Requests) == 0 {
+				c.Resources = *kpackConfig.DefaultResources.DeepCopy()
+			}
+
+			for _, env := range proxyEnv {
+				if !hasEnv(c.Env, env.Name) {
+					c.Env = append(c.Env, env)
+				}
+			}
+
+			image, err := mirroredImage(c.Image, kpackConfig.RegistryMirrors)
+			if err != nil {
+				return err
+			}
+			c.Image = image
+		}
+	}
+
+	return nil
+}
+
+func proxyEnvVars(proxy config.Proxy) []corev1.EnvVar {
+	var env []corev1.EnvVar
+	for _, e := range []struct {
+		names []string
+		value string
+	}{
+		{[]string{"HTTP_PROXY", "http_proxy"}, proxy.HttpProxy},
+		{[]string{"HTTPS_PROXY", "https_proxy"}, proxy.HttpsProxy},
+		{[]string{"NO_PROXY", "no_proxy"}, proxy.NoProxy},
+	} {
+		if e.value == "" {
+			continue
+		}
+		for _, n := range e.names {
+			env = append(env, corev1.EnvVar{Name: n, Value: e.value})
+		}
+	}
+	return env
+}
+
+func hasEnv(env []corev1.EnvVar, name string) bool {
+	for _, e := range env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func mirroredImage(image string, mirrors map[string]string) (string, error) {
+	if image == "" || len(mirrors) == 0 {
+		return image, nil
+	}
+
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	mirror, ok := mirrors[ref.Context().RegistryStr()]
+	if !ok {
+		return image, nil
+	}
+
+	separator := ":"
+	if _, ok := ref.(name.Digest); ok {
+		separator = "@"
+	}
+
+	return mirror + "/" + ref.Context().RepositoryStr() + separator + ref.Identifier(), nil
+}
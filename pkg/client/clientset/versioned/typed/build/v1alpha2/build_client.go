@@ -29,14 +29,22 @@ import (
 type KpackV1alpha2Interface interface {
 	RESTClient() rest.Interface
 	BuildsGetter
+	BuildQuotasGetter
+	BuildTemplatesGetter
 	BuildersGetter
+	BuilderGrantsGetter
 	BuildpacksGetter
+	ClusterBuildTemplatesGetter
 	ClusterBuildersGetter
 	ClusterBuildpacksGetter
 	ClusterStacksGetter
 	ClusterStoresGetter
 	ImagesGetter
+	ImageDefaultsGetter
+	PromotionsGetter
 	SourceResolversGetter
+	StacksGetter
+	StoresGetter
 }
 
 // KpackV1alpha2Client is used to interact with features provided by the kpack.io group.
@@ -48,14 +56,30 @@ func (c *KpackV1alpha2Client) Builds(namespace string) BuildInterface {
 	return newBuilds(c, namespace)
 }
 
+func (c *KpackV1alpha2Client) BuildQuotas(namespace string) BuildQuotaInterface {
+	return newBuildQuotas(c, namespace)
+}
+
+func (c *KpackV1alpha2Client) BuildTemplates(namespace string) BuildTemplateInterface {
+	return newBuildTemplates(c, namespace)
+}
+
 func (c *KpackV1alpha2Client) Builders(namespace string) BuilderInterface {
 	return newBuilders(c, namespace)
 }
 
+func (c *KpackV1alpha2Client) BuilderGrants(namespace string) BuilderGrantInterface {
+	return newBuilderGrants(c, namespace)
+}
+
 func (c *KpackV1alpha2Client) Buildpacks(namespace string) BuildpackInterface {
 	return newBuildpacks(c, namespace)
 }
 
+func (c *KpackV1alpha2Client) ClusterBuildTemplates() ClusterBuildTemplateInterface {
+	return newClusterBuildTemplates(c)
+}
+
 func (c *KpackV1alpha2Client) ClusterBuilders() ClusterBuilderInterface {
 	return newClusterBuilders(c)
 }
@@ -76,10 +100,26 @@ func (c *KpackV1alpha2Client) Images(namespace string) ImageInterface {
 	return newImages(c, namespace)
 }
 
+func (c *KpackV1alpha2Client) ImageDefaults(namespace string) ImageDefaultsInterface {
+	return newImageDefaults(c, namespace)
+}
+
+func (c *KpackV1alpha2Client) Promotions(namespace string) PromotionInterface {
+	return newPromotions(c, namespace)
+}
+
 func (c *KpackV1alpha2Client) SourceResolvers(namespace string) SourceResolverInterface {
 	return newSourceResolvers(c, namespace)
 }
 
+func (c *KpackV1alpha2Client) Stacks(namespace string) StackInterface {
+	return newStacks(c, namespace)
+}
+
+func (c *KpackV1alpha2Client) Stores(namespace string) StoreInterface {
+	return newStores(c, namespace)
+}
+
 // NewForConfig creates a new KpackV1alpha2Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
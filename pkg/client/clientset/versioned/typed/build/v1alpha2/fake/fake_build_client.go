@@ -32,14 +32,30 @@ func (c *FakeKpackV1alpha2) Builds(namespace string) v1alpha2.BuildInterface {
 	return &FakeBuilds{c, namespace}
 }
 
+func (c *FakeKpackV1alpha2) BuildQuotas(namespace string) v1alpha2.BuildQuotaInterface {
+	return &FakeBuildQuotas{c, namespace}
+}
+
+func (c *FakeKpackV1alpha2) BuildTemplates(namespace string) v1alpha2.BuildTemplateInterface {
+	return &FakeBuildTemplates{c, namespace}
+}
+
 func (c *FakeKpackV1alpha2) Builders(namespace string) v1alpha2.BuilderInterface {
 	return &FakeBuilders{c, namespace}
 }
 
+func (c *FakeKpackV1alpha2) BuilderGrants(namespace string) v1alpha2.BuilderGrantInterface {
+	return &FakeBuilderGrants{c, namespace}
+}
+
 func (c *FakeKpackV1alpha2) Buildpacks(namespace string) v1alpha2.BuildpackInterface {
 	return &FakeBuildpacks{c, namespace}
 }
 
+func (c *FakeKpackV1alpha2) ClusterBuildTemplates() v1alpha2.ClusterBuildTemplateInterface {
+	return &FakeClusterBuildTemplates{c}
+}
+
 func (c *FakeKpackV1alpha2) ClusterBuilders() v1alpha2.ClusterBuilderInterface {
 	return &FakeClusterBuilders{c}
 }
@@ -60,10 +76,26 @@ func (c *FakeKpackV1alpha2) Images(namespace string) v1alpha2.ImageInterface {
 	return &FakeImages{c, namespace}
 }
 
+func (c *FakeKpackV1alpha2) ImageDefaults(namespace string) v1alpha2.ImageDefaultsInterface {
+	return &FakeImageDefaults{c, namespace}
+}
+
+func (c *FakeKpackV1alpha2) Promotions(namespace string) v1alpha2.PromotionInterface {
+	return &FakePromotions{c, namespace}
+}
+
 func (c *FakeKpackV1alpha2) SourceResolvers(namespace string) v1alpha2.SourceResolverInterface {
 	return &FakeSourceResolvers{c, namespace}
 }
 
+func (c *FakeKpackV1alpha2) Stacks(namespace string) v1alpha2.StackInterface {
+	return &FakeStacks{c, namespace}
+}
+
+func (c *FakeKpackV1alpha2) Stores(namespace string) v1alpha2.StoreInterface {
+	return &FakeStores{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeKpackV1alpha2) RESTClient() rest.Interface {
@@ -20,10 +20,18 @@ package v1alpha2
 
 type BuildExpansion interface{}
 
+type BuildQuotaExpansion interface{}
+
+type BuildTemplateExpansion interface{}
+
 type BuilderExpansion interface{}
 
+type BuilderGrantExpansion interface{}
+
 type BuildpackExpansion interface{}
 
+type ClusterBuildTemplateExpansion interface{}
+
 type ClusterBuilderExpansion interface{}
 
 type ClusterBuildpackExpansion interface{}
@@ -34,4 +42,12 @@ type ClusterStoreExpansion interface{}
 
 type ImageExpansion interface{}
 
+type ImageDefaultsExpansion interface{}
+
+type PromotionExpansion interface{}
+
 type SourceResolverExpansion interface{}
+
+type StackExpansion interface{}
+
+type StoreExpansion interface{}
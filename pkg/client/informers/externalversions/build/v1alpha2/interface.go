@@ -26,10 +26,18 @@ import (
 type Interface interface {
 	// Builds returns a BuildInformer.
 	Builds() BuildInformer
+	// BuildQuotas returns a BuildQuotaInformer.
+	BuildQuotas() BuildQuotaInformer
+	// BuildTemplates returns a BuildTemplateInformer.
+	BuildTemplates() BuildTemplateInformer
 	// Builders returns a BuilderInformer.
 	Builders() BuilderInformer
+	// BuilderGrants returns a BuilderGrantInformer.
+	BuilderGrants() BuilderGrantInformer
 	// Buildpacks returns a BuildpackInformer.
 	Buildpacks() BuildpackInformer
+	// ClusterBuildTemplates returns a ClusterBuildTemplateInformer.
+	ClusterBuildTemplates() ClusterBuildTemplateInformer
 	// ClusterBuilders returns a ClusterBuilderInformer.
 	ClusterBuilders() ClusterBuilderInformer
 	// ClusterBuildpacks returns a ClusterBuildpackInformer.
@@ -40,8 +48,16 @@ type Interface interface {
 	ClusterStores() ClusterStoreInformer
 	// Images returns a ImageInformer.
 	Images() ImageInformer
+	// ImageDefaults returns a ImageDefaultsInformer.
+	ImageDefaults() ImageDefaultsInformer
+	// Promotions returns a PromotionInformer.
+	Promotions() PromotionInformer
 	// SourceResolvers returns a SourceResolverInformer.
 	SourceResolvers() SourceResolverInformer
+	// Stacks returns a StackInformer.
+	Stacks() StackInformer
+	// Stores returns a StoreInformer.
+	Stores() StoreInformer
 }
 
 type version struct {
@@ -60,16 +76,36 @@ func (v *version) Builds() BuildInformer {
 	return &buildInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BuildQuotas returns a BuildQuotaInformer.
+func (v *version) BuildQuotas() BuildQuotaInformer {
+	return &buildQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// BuildTemplates returns a BuildTemplateInformer.
+func (v *version) BuildTemplates() BuildTemplateInformer {
+	return &buildTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Builders returns a BuilderInformer.
 func (v *version) Builders() BuilderInformer {
 	return &builderInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BuilderGrants returns a BuilderGrantInformer.
+func (v *version) BuilderGrants() BuilderGrantInformer {
+	return &builderGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Buildpacks returns a BuildpackInformer.
 func (v *version) Buildpacks() BuildpackInformer {
 	return &buildpackInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterBuildTemplates returns a ClusterBuildTemplateInformer.
+func (v *version) ClusterBuildTemplates() ClusterBuildTemplateInformer {
+	return &clusterBuildTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterBuilders returns a ClusterBuilderInformer.
 func (v *version) ClusterBuilders() ClusterBuilderInformer {
 	return &clusterBuilderInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -95,7 +131,27 @@ func (v *version) Images() ImageInformer {
 	return &imageInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ImageDefaults returns a ImageDefaultsInformer.
+func (v *version) ImageDefaults() ImageDefaultsInformer {
+	return &imageDefaultsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Promotions returns a PromotionInformer.
+func (v *version) Promotions() PromotionInformer {
+	return &promotionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // SourceResolvers returns a SourceResolverInformer.
 func (v *version) SourceResolvers() SourceResolverInformer {
 	return &sourceResolverInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// Stacks returns a StackInformer.
+func (v *version) Stacks() StackInformer {
+	return &stackInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Stores returns a StoreInformer.
+func (v *version) Stores() StoreInformer {
+	return &storeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
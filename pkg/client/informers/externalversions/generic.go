@@ -72,10 +72,18 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=kpack.io, Version=v1alpha2
 	case v1alpha2.SchemeGroupVersion.WithResource("builds"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().Builds().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("buildquotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().BuildQuotas().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("buildtemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().BuildTemplates().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("builders"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().Builders().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("buildergrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().BuilderGrants().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("buildpacks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().Buildpacks().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("clusterbuildtemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().ClusterBuildTemplates().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("clusterbuilders"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().ClusterBuilders().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("clusterbuildpacks"):
@@ -86,8 +94,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().ClusterStores().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("images"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().Images().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("imagedefaults"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().ImageDefaults().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("promotions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().Promotions().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("sourceresolvers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().SourceResolvers().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("stacks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().Stacks().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("stores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kpack().V1alpha2().Stores().Informer()}, nil
 
 	}
 
@@ -0,0 +1,60 @@
+package v1alpha2
+
+import (
+	"sort"
+
+	v1alpha2 "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+// ImageIndex is the name of the index of Builds by the Image that created
+// them. Register ImageIndexFunc under it on the Build informer to look up the
+// Builds of an Image without listing every Build in the namespace.
+const ImageIndex = "image"
+
+// ImageIndexFunc indexes Builds by the namespace and name of their Image.
+func ImageIndexFunc(obj interface{}) ([]string, error) {
+	build, ok := obj.(*v1alpha2.Build)
+	if !ok {
+		return nil, nil
+	}
+
+	image, ok := build.Labels[v1alpha2.ImageLabel]
+	if !ok {
+		return nil, nil
+	}
+	return []string{build.Namespace + "/" + image}, nil
+}
+
+// BuildListerExpansion allows custom methods to be added to
+// BuildLister.
+type BuildListerExpansion interface{}
+
+// BuildNamespaceListerExpansion allows custom methods to be added to
+// BuildNamespaceLister.
+type BuildNamespaceListerExpansion interface {
+	// ListForImage lists the Builds of the Image ordered by build number,
+	// oldest first.
+	// Objects returned here must be treated as read-only.
+	ListForImage(image *v1alpha2.Image) (ret []*v1alpha2.Build, err error)
+}
+
+// ListForImage lists the Builds of the Image ordered by build number.
+func (s buildNamespaceLister) ListForImage(image *v1alpha2.Image) (ret []*v1alpha2.Build, err error) {
+	if _, ok := s.indexer.GetIndexers()[ImageIndex]; !ok {
+		ret, err = s.List(image.BuildsSelector())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		objs, err := s.indexer.ByIndex(ImageIndex, s.namespace+"/"+image.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			ret = append(ret, obj.(*v1alpha2.Build))
+		}
+	}
+
+	sort.Sort(v1alpha2.ByBuildNumber(ret))
+	return ret, nil
+}
@@ -18,18 +18,34 @@
 
 package v1alpha2
 
-// BuildListerExpansion allows custom methods to be added to
-// BuildLister.
-type BuildListerExpansion interface{}
+// BuildQuotaListerExpansion allows custom methods to be added to
+// BuildQuotaLister.
+type BuildQuotaListerExpansion interface{}
 
-// BuildNamespaceListerExpansion allows custom methods to be added to
-// BuildNamespaceLister.
-type BuildNamespaceListerExpansion interface{}
+// BuildQuotaNamespaceListerExpansion allows custom methods to be added to
+// BuildQuotaNamespaceLister.
+type BuildQuotaNamespaceListerExpansion interface{}
+
+// BuildTemplateListerExpansion allows custom methods to be added to
+// BuildTemplateLister.
+type BuildTemplateListerExpansion interface{}
+
+// BuildTemplateNamespaceListerExpansion allows custom methods to be added to
+// BuildTemplateNamespaceLister.
+type BuildTemplateNamespaceListerExpansion interface{}
 
 // BuilderListerExpansion allows custom methods to be added to
 // BuilderLister.
 type BuilderListerExpansion interface{}
 
+// BuilderGrantListerExpansion allows custom methods to be added to
+// BuilderGrantLister.
+type BuilderGrantListerExpansion interface{}
+
+// BuilderGrantNamespaceListerExpansion allows custom methods to be added to
+// BuilderGrantNamespaceLister.
+type BuilderGrantNamespaceListerExpansion interface{}
+
 // BuilderNamespaceListerExpansion allows custom methods to be added to
 // BuilderNamespaceLister.
 type BuilderNamespaceListerExpansion interface{}
@@ -42,6 +58,10 @@ type BuildpackListerExpansion interface{}
 // BuildpackNamespaceLister.
 type BuildpackNamespaceListerExpansion interface{}
 
+// ClusterBuildTemplateListerExpansion allows custom methods to be added to
+// ClusterBuildTemplateLister.
+type ClusterBuildTemplateListerExpansion interface{}
+
 // ClusterBuilderListerExpansion allows custom methods to be added to
 // ClusterBuilderLister.
 type ClusterBuilderListerExpansion interface{}
@@ -62,10 +82,26 @@ type ClusterStoreListerExpansion interface{}
 // ImageLister.
 type ImageListerExpansion interface{}
 
+// ImageDefaultsListerExpansion allows custom methods to be added to
+// ImageDefaultsLister.
+type ImageDefaultsListerExpansion interface{}
+
+// ImageDefaultsNamespaceListerExpansion allows custom methods to be added to
+// ImageDefaultsNamespaceLister.
+type ImageDefaultsNamespaceListerExpansion interface{}
+
 // ImageNamespaceListerExpansion allows custom methods to be added to
 // ImageNamespaceLister.
 type ImageNamespaceListerExpansion interface{}
 
+// PromotionListerExpansion allows custom methods to be added to
+// PromotionLister.
+type PromotionListerExpansion interface{}
+
+// PromotionNamespaceListerExpansion allows custom methods to be added to
+// PromotionNamespaceLister.
+type PromotionNamespaceListerExpansion interface{}
+
 // SourceResolverListerExpansion allows custom methods to be added to
 // SourceResolverLister.
 type SourceResolverListerExpansion interface{}
@@ -73,3 +109,19 @@ type SourceResolverListerExpansion interface{}
 // SourceResolverNamespaceListerExpansion allows custom methods to be added to
 // SourceResolverNamespaceLister.
 type SourceResolverNamespaceListerExpansion interface{}
+
+// StackListerExpansion allows custom methods to be added to
+// StackLister.
+type StackListerExpansion interface{}
+
+// StackNamespaceListerExpansion allows custom methods to be added to
+// StackNamespaceLister.
+type StackNamespaceListerExpansion interface{}
+
+// StoreListerExpansion allows custom methods to be added to
+// StoreLister.
+type StoreListerExpansion interface{}
+
+// StoreNamespaceListerExpansion allows custom methods to be added to
+// StoreNamespaceLister.
+type StoreNamespaceListerExpansion interface{}
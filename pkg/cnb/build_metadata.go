@@ -5,7 +5,9 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"strings"
 
 	lifecyclebuildpack "github.com/buildpacks/lifecycle/buildpack"
 	"github.com/buildpacks/lifecycle/platform"
@@ -24,8 +26,19 @@ type BuildMetadata struct {
 	LatestImage       string                             `json:"latestImage"`
 	StackID           string                             `json:"stackID"`
 	StackRunImage     string                             `json:"stackRunImage"`
+	CacheMetrics      *corev1alpha1.BuildCacheMetrics    `json:"cacheMetrics,omitempty"`
+	ImageDigest       string                             `json:"imageDigest,omitempty"`
+	PushedTags        []string                           `json:"pushedTags,omitempty"`
+	ImageLabels       map[string]string                  `json:"imageLabels,omitempty"`
+	ResultsConfigMap  string                             `json:"resultsConfigMap,omitempty"`
 }
 
+var ErrBuildMetadataTooLarge = errors.New("compressed metadata size too large")
+
+// lifecycleLabelPrefix identifies labels written by the lifecycle. They hold
+// large metadata documents that are already surfaced elsewhere in the status.
+const lifecycleLabelPrefix = "io.buildpacks."
+
 type ImageFetcher interface {
 	Fetch(keychain authn.Keychain, repoName string) (ggcrv1.Image, string, error)
 }
@@ -47,9 +60,126 @@ func (r *RemoteMetadataRetriever) GetBuildMetadata(builtImageRef, cacheTag strin
 		LatestCacheImage:  cacheImageRef,
 		StackRunImage:     buildImg.stack.RunImage,
 		StackID:           buildImg.stack.ID,
+		ImageDigest:       imageDigest(buildImg.identifier),
+		ImageLabels:       buildImg.labels,
 	}, nil
 }
 
+// GetBuildMetadataFromReport retrieves the metadata of the image recorded in
+// the lifecycle's export report along with the digest and tags it pushed.
+func (r *RemoteMetadataRetriever) GetBuildMetadataFromReport(report platform.ExportReport, cacheTag string, keychain authn.Keychain) (*BuildMetadata, error) {
+	if len(report.Image.Tags) == 0 {
+		return nil, errors.New("no image found in report")
+	}
+
+	builtImageRef := fmt.Sprintf("%s@%s", report.Image.Tags[0], report.Image.Digest)
+
+	buildMetadata, err := r.GetBuildMetadata(builtImageRef, cacheTag, keychain)
+	if err != nil {
+		return nil, err
+	}
+
+	buildMetadata.ImageDigest = report.Image.Digest
+	buildMetadata.PushedTags = report.Image.Tags
+	return buildMetadata, nil
+}
+
+// GetCacheMetrics compares the app layers of the built image and the layers of
+// the cache image with those of the previous build to determine how many layers
+// were reused rather than rebuilt.
+func (r *RemoteMetadataRetriever) GetCacheMetrics(builtImageRef, previousImageRef, cacheImageRef, previousCacheImageRef string, keychain authn.Keychain) (*corev1alpha1.BuildCacheMetrics, error) {
+	appImage, _, err := r.ImageFetcher.Fetch(keychain, builtImageRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch app image")
+	}
+
+	appLayers, err := appLayerDiffIDs(appImage)
+	if err != nil {
+		return nil, err
+	}
+
+	previousLayers, err := r.layerDiffIDs(previousImageRef, keychain)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch previous image")
+	}
+
+	cacheLayers, err := r.layerDiffIDs(cacheImageRef, keychain)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch cache image")
+	}
+
+	previousCacheLayers, err := r.layerDiffIDs(previousCacheImageRef, keychain)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to fetch previous cache image")
+	}
+
+	metrics := &corev1alpha1.BuildCacheMetrics{
+		Layers:              int64(len(appLayers)),
+		ReusedLayers:        countShared(appLayers, previousLayers),
+		CacheLayers:         int64(len(cacheLayers)),
+		RestoredCacheLayers: countShared(cacheLayers, previousCacheLayers),
+	}
+
+	if total := metrics.Layers + metrics.CacheLayers; total > 0 {
+		metrics.HitRatioPercent = (metrics.ReusedLayers + metrics.RestoredCacheLayers) * 100 / total
+	}
+	return metrics, nil
+}
+
+func (r *RemoteMetadataRetriever) layerDiffIDs(ref string, keychain authn.Keychain) ([]ggcrv1.Hash, error) {
+	if ref == "" {
+		return nil, nil
+	}
+
+	image, _, err := r.ImageFetcher.Fetch(keychain, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	configFile, err := image.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	return configFile.RootFS.DiffIDs, nil
+}
+
+// appLayerDiffIDs returns the diffIDs of the layers above the run image.
+func appLayerDiffIDs(appImage ggcrv1.Image) ([]ggcrv1.Hash, error) {
+	var layerMetadata appLayersMetadata
+	err := imagehelpers.GetLabel(appImage, platform.LayerMetadataLabel, &layerMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	configFile, err := appImage.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	diffIDs := configFile.RootFS.DiffIDs
+	for i, diffID := range diffIDs {
+		if diffID.String() == layerMetadata.RunImage.TopLayer {
+			return diffIDs[i+1:], nil
+		}
+	}
+	return diffIDs, nil
+}
+
+func countShared(layers, previousLayers []ggcrv1.Hash) int64 {
+	previous := make(map[ggcrv1.Hash]struct{}, len(previousLayers))
+	for _, layer := range previousLayers {
+		previous[layer] = struct{}{}
+	}
+
+	var shared int64
+	for _, layer := range layers {
+		if _, ok := previous[layer]; ok {
+			shared++
+		}
+	}
+	return shared
+}
+
 func (r *RemoteMetadataRetriever) getBuiltImage(tag string, keychain authn.Keychain) (builtImage, error) {
 	appImage, appImageId, err := r.ImageFetcher.Fetch(keychain, tag)
 	if err != nil {
@@ -99,8 +229,14 @@ func readBuiltImage(appImage ggcrv1.Image, appImageId string) (builtImage, error
 		return builtImage{}, err
 	}
 
+	configFile, err := appImage.ConfigFile()
+	if err != nil {
+		return builtImage{}, err
+	}
+
 	return builtImage{
 		identifier:        appImageId,
+		labels:            userLabels(configFile.Config.Labels),
 		buildpackMetadata: buildMetadata.Buildpacks,
 		stack: builtImageStack{
 			RunImage: baseImageRef.Context().String() + "@" + runImageRef.Identifier(),
@@ -111,6 +247,7 @@ func readBuiltImage(appImage ggcrv1.Image, appImageId string) (builtImage, error
 
 type builtImage struct {
 	identifier        string
+	labels            map[string]string
 	buildpackMetadata []lifecyclebuildpack.GroupBuildpack
 	stack             builtImageStack
 }
@@ -125,6 +262,28 @@ type RunImageAppMetadata struct {
 	Reference string `json:"reference" toml:"reference"`
 }
 
+func userLabels(labels map[string]string) map[string]string {
+	var filtered map[string]string
+	for k, v := range labels {
+		if strings.HasPrefix(k, lifecycleLabelPrefix) {
+			continue
+		}
+		if filtered == nil {
+			filtered = map[string]string{}
+		}
+		filtered[k] = v
+	}
+	return filtered
+}
+
+func imageDigest(identifier string) string {
+	parts := strings.SplitN(identifier, "@", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[1]
+}
+
 func buildMetadataFromBuiltImage(image builtImage) corev1alpha1.BuildpackMetadataList {
 	bpMetadata := make([]corev1alpha1.BuildpackMetadata, 0, len(image.buildpackMetadata))
 	for _, metadata := range image.buildpackMetadata {
@@ -158,7 +317,7 @@ func CompressBuildMetadata(metadata *BuildMetadata) ([]byte, error) {
 	encodedLength := base64.StdEncoding.EncodedLen(len(src))
 	const maxTerminationMessageSize = 4096
 	if encodedLength > maxTerminationMessageSize {
-		return nil, errors.New("compressed metadata size too large")
+		return nil, ErrBuildMetadataTooLarge
 	}
 	dst := make([]byte, encodedLength)
 	base64.StdEncoding.Encode(dst, src)
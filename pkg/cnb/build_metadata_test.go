@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/buildpacks/lifecycle/platform"
 	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/sclevine/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -108,6 +112,42 @@ func testMetadataRetriever(t *testing.T, when spec.G, it spec.S) {
 					assert.Equal(t, fmt.Sprintf("%s@%s", cacheTag, cacheDigest.String()), metadata.LatestCacheImage)
 				})
 
+				it("surfaces image labels not written by the lifecycle", func() {
+					labeledImage, err := imagehelpers.SetStringLabel(appImage, "org.opencontainers.image.source", "https://github.com/some/repo")
+					require.NoError(t, err)
+					imageFetcher.AddImage(appTag, labeledImage, fakeKeychain)
+
+					metadata, err := retriever.GetBuildMetadata(appTag, cacheTag, fakeKeychain)
+					require.NoError(t, err)
+
+					labeledDigest, err := labeledImage.Digest()
+					require.NoError(t, err)
+					assert.Equal(t, labeledDigest.String(), metadata.ImageDigest)
+					assert.Equal(t, map[string]string{"org.opencontainers.image.source": "https://github.com/some/repo"}, metadata.ImageLabels)
+				})
+
+				it("retrieves the metadata of the image in the export report", func() {
+					imageFetcher.AddImage(fmt.Sprintf("%s@%s", appTag, appDigest), appImage, fakeKeychain)
+
+					metadata, err := retriever.GetBuildMetadataFromReport(platform.ExportReport{
+						Image: platform.ImageReport{
+							Tags:   []string{appTag, "reg.io/appimage/name:other-tag"},
+							Digest: appDigest,
+						},
+					}, cacheTag, fakeKeychain)
+					require.NoError(t, err)
+
+					assert.Equal(t, fmt.Sprintf("%s@%s", appTag, appDigest), metadata.LatestImage)
+					assert.Equal(t, appDigest, metadata.ImageDigest)
+					assert.Equal(t, []string{appTag, "reg.io/appimage/name:other-tag"}, metadata.PushedTags)
+					assert.Equal(t, stackID, metadata.StackID)
+				})
+
+				it("errors when the export report has no image", func() {
+					_, err := retriever.GetBuildMetadataFromReport(platform.ExportReport{}, cacheTag, fakeKeychain)
+					require.EqualError(t, err, "no image found in report")
+				})
+
 				it("does not error for bad cache tag", func() {
 					metadata, err := retriever.GetBuildMetadata(appTag, "invalid", fakeKeychain)
 					assert.NoError(t, err)
@@ -144,6 +184,88 @@ func testMetadataRetriever(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 		})
+
+		when("GetCacheMetrics", func() {
+			const (
+				previousAppTag   = "reg.io/appimage/name@sha256:2a1e2ba6ba6fd9d8bbba5d1eb8e4a9e0e1c8d4b2e1ee4b1a9f5c3e3fa4f9b2c1"
+				previousCacheTag = "reg.io/cacheimage/name@sha256:7c4d1f0e5b3a2c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d"
+			)
+
+			it.Before(func() {
+				imageFetcher = registryfakes.NewFakeClient()
+				fakeKeychain = &registryfakes.FakeKeychain{}
+				retriever = &cnb.RemoteMetadataRetriever{
+					ImageFetcher: imageFetcher,
+				}
+
+				runImage := randomImage(t)
+				configFile, err := runImage.ConfigFile()
+				require.NoError(t, err)
+				topLayer := configFile.RootFS.DiffIDs[len(configFile.RootFS.DiffIDs)-1]
+
+				reusedLayer, removedLayer, newLayer := randomLayer(t), randomLayer(t), randomLayer(t)
+
+				previousImage, err := mutate.AppendLayers(runImage, removedLayer, reusedLayer)
+				require.NoError(t, err)
+				imageFetcher.AddImage(previousAppTag, previousImage, fakeKeychain)
+
+				appImage, err = mutate.AppendLayers(runImage, reusedLayer, newLayer)
+				require.NoError(t, err)
+				appImage, err = imagehelpers.SetStringLabel(appImage, "io.buildpacks.lifecycle.metadata", fmt.Sprintf(`{"runImage": {"topLayer": "%s"}}`, topLayer))
+				require.NoError(t, err)
+				imageFetcher.AddImage(appTag, appImage, fakeKeychain)
+
+				restoredCacheLayer, removedCacheLayer, newCacheLayer := randomLayer(t), randomLayer(t), randomLayer(t)
+
+				previousCacheImage, err := mutate.AppendLayers(empty.Image, restoredCacheLayer, removedCacheLayer)
+				require.NoError(t, err)
+				imageFetcher.AddImage(previousCacheTag, previousCacheImage, fakeKeychain)
+
+				cacheImage, err = mutate.AppendLayers(empty.Image, restoredCacheLayer, newCacheLayer)
+				require.NoError(t, err)
+				imageFetcher.AddImage(cacheTag, cacheImage, fakeKeychain)
+			})
+
+			it("counts the app and cache layers reused from the previous build", func() {
+				metrics, err := retriever.GetCacheMetrics(appTag, previousAppTag, cacheTag, previousCacheTag, fakeKeychain)
+				require.NoError(t, err)
+
+				assert.Equal(t, &corev1alpha1.BuildCacheMetrics{
+					Layers:              2,
+					ReusedLayers:        1,
+					CacheLayers:         2,
+					RestoredCacheLayers: 1,
+					HitRatioPercent:     50,
+				}, metrics)
+			})
+
+			it("reports no reuse without a previous build", func() {
+				metrics, err := retriever.GetCacheMetrics(appTag, "", cacheTag, "", fakeKeychain)
+				require.NoError(t, err)
+
+				assert.Equal(t, &corev1alpha1.BuildCacheMetrics{
+					Layers:          2,
+					CacheLayers:     2,
+					HitRatioPercent: 0,
+				}, metrics)
+			})
+
+			it("ignores the cache when there is no cache image", func() {
+				metrics, err := retriever.GetCacheMetrics(appTag, previousAppTag, "", "", fakeKeychain)
+				require.NoError(t, err)
+
+				assert.Equal(t, &corev1alpha1.BuildCacheMetrics{
+					Layers:          2,
+					ReusedLayers:    1,
+					HitRatioPercent: 50,
+				}, metrics)
+			})
+
+			it("errors when the previous image cannot be fetched", func() {
+				_, err := retriever.GetCacheMetrics(appTag, "reg.io/appimage/name@sha256:missing", cacheTag, previousCacheTag, fakeKeychain)
+				require.EqualError(t, err, "unable to fetch previous image: unexpected keychain")
+			})
+		})
 	})
 }
 
@@ -189,3 +311,9 @@ func randomImage(t *testing.T) ggcrv1.Image {
 	require.NoError(t, err)
 	return image
 }
+
+func randomLayer(t *testing.T) ggcrv1.Layer {
+	layer, err := random.Layer(10, types.DockerLayer)
+	require.NoError(t, err)
+	return layer
+}
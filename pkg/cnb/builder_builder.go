@@ -50,6 +50,8 @@ type builderBlder struct {
 	kpackVersion      string
 	runImage          string
 	mixins            []string
+	relaxMixins       bool
+	mixinMismatches   []buildapi.BuildpackMixinMismatch
 	os                string
 }
 
@@ -71,6 +73,7 @@ func (bb *builderBlder) AddStack(baseImage v1.Image, clusterStack *buildapi.Clus
 	bb.stackId = clusterStack.Status.Id
 	bb.runImage = clusterStack.Status.RunImage.Image
 	bb.mixins = clusterStack.Status.Mixins
+	bb.relaxMixins = clusterStack.Spec.RelaxMixinValidation
 	bb.cnbUserId = clusterStack.Status.UserID
 	bb.cnbGroupId = clusterStack.Status.GroupID
 	return nil
@@ -180,14 +183,27 @@ func (bb *builderBlder) validateBuilder(sortedBuildpacks []DescriptiveBuildpackI
 		return err
 	}
 	buildpackApis := append(bb.LifecycleMetadata.APIs.Buildpack.Deprecated, bb.LifecycleMetadata.APIs.Buildpack.Supported...)
+	bb.mixinMismatches = nil
 	for _, bpInfo := range sortedBuildpacks {
 
 		bpLayerInfo := bb.buildpackLayers[bpInfo].BuildpackLayerInfo
 		err := bpLayerInfo.supports(buildpackApis, bb.stackId, bb.mixins, relaxedMixinContract(platformApis))
+		var missing missingMixinsError
+		if errors.As(err, &missing) {
+			bb.mixinMismatches = append(bb.mixinMismatches, buildapi.BuildpackMixinMismatch{
+				BuildpackInfo: bpInfo.BuildpackInfo,
+				MissingMixins: missing,
+			})
+			continue
+		}
 		if err != nil {
 			return errors.Wrapf(err, "validating buildpack %s", bpInfo)
 		}
 	}
+
+	if len(bb.mixinMismatches) > 0 && !bb.relaxMixins {
+		return &buildapi.MixinMismatchError{Mismatches: bb.mixinMismatches}
+	}
 	return nil
 }
 
@@ -175,6 +175,11 @@ func (r *buildpackResolver) resolveFromClusterStore(id string, store *v1alpha2.C
 					Namespace:      store.Spec.ServiceAccountRef.Namespace,
 				}
 			}
+
+			if store.Spec.SecretRef != nil {
+				secretRef.Namespace = store.Spec.SecretRef.Namespace
+				secretRef.ImagePullSecrets = []v1.LocalObjectReference{{Name: store.Spec.SecretRef.Name}}
+			}
 			matchingBuildpacks = append(matchingBuildpacks, K8sRemoteBuildpack{
 				Buildpack: status,
 				SecretRef: secretRef,
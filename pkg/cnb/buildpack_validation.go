@@ -18,6 +18,10 @@ func (bl BuildpackLayerInfo) supports(buildpackApis []string, id string, mixins
 		return errors.Errorf("unsupported buildpack api: %s, expecting: %s", bl.API, strings.Join(buildpackApis, ", "))
 	}
 
+	return bl.supportsStack(id, mixins, relaxedMixinContract)
+}
+
+func (bl BuildpackLayerInfo) supportsStack(id string, mixins []string, relaxedMixinContract bool) error {
 	for _, s := range bl.Stacks {
 		buildpackVersion, err := semver.NewVersion(bl.API)
 		if err != nil {
@@ -43,7 +47,13 @@ func validateRequiredMixins(providedMixins, requiredMixins []string, relaxedMixi
 		return nil
 	}
 
-	return errors.Errorf("stack missing mixin(s): %s", strings.Join(missing, ", "))
+	return missingMixinsError(missing)
+}
+
+type missingMixinsError []string
+
+func (e missingMixinsError) Error() string {
+	return "stack missing mixin(s): " + strings.Join(e, ", ")
 }
 
 func present(haystack []string, needle string) bool {
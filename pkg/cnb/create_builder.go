@@ -91,6 +91,8 @@ func (r *RemoteBuilderCreator) CreateBuilder(
 		ObservedStackGeneration: clusterStack.Status.ObservedGeneration,
 		ObservedStoreGeneration: fetcher.ClusterStoreObservedGeneration(),
 		OS:                      config.OS,
+		MixinMismatches:         builderBldr.mixinMismatches,
+		StackDeprecation:        clusterStack.Status.Deprecation,
 	}
 
 	return builder, nil
@@ -575,6 +575,14 @@ func testCreateBuilderOs(os string, t *testing.T, when spec.G, it spec.S) {
 
 		})
 
+		it("records the deprecation of the stack", func() {
+			stack.Status.Deprecation = &buildapi.StackDeprecation{Message: "some-message"}
+
+			builderRecord, err := subject.CreateBuilder(ctx, keychain, fetcher, stack, clusterBuilderSpec)
+			require.NoError(t, err)
+			assert.Equal(t, &buildapi.StackDeprecation{Message: "some-message"}, builderRecord.StackDeprecation)
+		})
+
 		it("creates images deterministically ", func() {
 			original, err := subject.CreateBuilder(ctx, keychain, fetcher, stack, clusterBuilderSpec)
 			require.NoError(t, err)
@@ -636,6 +644,43 @@ func testCreateBuilderOs(os string, t *testing.T, when spec.G, it spec.S) {
 
 				_, err := subject.CreateBuilder(ctx, keychain, fetcher, stack, clusterBuilderSpec)
 				require.EqualError(t, err, "validating buildpack io.buildpack.unsupported.mixin@v4: stack missing mixin(s): something-missing-mixin, something-missing-mixin2")
+
+				var mismatchErr *buildapi.MixinMismatchError
+				require.ErrorAs(t, err, &mismatchErr)
+				require.Equal(t, []buildapi.BuildpackMixinMismatch{{
+					BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.unsupported.mixin", Version: "v4"},
+					MissingMixins: []string{"something-missing-mixin", "something-missing-mixin2"},
+				}}, mismatchErr.Mismatches)
+			})
+
+			it("creates the builder and reports mismatches when the stack relaxes mixin validation", func() {
+				stack.Spec.RelaxMixinValidation = true
+
+				addBuildpack(t, "io.buildpack.unsupported.mixin", "v4", "buildpack.1.com", "0.2",
+					[]corev1alpha1.BuildpackStack{
+						{
+							ID:     stackID,
+							Mixins: []string{mixin, "something-missing-mixin"},
+						},
+					})
+
+				clusterBuilderSpec.Order = []buildapi.BuilderOrderEntry{{
+					Group: []buildapi.BuilderBuildpackRef{{
+						BuildpackRef: corev1alpha1.BuildpackRef{
+							BuildpackInfo: corev1alpha1.BuildpackInfo{
+								Id:      "io.buildpack.unsupported.mixin",
+								Version: "v4",
+							},
+						},
+					}},
+				}}
+
+				record, err := subject.CreateBuilder(ctx, keychain, fetcher, stack, clusterBuilderSpec)
+				require.NoError(t, err)
+				require.Equal(t, []buildapi.BuildpackMixinMismatch{{
+					BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.unsupported.mixin", Version: "v4"},
+					MissingMixins: []string{"something-missing-mixin"},
+				}}, record.MixinMismatches)
 			})
 
 			it("works with relaxed mixin contract", func() {
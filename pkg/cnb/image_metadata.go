@@ -0,0 +1,37 @@
+package cnb
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const imageMetadataDir = ".kpack"
+
+// WriteImageMetadata writes the image metadata of the build to
+// .kpack/metadata.json in the app dir so it is exported with the app. The
+// metadata is the JSON object passed to the prepare step. Nothing is written
+// if the build has no image metadata.
+func WriteImageMetadata(appDir, metadata string) error {
+	if metadata == "" {
+		return nil
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal([]byte(metadata), &values); err != nil {
+		return errors.Wrap(err, "invalid image metadata")
+	}
+
+	data, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(appDir, imageMetadataDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "metadata.json"), data, 0644)
+}
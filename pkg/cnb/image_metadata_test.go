@@ -0,0 +1,46 @@
+package cnb_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/cnb"
+)
+
+func TestWriteImageMetadata(t *testing.T) {
+	spec.Run(t, "WriteImageMetadata", testWriteImageMetadata)
+}
+
+func testWriteImageMetadata(t *testing.T, when spec.G, it spec.S) {
+	var appDir string
+
+	it.Before(func() {
+		appDir = t.TempDir()
+	})
+
+	it("writes the metadata to .kpack/metadata.json", func() {
+		err := cnb.WriteImageMetadata(appDir, `{"team":"payments","io.kpack.build.name":"some-build"}`)
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(appDir, ".kpack", "metadata.json"))
+		require.NoError(t, err)
+		require.JSONEq(t, `{"team":"payments","io.kpack.build.name":"some-build"}`, string(data))
+	})
+
+	it("does not write a file without metadata", func() {
+		err := cnb.WriteImageMetadata(appDir, "")
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(appDir, ".kpack"))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	it("returns an error for invalid metadata", func() {
+		err := cnb.WriteImageMetadata(appDir, "not-json")
+		require.EqualError(t, err, "invalid image metadata: invalid character 'o' in literal null (expecting 'u')")
+	})
+}
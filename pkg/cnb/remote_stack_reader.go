@@ -3,10 +3,12 @@ package cnb
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
@@ -16,6 +18,11 @@ const (
 	MixinsLabel = "io.buildpacks.stack.mixins"
 	StackLabel  = "io.buildpacks.stack.id"
 
+	// StackDeprecationLabel and StackEndOfLifeLabel deprecate a stack from
+	// its build image. The end of life is a RFC 3339 timestamp or a date.
+	StackDeprecationLabel = "io.kpack.stack.deprecation"
+	StackEndOfLifeLabel   = "io.kpack.stack.end-of-life"
+
 	cnbUserId  = "CNB_USER_ID"
 	cnbGroupId = "CNB_GROUP_ID"
 )
@@ -61,6 +68,17 @@ func (r *RemoteStackReader) Read(keychain authn.Keychain, clusterStackSpec build
 	}
 
 	mixins, err := mixins(buildMixins, runMixins)
+	if err != nil {
+		return buildapi.ResolvedClusterStack{}, err
+	}
+
+	deprecation := clusterStackSpec.Deprecation
+	if deprecation == nil {
+		deprecation, err = readDeprecation(buildImage)
+		if err != nil {
+			return buildapi.ResolvedClusterStack{}, errors.Wrap(err, "validating build image")
+		}
+	}
 
 	return buildapi.ResolvedClusterStack{
 		Id: clusterStackSpec.Id,
@@ -72,10 +90,11 @@ func (r *RemoteStackReader) Read(keychain authn.Keychain, clusterStackSpec build
 			LatestImage: runIdentifier,
 			Image:       clusterStackSpec.RunImage.Image,
 		},
-		Mixins:  mixins,
-		UserID:  userId,
-		GroupID: groupId,
-	}, err
+		Mixins:      mixins,
+		UserID:      userId,
+		GroupID:     groupId,
+		Deprecation: deprecation,
+	}, nil
 }
 
 func validateStackId(stackId string, buildImage ggcrv1.Image, runImage ggcrv1.Image) error {
@@ -148,6 +167,37 @@ func missingCommonRunMixins(build []string, run []string) []string {
 	return missing
 }
 
+func readDeprecation(image ggcrv1.Image) (*buildapi.StackDeprecation, error) {
+	hasLabel, err := imagehelpers.HasLabel(image, StackDeprecationLabel)
+	if !hasLabel || err != nil {
+		return nil, err
+	}
+
+	message, err := imagehelpers.GetStringLabel(image, StackDeprecationLabel)
+	if err != nil {
+		return nil, err
+	}
+	deprecation := &buildapi.StackDeprecation{Message: message}
+
+	hasLabel, err = imagehelpers.HasLabel(image, StackEndOfLifeLabel)
+	if !hasLabel || err != nil {
+		return deprecation, err
+	}
+
+	endOfLife, err := imagehelpers.GetStringLabel(image, StackEndOfLifeLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, endOfLife); err == nil {
+			deprecation.EndOfLife = &metav1.Time{Time: t}
+			return deprecation, nil
+		}
+	}
+	return nil, errors.Errorf("invalid %s label: %s", StackEndOfLifeLabel, endOfLife)
+}
+
 func parseCNBID(image ggcrv1.Image, env string) (int, error) {
 	v, err := imagehelpers.GetEnv(image, env)
 	if err != nil {
@@ -3,6 +3,7 @@ package cnb_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
@@ -10,6 +11,7 @@ import (
 	"github.com/sclevine/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	"github.com/pivotal/kpack/pkg/cnb"
@@ -79,6 +81,54 @@ func testRemoteStackReader(t *testing.T, when spec.G, it spec.S) {
 
 		})
 
+		when("deprecated", func() {
+			deprecatedBuildImage := func(endOfLife string) v1.Image {
+				image, err := imagehelpers.SetStringLabel(buildImage(t, stackId, nil), cnb.StackDeprecationLabel, "use org.other.stack")
+				require.NoError(t, err)
+				image, err = imagehelpers.SetStringLabel(image, cnb.StackEndOfLifeLabel, endOfLife)
+				require.NoError(t, err)
+				return image
+			}
+
+			stackSpec := buildapi.ClusterStackSpec{
+				Id:         stackId,
+				BuildImage: buildapi.ClusterStackSpecImage{Image: buildTag},
+				RunImage:   buildapi.ClusterStackSpecImage{Image: runTag},
+			}
+
+			it("reads the deprecation from the build image labels", func() {
+				fakeClient.AddImage(runTag, runImage(t, stackId, nil), expectedKeychain)
+				fakeClient.AddImage(buildTag, deprecatedBuildImage("2030-01-31"), expectedKeychain)
+
+				resolvedStack, err := remoteStackReader.Read(expectedKeychain, stackSpec)
+				require.NoError(t, err)
+
+				assert.Equal(t, &buildapi.StackDeprecation{
+					Message:   "use org.other.stack",
+					EndOfLife: &metav1.Time{Time: time.Date(2030, time.January, 31, 0, 0, 0, 0, time.UTC)},
+				}, resolvedStack.Deprecation)
+			})
+
+			it("prefers the deprecation of the spec", func() {
+				fakeClient.AddImage(runTag, runImage(t, stackId, nil), expectedKeychain)
+				fakeClient.AddImage(buildTag, deprecatedBuildImage("2030-01-31"), expectedKeychain)
+
+				stackSpec.Deprecation = &buildapi.StackDeprecation{Message: "from the spec"}
+
+				resolvedStack, err := remoteStackReader.Read(expectedKeychain, stackSpec)
+				require.NoError(t, err)
+				assert.Equal(t, &buildapi.StackDeprecation{Message: "from the spec"}, resolvedStack.Deprecation)
+			})
+
+			it("returns error for an invalid end of life label", func() {
+				fakeClient.AddImage(runTag, runImage(t, stackId, nil), expectedKeychain)
+				fakeClient.AddImage(buildTag, deprecatedBuildImage("soon"), expectedKeychain)
+
+				_, err := remoteStackReader.Read(expectedKeychain, stackSpec)
+				require.EqualError(t, err, "validating build image: invalid io.kpack.stack.end-of-life label: soon")
+			})
+		})
+
 		when("invalid", func() {
 			it("returns error if stack id does not match run image", func() {
 				runImage := runImage(t, "something.else", nil)
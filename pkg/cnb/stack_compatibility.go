@@ -0,0 +1,71 @@
+package cnb
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"knative.dev/pkg/apis"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+// ValidateStackCompatibility checks that every buildpack in the order,
+// including the buildpacks of meta-buildpacks, supports the stack. The
+// lifecycle of the builder is not known before it is created so the relaxed
+// mixin contract is assumed and buildpack apis are not checked. Buildpacks
+// that cannot be resolved are skipped and reported when the builder is
+// reconciled.
+func ValidateStackCompatibility(resolver BuildpackResolver, stack buildapi.ResolvedClusterStack, order []buildapi.BuilderOrderEntry) *apis.FieldError {
+	var errs *apis.FieldError
+	for i, entry := range order {
+		for j, ref := range entry.Group {
+			remote, err := resolver.resolve(ref)
+			if err != nil {
+				continue
+			}
+
+			if err := stackCompatibility(resolver, stack, remote.Buildpack, map[string]bool{}); err != nil {
+				errs = errs.Also(apis.ErrGeneric(err.Error(), fmt.Sprintf("order[%d].group[%d]", i, j)))
+			}
+		}
+	}
+	return errs
+}
+
+func stackCompatibility(resolver BuildpackResolver, stack buildapi.ResolvedClusterStack, buildpack corev1alpha1.BuildpackStatus, visited map[string]bool) error {
+	if visited[buildpack.Id] {
+		return nil
+	}
+	visited[buildpack.Id] = true
+
+	if len(buildpack.Order) == 0 {
+		err := BuildpackLayerInfo{API: buildpack.API, Stacks: buildpack.Stacks}.supportsStack(stack.Id, stack.Mixins, true)
+		return errors.Wrapf(err, "buildpack %s", buildpackRef(buildpack.BuildpackInfo))
+	}
+
+	for _, entry := range buildpack.Order {
+		for _, ref := range entry.Group {
+			child, err := resolver.resolve(buildapi.BuilderBuildpackRef{
+				BuildpackRef: corev1alpha1.BuildpackRef{
+					BuildpackInfo: ref.BuildpackInfo,
+				},
+			})
+			if err != nil {
+				continue
+			}
+
+			if err := stackCompatibility(resolver, stack, child.Buildpack, visited); err != nil {
+				return errors.Wrapf(err, "meta-buildpack %s", buildpackRef(buildpack.BuildpackInfo))
+			}
+		}
+	}
+	return nil
+}
+
+func buildpackRef(info corev1alpha1.BuildpackInfo) string {
+	if info.Version == "" {
+		return info.Id
+	}
+	return fmt.Sprintf("%s@%s", info.Id, info.Version)
+}
@@ -0,0 +1,127 @@
+package cnb
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+func TestStackCompatibility(t *testing.T) {
+	spec.Run(t, "TestStackCompatibility", testStackCompatibility)
+}
+
+func testStackCompatibility(t *testing.T, when spec.G, it spec.S) {
+	var (
+		stack = buildapi.ResolvedClusterStack{
+			Id:     "io.buildpacks.stacks.bionic",
+			Mixins: []string{"some-mixin", "build:build-only-mixin"},
+		}
+
+		compatibleBuildpack = corev1alpha1.BuildpackStatus{
+			BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.compatible", Version: "1.0.0"},
+			API:           "0.7",
+			Stacks: []corev1alpha1.BuildpackStack{
+				{ID: "io.buildpacks.stacks.bionic", Mixins: []string{"some-mixin", "build:build-only-mixin"}},
+			},
+		}
+
+		anyStackBuildpack = corev1alpha1.BuildpackStatus{
+			BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.any-stack", Version: "1.0.0"},
+			API:           "0.7",
+			Stacks:        []corev1alpha1.BuildpackStack{{ID: "*"}},
+		}
+
+		otherStackBuildpack = corev1alpha1.BuildpackStatus{
+			BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.other-stack", Version: "1.0.0"},
+			API:           "0.7",
+			Stacks:        []corev1alpha1.BuildpackStack{{ID: "io.buildpacks.stacks.other"}},
+		}
+
+		missingMixinBuildpack = corev1alpha1.BuildpackStatus{
+			BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.missing-mixin", Version: "1.0.0"},
+			API:           "0.7",
+			Stacks: []corev1alpha1.BuildpackStack{
+				{ID: "io.buildpacks.stacks.bionic", Mixins: []string{"some-mixin", "run:missing-mixin"}},
+			},
+		}
+
+		metaBuildpack = corev1alpha1.BuildpackStatus{
+			BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.meta", Version: "1.0.0"},
+			API:           "0.7",
+			Order: []corev1alpha1.OrderEntry{
+				{
+					Group: []corev1alpha1.BuildpackRef{
+						{BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.compatible", Version: "1.0.0"}},
+						{BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.other-stack", Version: "1.0.0"}},
+					},
+				},
+			},
+		}
+
+		resolver = NewBuildpackResolver(&buildapi.ClusterStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "some-store"},
+			Status: buildapi.ClusterStoreStatus{
+				Buildpacks: []corev1alpha1.BuildpackStatus{
+					compatibleBuildpack,
+					anyStackBuildpack,
+					otherStackBuildpack,
+					missingMixinBuildpack,
+					metaBuildpack,
+				},
+			},
+		}, nil, nil)
+	)
+
+	ref := func(id string) buildapi.BuilderBuildpackRef {
+		return buildapi.BuilderBuildpackRef{
+			BuildpackRef: corev1alpha1.BuildpackRef{
+				BuildpackInfo: corev1alpha1.BuildpackInfo{Id: id},
+			},
+		}
+	}
+
+	it("accepts buildpacks that support the stack", func() {
+		err := ValidateStackCompatibility(resolver, stack, []buildapi.BuilderOrderEntry{
+			{Group: []buildapi.BuilderBuildpackRef{ref("io.buildpack.compatible"), ref("io.buildpack.any-stack")}},
+		})
+		assert.Nil(t, err)
+	})
+
+	it("rejects buildpacks that do not support the stack", func() {
+		err := ValidateStackCompatibility(resolver, stack, []buildapi.BuilderOrderEntry{
+			{Group: []buildapi.BuilderBuildpackRef{ref("io.buildpack.compatible")}},
+			{Group: []buildapi.BuilderBuildpackRef{ref("io.buildpack.any-stack"), ref("io.buildpack.other-stack")}},
+		})
+		assert.EqualError(t, err, "buildpack io.buildpack.other-stack@1.0.0: stack io.buildpacks.stacks.bionic is not supported: order[1].group[1]")
+	})
+
+	it("rejects buildpacks that require mixins the stack does not provide", func() {
+		err := ValidateStackCompatibility(resolver, stack, []buildapi.BuilderOrderEntry{
+			{Group: []buildapi.BuilderBuildpackRef{ref("io.buildpack.missing-mixin")}},
+		})
+		assert.EqualError(t, err, "buildpack io.buildpack.missing-mixin@1.0.0: stack missing mixin(s): run:missing-mixin: order[0].group[0]")
+	})
+
+	it("checks the buildpacks of meta-buildpacks", func() {
+		err := ValidateStackCompatibility(resolver, stack, []buildapi.BuilderOrderEntry{
+			{Group: []buildapi.BuilderBuildpackRef{ref("io.buildpack.meta")}},
+		})
+		assert.EqualError(t, err, "meta-buildpack io.buildpack.meta@1.0.0: buildpack io.buildpack.other-stack@1.0.0: stack io.buildpacks.stacks.bionic is not supported: order[0].group[0]")
+	})
+
+	it("skips buildpacks that cannot be resolved", func() {
+		err := ValidateStackCompatibility(resolver, stack, []buildapi.BuilderOrderEntry{
+			{Group: []buildapi.BuilderBuildpackRef{
+				ref("io.buildpack.unknown"),
+				{BuildpackRef: corev1alpha1.BuildpackRef{BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "io.buildpack.other-stack"}}, ObjectReference: corev1.ObjectReference{Kind: buildapi.ClusterBuildpackKind, Name: "missing"}},
+			}},
+		})
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"context"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+const (
+	BuildNetworkPolicyConfigName = "kpack-build-network-policy"
+	BuildNetworkPolicyEgressKey  = "egress"
+)
+
+// ParseBuildNetworkPolicy reads the build network policy of a namespace from
+// its kpack-build-network-policy ConfigMap.
+func ParseBuildNetworkPolicy(cm *corev1.ConfigMap) (*buildapi.BuildNetworkPolicy, error) {
+	policy := &buildapi.BuildNetworkPolicy{}
+	if err := yaml.Unmarshal([]byte(cm.Data[BuildNetworkPolicyEgressKey]), &policy.Egress); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s in configmap %s", BuildNetworkPolicyEgressKey, BuildNetworkPolicyConfigName)
+	}
+
+	if err := policy.Validate(context.Background()); err != nil {
+		return nil, errors.Wrapf(err, "invalid configmap %s", BuildNetworkPolicyConfigName)
+	}
+	return policy, nil
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+func TestBuildNetworkPolicy(t *testing.T) {
+	spec.Run(t, "BuildNetworkPolicy", testBuildNetworkPolicy)
+}
+
+func testBuildNetworkPolicy(t *testing.T, when spec.G, it spec.S) {
+	configMap := func(egress string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      BuildNetworkPolicyConfigName,
+				Namespace: "some-namespace",
+			},
+			Data: map[string]string{
+				BuildNetworkPolicyEgressKey: egress,
+			},
+		}
+	}
+
+	when("#ParseBuildNetworkPolicy", func() {
+		it("reads the egress destinations", func() {
+			policy, err := ParseBuildNetworkPolicy(configMap(`
+- cidr: 10.0.0.0/8
+  except:
+  - 10.1.0.0/16
+- cidr: 192.168.1.10/32
+  ports:
+  - 3128
+`))
+			require.NoError(t, err)
+			require.Equal(t, &buildapi.BuildNetworkPolicy{
+				Egress: []buildapi.BuildNetworkEgress{
+					{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}},
+					{CIDR: "192.168.1.10/32", Ports: []int32{3128}},
+				},
+			}, policy)
+		})
+
+		it("denies all egress but dns without destinations", func() {
+			policy, err := ParseBuildNetworkPolicy(configMap(""))
+			require.NoError(t, err)
+			require.Equal(t, &buildapi.BuildNetworkPolicy{}, policy)
+		})
+
+		it("returns an error for an invalid cidr", func() {
+			_, err := ParseBuildNetworkPolicy(configMap(`
+- cidr: github.com
+`))
+			require.EqualError(t, err, "invalid configmap kpack-build-network-policy: invalid value: github.com: egress[0].cidr")
+		})
+	})
+}
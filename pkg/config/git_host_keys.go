@@ -0,0 +1,49 @@
+package config
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "k8s.io/client-go/kubernetes"
+)
+
+const (
+	GitHostKeysConfigName                 = "git-host-keys"
+	GitKnownHostsKey                      = "known_hosts"
+	GitInsecureSkipHostKeyVerificationKey = "insecureSkipHostKeyVerification"
+)
+
+// GitHostKeys is the cluster wide configuration used to verify the host keys
+// of git remotes accessed over ssh.
+type GitHostKeys struct {
+	KnownHosts         string
+	InsecureSkipVerify bool
+}
+
+// FetchGitHostKeys reads the git host key ConfigMap from namespace. A missing
+// ConfigMap leaves host key verification strict with no cluster wide
+// known_hosts.
+func FetchGitHostKeys(ctx context.Context, client k8sclient.Interface, namespace string) (GitHostKeys, error) {
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, GitHostKeysConfigName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return GitHostKeys{}, nil
+	} else if err != nil {
+		return GitHostKeys{}, err
+	}
+
+	var insecureSkipVerify bool
+	if v, ok := cm.Data[GitInsecureSkipHostKeyVerificationKey]; ok {
+		insecureSkipVerify, err = strconv.ParseBool(v)
+		if err != nil {
+			return GitHostKeys{}, errors.Wrapf(err, "invalid %s in configmap %s", GitInsecureSkipHostKeyVerificationKey, GitHostKeysConfigName)
+		}
+	}
+
+	return GitHostKeys{
+		KnownHosts:         cm.Data[GitKnownHostsKey],
+		InsecureSkipVerify: insecureSkipVerify,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGitHostKeys(t *testing.T) {
+	spec.Run(t, "GitHostKeys", testGitHostKeys)
+}
+
+func testGitHostKeys(t *testing.T, when spec.G, it spec.S) {
+	const namespace = "kpack"
+
+	configMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      GitHostKeysConfigName,
+				Namespace: namespace,
+			},
+			Data: data,
+		}
+	}
+
+	when("#FetchGitHostKeys", func() {
+		it("reads the known_hosts and verification opt-out", func() {
+			client := fake.NewSimpleClientset(configMap(map[string]string{
+				GitKnownHostsKey:                      "github.com ssh-ed25519 AAAA",
+				GitInsecureSkipHostKeyVerificationKey: "true",
+			}))
+
+			hostKeys, err := FetchGitHostKeys(context.TODO(), client, namespace)
+			require.NoError(t, err)
+			require.Equal(t, GitHostKeys{KnownHosts: "github.com ssh-ed25519 AAAA", InsecureSkipVerify: true}, hostKeys)
+		})
+
+		it("verifies strictly without the configmap", func() {
+			hostKeys, err := FetchGitHostKeys(context.TODO(), fake.NewSimpleClientset(), namespace)
+			require.NoError(t, err)
+			require.Equal(t, GitHostKeys{}, hostKeys)
+		})
+
+		it("returns an error for an invalid opt-out", func() {
+			client := fake.NewSimpleClientset(configMap(map[string]string{
+				GitInsecureSkipHostKeyVerificationKey: "maybe",
+			}))
+
+			_, err := FetchGitHostKeys(context.TODO(), client, namespace)
+			require.EqualError(t, err, `invalid insecureSkipHostKeyVerification in configmap git-host-keys: strconv.ParseBool: parsing "maybe": invalid syntax`)
+		})
+	})
+}
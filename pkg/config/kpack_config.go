@@ -0,0 +1,235 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+const (
+	KpackConfigName = "kpack-config"
+
+	BuildInitImageKey           = "build-init-image"
+	BuildInitWindowsImageKey    = "build-init-windows-image"
+	BuildWaiterImageKey         = "build-waiter-image"
+	RebaseImageKey              = "rebase-image"
+	CompletionImageKey          = "completion-image"
+	CompletionWindowsImageKey   = "completion-windows-image"
+	DefaultResourcesKey         = "default-resources"
+	EnablePriorityClassesKey    = "enable-priority-classes"
+	InjectedSidecarSupportKey   = "injected-sidecar-support"
+	EnableBuildDeduplicationKey = "enable-build-deduplication"
+	PrioritizeFirstBuildsKey    = "prioritize-first-builds"
+	HttpProxyKey                = "http-proxy"
+	HttpsProxyKey               = "https-proxy"
+	NoProxyKey                  = "no-proxy"
+	RegistryMirrorsKey          = "registry-mirrors"
+	PlatformImagesKey           = "platform-images"
+	ImageRepositoryPrefixKey    = "image-repository-prefix"
+	RunImageRegistriesKey       = "run-image-registries"
+)
+
+// KpackConfig is the controller configuration that can be changed at runtime
+// with the kpack-config ConfigMap. Keys missing from the ConfigMap keep the
+// value provided by the controller flags.
+type KpackConfig struct {
+	BuildPodImages   buildapi.BuildPodImages
+	DefaultResources corev1.ResourceRequirements
+	FeatureGates     FeatureGates
+	Proxy            Proxy
+	// RegistryMirrors maps a registry host to the registry build pod images
+	// are pulled from instead.
+	RegistryMirrors map[string]string
+	// PlatformImages overrides the build pod helper images for builders of
+	// an os/arch pair such as linux/arm64.
+	PlatformImages map[string]PlatformImages
+	// ImageRepositoryPrefix relocates the helper and lifecycle images to an
+	// internal registry for air-gapped installs.
+	ImageRepositoryPrefix string
+	// RunImageRegistries maps a repository prefix of app images to the
+	// registry namespace app clusters pull run images from.
+	RunImageRegistries map[string]string
+}
+
+type PlatformImages struct {
+	BuildInitImage   string `json:"buildInitImage,omitempty"`
+	BuildWaiterImage string `json:"buildWaiterImage,omitempty"`
+	RebaseImage      string `json:"rebaseImage,omitempty"`
+	CompletionImage  string `json:"completionImage,omitempty"`
+}
+
+type FeatureGates struct {
+	EnablePriorityClasses    bool
+	InjectedSidecarSupport   bool
+	EnableBuildDeduplication bool
+	PrioritizeFirstBuilds    bool
+}
+
+type Proxy struct {
+	HttpProxy  string
+	HttpsProxy string
+	NoProxy    string
+}
+
+// ParseKpackConfig layers the data of the kpack-config ConfigMap over
+// defaults.
+func ParseKpackConfig(cm *corev1.ConfigMap, defaults KpackConfig) (KpackConfig, error) {
+	c := defaults
+
+	for key, value := range map[string]*string{
+		BuildInitImageKey:         &c.BuildPodImages.BuildInitImage,
+		BuildInitWindowsImageKey:  &c.BuildPodImages.BuildInitWindowsImage,
+		BuildWaiterImageKey:       &c.BuildPodImages.BuildWaiterImage,
+		RebaseImageKey:            &c.BuildPodImages.RebaseImage,
+		CompletionImageKey:        &c.BuildPodImages.CompletionImage,
+		CompletionWindowsImageKey: &c.BuildPodImages.CompletionWindowsImage,
+		HttpProxyKey:              &c.Proxy.HttpProxy,
+		HttpsProxyKey:             &c.Proxy.HttpsProxy,
+		NoProxyKey:                &c.Proxy.NoProxy,
+		ImageRepositoryPrefixKey:  &c.ImageRepositoryPrefix,
+	} {
+		if v, ok := cm.Data[key]; ok {
+			*value = strings.TrimSpace(v)
+		}
+	}
+
+	for key, gate := range map[string]*bool{
+		EnablePriorityClassesKey:    &c.FeatureGates.EnablePriorityClasses,
+		InjectedSidecarSupportKey:   &c.FeatureGates.InjectedSidecarSupport,
+		EnableBuildDeduplicationKey: &c.FeatureGates.EnableBuildDeduplication,
+		PrioritizeFirstBuildsKey:    &c.FeatureGates.PrioritizeFirstBuilds,
+	} {
+		if v, ok := cm.Data[key]; ok {
+			enabled, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return KpackConfig{}, errors.Wrapf(err, "invalid %s in configmap %s", key, KpackConfigName)
+			}
+			*gate = enabled
+		}
+	}
+
+	if v, ok := cm.Data[DefaultResourcesKey]; ok {
+		c.DefaultResources = corev1.ResourceRequirements{}
+		if err := yaml.Unmarshal([]byte(v), &c.DefaultResources); err != nil {
+			return KpackConfig{}, errors.Wrapf(err, "invalid %s in configmap %s", DefaultResourcesKey, KpackConfigName)
+		}
+	}
+
+	if v, ok := cm.Data[RegistryMirrorsKey]; ok {
+		c.RegistryMirrors = nil
+		if err := yaml.Unmarshal([]byte(v), &c.RegistryMirrors); err != nil {
+			return KpackConfig{}, errors.Wrapf(err, "invalid %s in configmap %s", RegistryMirrorsKey, KpackConfigName)
+		}
+	}
+
+	if v, ok := cm.Data[RunImageRegistriesKey]; ok {
+		c.RunImageRegistries = nil
+		if err := yaml.Unmarshal([]byte(v), &c.RunImageRegistries); err != nil {
+			return KpackConfig{}, errors.Wrapf(err, "invalid %s in configmap %s", RunImageRegistriesKey, KpackConfigName)
+		}
+	}
+
+	if v, ok := cm.Data[PlatformImagesKey]; ok {
+		c.PlatformImages = nil
+		if err := yaml.Unmarshal([]byte(v), &c.PlatformImages); err != nil {
+			return KpackConfig{}, errors.Wrapf(err, "invalid %s in configmap %s", PlatformImagesKey, KpackConfigName)
+		}
+		for platform := range c.PlatformImages {
+			if parts := strings.Split(platform, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return KpackConfig{}, errors.Errorf("invalid %s in configmap %s: platform %q must be os/arch", PlatformImagesKey, KpackConfigName, platform)
+			}
+		}
+	}
+
+	if err := c.relocate(); err != nil {
+		return KpackConfig{}, errors.Wrapf(err, "invalid %s in configmap %s", ImageRepositoryPrefixKey, KpackConfigName)
+	}
+
+	return c, nil
+}
+
+// BuildPodImagesFor returns the helper images for a builder of the given os
+// and architecture. Images without a platform override use BuildPodImages.
+func (c KpackConfig) BuildPodImagesFor(os, arch string) buildapi.BuildPodImages {
+	images := c.BuildPodImages
+
+	platformImages, ok := c.PlatformImages[os+"/"+arch]
+	if !ok {
+		return images
+	}
+
+	if platformImages.BuildInitImage != "" {
+		images.BuildInitImage = platformImages.BuildInitImage
+		images.BuildInitWindowsImage = platformImages.BuildInitImage
+	}
+	if platformImages.BuildWaiterImage != "" {
+		images.BuildWaiterImage = platformImages.BuildWaiterImage
+	}
+	if platformImages.RebaseImage != "" {
+		images.RebaseImage = platformImages.RebaseImage
+	}
+	if platformImages.CompletionImage != "" {
+		images.CompletionImage = platformImages.CompletionImage
+		images.CompletionWindowsImage = platformImages.CompletionImage
+	}
+	return images
+}
+
+// RunImageRegistryFor returns the registry namespace app clusters pull the
+// run image of image from. The longest matching repository prefix wins.
+func (c KpackConfig) RunImageRegistryFor(image string) (string, bool) {
+	var prefix, registry string
+	for p, r := range c.RunImageRegistries {
+		p = strings.TrimSuffix(p, "/")
+		if !hasRepositoryPrefix(image, p) {
+			continue
+		}
+		if len(p) > len(prefix) {
+			prefix, registry = p, r
+		}
+	}
+	return strings.TrimSuffix(registry, "/"), prefix != ""
+}
+
+func hasRepositoryPrefix(image, prefix string) bool {
+	if !strings.HasPrefix(image, prefix) {
+		return false
+	}
+	rest := image[len(prefix):]
+	return rest == "" || strings.ContainsAny(rest[:1], "/:@")
+}
+
+// KpackConfigStore holds the latest valid KpackConfig so the controller can
+// be reconfigured without a restart.
+type KpackConfigStore struct {
+	defaults KpackConfig
+	config   atomic.Value
+}
+
+func NewKpackConfigStore(defaults KpackConfig) *KpackConfigStore {
+	s := &KpackConfigStore{defaults: defaults}
+	s.config.Store(defaults)
+	return s
+}
+
+func (s *KpackConfigStore) Load() KpackConfig {
+	return s.config.Load().(KpackConfig)
+}
+
+// Update replaces the stored config with cm layered over the defaults. An
+// invalid ConfigMap leaves the previous config in place.
+func (s *KpackConfigStore) Update(cm *corev1.ConfigMap) error {
+	c, err := ParseKpackConfig(cm, s.defaults)
+	if err != nil {
+		return err
+	}
+
+	s.config.Store(c)
+	return nil
+}
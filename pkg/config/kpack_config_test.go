@@ -0,0 +1,231 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+func TestKpackConfig(t *testing.T) {
+	spec.Run(t, "KpackConfig", testKpackConfig)
+}
+
+func testKpackConfig(t *testing.T, when spec.G, it spec.S) {
+	defaults := KpackConfig{
+		BuildPodImages: buildapi.BuildPodImages{
+			BuildInitImage:  "some-registry.io/build-init",
+			CompletionImage: "some-registry.io/completion",
+		},
+		FeatureGates: FeatureGates{
+			InjectedSidecarSupport: true,
+			PrioritizeFirstBuilds:  true,
+		},
+	}
+
+	configMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      KpackConfigName,
+				Namespace: "kpack",
+			},
+			Data: data,
+		}
+	}
+
+	when("#ParseKpackConfig", func() {
+		it("layers the configmap over the defaults", func() {
+			config, err := ParseKpackConfig(configMap(map[string]string{
+				BuildInitImageKey:           "other-registry.io/build-init",
+				EnableBuildDeduplicationKey: "true",
+				PrioritizeFirstBuildsKey:    "false",
+				InjectedSidecarSupportKey:   "false",
+				HttpsProxyKey:               "https://proxy",
+				DefaultResourcesKey: `
+requests:
+  cpu: 500m
+limits:
+  memory: 2Gi
+`,
+				RegistryMirrorsKey: `
+gcr.io: mirror.example.com/gcr
+`,
+				RunImageRegistriesKey: `
+registry.example.com/apps: registry.example.com/stacks
+`,
+			}), defaults)
+			require.NoError(t, err)
+
+			require.Equal(t, KpackConfig{
+				BuildPodImages: buildapi.BuildPodImages{
+					BuildInitImage:  "other-registry.io/build-init",
+					CompletionImage: "some-registry.io/completion",
+				},
+				DefaultResources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+				},
+				FeatureGates: FeatureGates{
+					EnableBuildDeduplication: true,
+				},
+				Proxy: Proxy{
+					HttpsProxy: "https://proxy",
+				},
+				RegistryMirrors: map[string]string{
+					"gcr.io": "mirror.example.com/gcr",
+				},
+				RunImageRegistries: map[string]string{
+					"registry.example.com/apps": "registry.example.com/stacks",
+				},
+			}, config)
+		})
+
+		it("uses the defaults for an empty configmap", func() {
+			config, err := ParseKpackConfig(configMap(nil), defaults)
+			require.NoError(t, err)
+			require.Equal(t, defaults, config)
+		})
+
+		it("returns an error for an invalid feature gate", func() {
+			_, err := ParseKpackConfig(configMap(map[string]string{
+				EnablePriorityClassesKey: "sometimes",
+			}), defaults)
+			require.EqualError(t, err, `invalid enable-priority-classes in configmap kpack-config: strconv.ParseBool: parsing "sometimes": invalid syntax`)
+		})
+
+		it("parses platform images", func() {
+			config, err := ParseKpackConfig(configMap(map[string]string{
+				PlatformImagesKey: `
+linux/arm64:
+  buildInitImage: some-registry.io/build-init-arm64
+  rebaseImage: some-registry.io/rebase-arm64
+`,
+			}), defaults)
+			require.NoError(t, err)
+
+			require.Equal(t, map[string]PlatformImages{
+				"linux/arm64": {
+					BuildInitImage: "some-registry.io/build-init-arm64",
+					RebaseImage:    "some-registry.io/rebase-arm64",
+				},
+			}, config.PlatformImages)
+		})
+
+		it("returns an error for a platform that is not os/arch", func() {
+			_, err := ParseKpackConfig(configMap(map[string]string{
+				PlatformImagesKey: `
+arm64:
+  buildInitImage: some-registry.io/build-init-arm64
+`,
+			}), defaults)
+			require.EqualError(t, err, `invalid platform-images in configmap kpack-config: platform "arm64" must be os/arch`)
+		})
+
+		it("returns an error for invalid default resources", func() {
+			_, err := ParseKpackConfig(configMap(map[string]string{
+				DefaultResourcesKey: "requests: [",
+			}), defaults)
+			require.Error(t, err)
+		})
+	})
+
+	when("#BuildPodImagesFor", func() {
+		config := defaults
+		config.PlatformImages = map[string]PlatformImages{
+			"linux/arm64": {
+				CompletionImage: "some-registry.io/completion-arm64",
+			},
+			"windows/amd64": {
+				BuildInitImage: "some-registry.io/build-init-windows-2022",
+			},
+		}
+
+		it("overrides the images configured for the platform", func() {
+			require.Equal(t, buildapi.BuildPodImages{
+				BuildInitImage:         "some-registry.io/build-init",
+				CompletionImage:        "some-registry.io/completion-arm64",
+				CompletionWindowsImage: "some-registry.io/completion-arm64",
+			}, config.BuildPodImagesFor("linux", "arm64"))
+
+			require.Equal(t, buildapi.BuildPodImages{
+				BuildInitImage:        "some-registry.io/build-init-windows-2022",
+				BuildInitWindowsImage: "some-registry.io/build-init-windows-2022",
+				CompletionImage:       "some-registry.io/completion",
+			}, config.BuildPodImagesFor("windows", "amd64"))
+		})
+
+		it("uses the default images for other platforms", func() {
+			require.Equal(t, defaults.BuildPodImages, config.BuildPodImagesFor("linux", "amd64"))
+		})
+	})
+
+	when("#RunImageRegistryFor", func() {
+		config := KpackConfig{
+			RunImageRegistries: map[string]string{
+				"registry.example.com":            "registry.example.com/stacks",
+				"registry.example.com/team-a/":    "registry.example.com/team-a-stacks/",
+				"registry.example.com/team-a/app": "registry.example.com/app-stacks",
+			},
+		}
+
+		it("uses the longest matching repository prefix", func() {
+			registry, ok := config.RunImageRegistryFor("registry.example.com/team-a/other:latest")
+			require.True(t, ok)
+			require.Equal(t, "registry.example.com/team-a-stacks", registry)
+
+			registry, ok = config.RunImageRegistryFor("registry.example.com/team-a/app@sha256:abc")
+			require.True(t, ok)
+			require.Equal(t, "registry.example.com/app-stacks", registry)
+
+			registry, ok = config.RunImageRegistryFor("registry.example.com/team-b/app")
+			require.True(t, ok)
+			require.Equal(t, "registry.example.com/stacks", registry)
+		})
+
+		it("only matches whole path segments", func() {
+			registry, ok := config.RunImageRegistryFor("registry.example.com/team-a/application")
+			require.True(t, ok)
+			require.Equal(t, "registry.example.com/team-a-stacks", registry)
+
+			_, ok = config.RunImageRegistryFor("registry.example.community/app")
+			require.False(t, ok)
+		})
+	})
+
+	when("KpackConfigStore", func() {
+		it("starts with the defaults", func() {
+			store := NewKpackConfigStore(defaults)
+			require.Equal(t, defaults, store.Load())
+		})
+
+		it("updates the config from the configmap", func() {
+			store := NewKpackConfigStore(defaults)
+
+			require.NoError(t, store.Update(configMap(map[string]string{
+				RebaseImageKey: "some-registry.io/rebase",
+			})))
+			require.Equal(t, "some-registry.io/rebase", store.Load().BuildPodImages.RebaseImage)
+
+			require.NoError(t, store.Update(configMap(nil)))
+			require.Equal(t, defaults, store.Load())
+		})
+
+		it("keeps the previous config when the configmap is invalid", func() {
+			store := NewKpackConfigStore(defaults)
+
+			require.NoError(t, store.Update(configMap(map[string]string{
+				EnablePriorityClassesKey: "true",
+			})))
+
+			require.Error(t, store.Update(configMap(map[string]string{
+				EnablePriorityClassesKey: "sometimes",
+			})))
+			require.True(t, store.Load().FeatureGates.EnablePriorityClasses)
+		})
+	})
+}
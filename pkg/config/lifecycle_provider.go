@@ -29,14 +29,16 @@ type RegistryClient interface {
 type LifecycleProvider struct {
 	registryClient  RegistryClient
 	keychainFactory registry.KeychainFactory
+	kpackConfig     *KpackConfigStore
 	lifecycleData   atomic.Value
 	handlers        []func()
 }
 
-func NewLifecycleProvider(client RegistryClient, keychainFactory registry.KeychainFactory) *LifecycleProvider {
+func NewLifecycleProvider(client RegistryClient, keychainFactory registry.KeychainFactory, kpackConfig *KpackConfigStore) *LifecycleProvider {
 	return &LifecycleProvider{
 		registryClient:  client,
 		keychainFactory: keychainFactory,
+		kpackConfig:     kpackConfig,
 	}
 }
 
@@ -82,6 +84,11 @@ func (l *LifecycleProvider) read(ctx context.Context, cm *corev1.ConfigMap) (*li
 		return nil, errors.Errorf("%s config invalid", LifecycleConfigName)
 	}
 
+	imageRef, err := RelocateImage(imageRef, l.kpackConfig.Load().ImageRepositoryPrefix)
+	if err != nil {
+		return nil, err
+	}
+
 	keychain, err := l.keychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{
 		ServiceAccount: cm.Data[serviceAccountNameKey],
 		Namespace:      cm.Data[serviceAccountNamespaceKey],
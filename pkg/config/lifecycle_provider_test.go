@@ -52,6 +52,7 @@ func testProvider(t *testing.T, when spec.G, it spec.S) {
 		windowsLayer    v1.Layer
 		callBack        *fakeCallback
 		keychainFactory = &registryfakes.FakeKeychainFactory{}
+		kpackConfig     = NewKpackConfigStore(KpackConfig{})
 		p               *LifecycleProvider
 	)
 
@@ -64,7 +65,7 @@ func testProvider(t *testing.T, when spec.G, it spec.S) {
 		client.AddImage(lifecycleImgRef, lifecycleImg, keychain)
 		client.AddImage("some-other-lifecycle-image", generateLifecycleImage(t, lifecycleMetadata, testLayer(t), testLayer(t)), keychain)
 
-		p = NewLifecycleProvider(client, keychainFactory)
+		p = NewLifecycleProvider(client, keychainFactory, kpackConfig)
 		callBack = &fakeCallback{}
 		p.AddEventHandler(callBack.callBack)
 	})
@@ -176,6 +177,40 @@ func testProvider(t *testing.T, when spec.G, it spec.S) {
 			require.Equal(t, expectedLayer, layer)
 		})
 
+		it("reads the lifecycle from the image repository prefix", func() {
+			client.AddImage("registry.example.com/internal/some-image:latest", lifecycleImg, keychain)
+			require.NoError(t, kpackConfig.Update(&corev1.ConfigMap{
+				Data: map[string]string{ImageRepositoryPrefixKey: "registry.example.com/internal"},
+			}))
+
+			require.NoError(t, p.UpdateImage(&corev1.ConfigMap{
+				Data: map[string]string{"image": lifecycleImgRef, "serviceAccountRef.name": "some-service-account", "serviceAccountRef.namespace": "some-service-account-namespace"},
+			}))
+
+			layer, _, err := p.LayerForOS("linux")
+			require.NoError(t, err)
+
+			expectedDigest, err := linuxLayer.Digest()
+			require.NoError(t, err)
+
+			expectedDiffID, err := linuxLayer.DiffID()
+			require.NoError(t, err)
+
+			expectedSize, err := linuxLayer.Size()
+			require.NoError(t, err)
+
+			expectedLayer, err := imagehelpers.NewLazyMountableLayer(imagehelpers.LazyMountableLayerArgs{
+				Digest:   expectedDigest.String(),
+				DiffId:   expectedDiffID.String(),
+				Image:    "registry.example.com/internal/some-image:latest",
+				Size:     expectedSize,
+				Keychain: keychain,
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, expectedLayer, layer)
+		})
+
 		it("returns error on invalid os", func() {
 			_, _, err := p.LayerForOS("kpack-invalid-test-os")
 			require.EqualError(t, err, "unrecognized os kpack-invalid-test-os")
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"path"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pkg/errors"
+)
+
+// RelocateImage moves image to the repository prefix, keeping the last path
+// segment of its repository and its tag or digest. For example
+// gcr.io/kpack/build-init@sha256:abc relocated to registry.example.com/kpack
+// is registry.example.com/kpack/build-init@sha256:abc. An empty prefix leaves
+// the image unchanged.
+func RelocateImage(image, prefix string) (string, error) {
+	if image == "" || prefix == "" {
+		return image, nil
+	}
+
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	separator := ":"
+	if _, ok := ref.(name.Digest); ok {
+		separator = "@"
+	}
+
+	relocated := prefix + "/" + path.Base(ref.Context().RepositoryStr()) + separator + ref.Identifier()
+	if _, err := name.ParseReference(relocated, name.WeakValidation); err != nil {
+		return "", errors.Wrapf(err, "relocating %s to %s", image, prefix)
+	}
+	return relocated, nil
+}
+
+func (c *KpackConfig) relocate() error {
+	if err := relocateAll(c.ImageRepositoryPrefix,
+		&c.BuildPodImages.BuildInitImage,
+		&c.BuildPodImages.BuildInitWindowsImage,
+		&c.BuildPodImages.BuildWaiterImage,
+		&c.BuildPodImages.RebaseImage,
+		&c.BuildPodImages.CompletionImage,
+		&c.BuildPodImages.CompletionWindowsImage,
+	); err != nil {
+		return err
+	}
+
+	if c.PlatformImages == nil {
+		return nil
+	}
+
+	platformImages := make(map[string]PlatformImages, len(c.PlatformImages))
+	for platform, images := range c.PlatformImages {
+		if err := relocateAll(c.ImageRepositoryPrefix, &images.BuildInitImage, &images.BuildWaiterImage, &images.RebaseImage, &images.CompletionImage); err != nil {
+			return err
+		}
+		platformImages[platform] = images
+	}
+	c.PlatformImages = platformImages
+	return nil
+}
+
+func relocateAll(prefix string, images ...*string) error {
+	for _, image := range images {
+		relocated, err := RelocateImage(*image, prefix)
+		if err != nil {
+			return err
+		}
+		*image = relocated
+	}
+	return nil
+}
+
+// Images returns every helper image of the config.
+func (c KpackConfig) Images() []string {
+	var images []string
+	add := func(image string) {
+		if image != "" {
+			images = append(images, image)
+		}
+	}
+
+	add(c.BuildPodImages.BuildInitImage)
+	add(c.BuildPodImages.BuildInitWindowsImage)
+	add(c.BuildPodImages.BuildWaiterImage)
+	add(c.BuildPodImages.RebaseImage)
+	add(c.BuildPodImages.CompletionImage)
+	add(c.BuildPodImages.CompletionWindowsImage)
+	for _, p := range c.PlatformImages {
+		add(p.BuildInitImage)
+		add(p.BuildWaiterImage)
+		add(p.RebaseImage)
+		add(p.CompletionImage)
+	}
+	return images
+}
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+func TestRelocation(t *testing.T) {
+	spec.Run(t, "Relocation", testRelocation)
+}
+
+func testRelocation(t *testing.T, when spec.G, it spec.S) {
+	const prefix = "registry.example.com/internal/kpack"
+
+	when("#RelocateImage", func() {
+		it("keeps the digest of the image", func() {
+			relocated, err := RelocateImage("gcr.io/cf-build-service-public/kpack/build-init@sha256:1e3fa1a9ce8bd5b8b5df24e3ec1c5a2e5bd0bd23d2e7f6bde0ea1e4ee1e5f4f1", prefix)
+			require.NoError(t, err)
+			require.Equal(t, "registry.example.com/internal/kpack/build-init@sha256:1e3fa1a9ce8bd5b8b5df24e3ec1c5a2e5bd0bd23d2e7f6bde0ea1e4ee1e5f4f1", relocated)
+		})
+
+		it("keeps the tag of the image", func() {
+			relocated, err := RelocateImage("buildpacksio/lifecycle", prefix)
+			require.NoError(t, err)
+			require.Equal(t, "registry.example.com/internal/kpack/lifecycle:latest", relocated)
+		})
+
+		it("is a noop for an already relocated image", func() {
+			relocated, err := RelocateImage("registry.example.com/internal/kpack/rebase:some-tag", prefix)
+			require.NoError(t, err)
+			require.Equal(t, "registry.example.com/internal/kpack/rebase:some-tag", relocated)
+		})
+
+		it("does not change the image without a prefix", func() {
+			relocated, err := RelocateImage("gcr.io/kpack/completion:some-tag", "")
+			require.NoError(t, err)
+			require.Equal(t, "gcr.io/kpack/completion:some-tag", relocated)
+		})
+
+		it("returns an error for an invalid prefix", func() {
+			_, err := RelocateImage("gcr.io/kpack/completion:some-tag", "Invalid Prefix")
+			require.Error(t, err)
+		})
+	})
+
+	when("image-repository-prefix is configured", func() {
+		it("relocates the helper images", func() {
+			config, err := ParseKpackConfig(&corev1.ConfigMap{
+				Data: map[string]string{
+					ImageRepositoryPrefixKey: prefix,
+					PlatformImagesKey: `
+linux/arm64:
+  completionImage: gcr.io/kpack/completion-arm64:some-tag
+`,
+				},
+			}, KpackConfig{
+				BuildPodImages: buildapi.BuildPodImages{
+					BuildInitImage:  "gcr.io/kpack/build-init:some-tag",
+					CompletionImage: "gcr.io/kpack/completion:some-tag",
+				},
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, buildapi.BuildPodImages{
+				BuildInitImage:  "registry.example.com/internal/kpack/build-init:some-tag",
+				CompletionImage: "registry.example.com/internal/kpack/completion:some-tag",
+			}, config.BuildPodImages)
+			require.Equal(t, "registry.example.com/internal/kpack/completion-arm64:some-tag", config.PlatformImages["linux/arm64"].CompletionImage)
+
+			require.ElementsMatch(t, []string{
+				"registry.example.com/internal/kpack/build-init:some-tag",
+				"registry.example.com/internal/kpack/completion:some-tag",
+				"registry.example.com/internal/kpack/completion-arm64:some-tag",
+			}, config.Images())
+		})
+
+		it("returns an error for an invalid prefix", func() {
+			_, err := ParseKpackConfig(&corev1.ConfigMap{
+				Data: map[string]string{
+					ImageRepositoryPrefixKey: "Invalid Prefix",
+				},
+			}, KpackConfig{
+				BuildPodImages: buildapi.BuildPodImages{
+					BuildInitImage: "gcr.io/kpack/build-init:some-tag",
+				},
+			})
+			require.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,84 @@
+// Package convert translates between the inputs of a local `pack build`,
+// either its command line flags or a project.toml project descriptor, and
+// kpack Images. Settings without an equivalent on the other side are
+// returned as warnings instead of being dropped silently.
+package convert
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+// ImageOptions are the Image settings that pack has no equivalent for.
+type ImageOptions struct {
+	Name               string
+	Namespace          string
+	ServiceAccountName string
+	// Builder replaces the builder image used with pack. A warning is
+	// returned when pack used a builder and Builder is not set.
+	Builder corev1.ObjectReference
+	Source  corev1alpha1.SourceConfig
+}
+
+func (o ImageOptions) image(tag string) *buildapi.Image {
+	return &buildapi.Image{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: buildapi.SchemeGroupVersion.String(),
+			Kind:       buildapi.ImageKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.Name,
+			Namespace: o.Namespace,
+		},
+		Spec: buildapi.ImageSpec{
+			Tag:                tag,
+			Builder:            o.Builder,
+			ServiceAccountName: o.ServiceAccountName,
+			Source:             o.Source,
+		},
+	}
+}
+
+func (o ImageOptions) builderWarning(packBuilder string) []string {
+	if packBuilder == "" || o.Builder.Name != "" {
+		return nil
+	}
+	return []string{fmt.Sprintf("builder %s must be replaced with a kpack Builder or ClusterBuilder", packBuilder)}
+}
+
+func setEnv(image *buildapi.Image, env []corev1.EnvVar) {
+	if len(env) == 0 {
+		return
+	}
+	if image.Spec.Build == nil {
+		image.Spec.Build = &buildapi.ImageBuild{}
+	}
+	image.Spec.Build.Env = env
+}
+
+// imageEnv returns the literal env of the image. Env sourced from secrets or
+// config maps cannot be passed to pack and is reported as a warning.
+func imageEnv(image *buildapi.Image) ([]corev1.EnvVar, []string) {
+	var env []corev1.EnvVar
+	var warnings []string
+	for _, e := range image.Env() {
+		if e.ValueFrom != nil {
+			warnings = append(warnings, fmt.Sprintf("env %s is read from a secret or config map and was not converted", e.Name))
+			continue
+		}
+		env = append(env, e)
+	}
+	return env, warnings
+}
+
+func bindingWarnings(image *buildapi.Image) []string {
+	if len(image.Services()) > 0 || len(image.CNBBindings()) > 0 {
+		return []string{"service bindings were not converted"}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package convert_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/convert"
+)
+
+func TestConvert(t *testing.T) {
+	spec.Run(t, "Convert", testConvert)
+}
+
+func testConvert(t *testing.T, when spec.G, it spec.S) {
+	opts := convert.ImageOptions{
+		Name:               "some-image",
+		Namespace:          "some-namespace",
+		ServiceAccountName: "some-sa",
+		Builder: corev1.ObjectReference{
+			Kind: buildapi.ClusterBuilderKind,
+			Name: "some-builder",
+		},
+		Source: corev1alpha1.SourceConfig{
+			Git: &corev1alpha1.Git{URL: "https://github.com/example/app", Revision: "main"},
+		},
+	}
+
+	when("pack build", func() {
+		it("parses flags before and after the image name", func() {
+			pb, err := convert.ParsePackBuild([]string{
+				"registry.io/app",
+				"--builder", "paketobuildpacks/builder:base",
+				"-e", "BP_JVM_VERSION=17",
+				"--env", "FROM_HOST",
+				"-t", "registry.io/app:v1",
+				"--publish",
+			})
+			require.NoError(t, err)
+			require.Equal(t, convert.PackBuild{
+				Image:   "registry.io/app",
+				Builder: "paketobuildpacks/builder:base",
+				Env:     []string{"BP_JVM_VERSION=17", "FROM_HOST"},
+				Tags:    []string{"registry.io/app:v1"},
+			}, pb)
+
+			pb, err = convert.ParsePackBuild([]string{"-B", "paketobuildpacks/builder:base", "registry.io/app"})
+			require.NoError(t, err)
+			require.Equal(t, "registry.io/app", pb.Image)
+		})
+
+		it("requires an image name", func() {
+			_, err := convert.ParsePackBuild([]string{"--builder", "paketobuildpacks/builder:base"})
+			require.EqualError(t, err, "image name is required")
+		})
+
+		it("converts to an image", func() {
+			image, warnings, err := convert.ImageFromPackBuild(convert.PackBuild{
+				Image:          "registry.io/app",
+				Builder:        "paketobuildpacks/builder:base",
+				Env:            []string{"BP_JVM_VERSION=17", "FROM_HOST"},
+				Tags:           []string{"registry.io/app:v1"},
+				DefaultProcess: "web",
+				Descriptor:     "build/project.toml",
+				CacheImage:     "registry.io/app-cache",
+				Buildpacks:     []string{"paketo-buildpacks/java"},
+				RunImage:       "paketobuildpacks/run:base",
+				Path:           "./app",
+			}, opts)
+			require.NoError(t, err)
+
+			require.Equal(t, buildapi.ImageSpec{
+				Tag:                   "registry.io/app",
+				AdditionalTags:        []string{"registry.io/app:v1"},
+				Builder:               opts.Builder,
+				ServiceAccountName:    "some-sa",
+				Source:                opts.Source,
+				DefaultProcess:        "web",
+				ProjectDescriptorPath: "build/project.toml",
+				Cache: &buildapi.ImageCacheConfig{
+					Registry: &buildapi.RegistryCache{Tag: "registry.io/app-cache"},
+				},
+				Build: &buildapi.ImageBuild{
+					Env: []corev1.EnvVar{{Name: "BP_JVM_VERSION", Value: "17"}},
+				},
+			}, image.Spec)
+			require.Equal(t, "some-image", image.Name)
+			require.Equal(t, buildapi.ImageKind, image.Kind)
+
+			require.Equal(t, []string{
+				"env FROM_HOST is read from the local environment by pack and was not converted",
+				"run image paketobuildpacks/run:base must be set on the stack of the builder",
+				"buildpacks paketo-buildpacks/java must be added to the order of the builder",
+			}, warnings)
+		})
+
+		it("warns when the builder is not replaced", func() {
+			_, warnings, err := convert.ImageFromPackBuild(convert.PackBuild{
+				Image:   "registry.io/app",
+				Builder: "paketobuildpacks/builder:base",
+				Path:    "./app",
+			}, convert.ImageOptions{})
+			require.NoError(t, err)
+			require.Equal(t, []string{
+				"builder paketobuildpacks/builder:base must be replaced with a kpack Builder or ClusterBuilder",
+				"local path ./app must be replaced with a git, blob or registry source",
+			}, warnings)
+		})
+
+		it("converts from an image", func() {
+			image := &buildapi.Image{
+				Spec: buildapi.ImageSpec{
+					Tag:            "registry.io/app",
+					AdditionalTags: []string{"registry.io/app:v1"},
+					DefaultProcess: "web",
+					Build: &buildapi.ImageBuild{
+						Env: []corev1.EnvVar{
+							{Name: "BP_JVM_VERSION", Value: "17"},
+							{Name: "SECRET", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "some-key"}}},
+						},
+					},
+				},
+			}
+
+			pb, warnings := convert.PackBuildFromImage(image, "registry.io/builder")
+			require.Equal(t, []string{
+				"registry.io/app",
+				"--builder", "registry.io/builder",
+				"--env", "BP_JVM_VERSION=17",
+				"--tag", "registry.io/app:v1",
+				"--default-process", "web",
+			}, pb.Args())
+			require.Equal(t, []string{"env SECRET is read from a secret or config map and was not converted"}, warnings)
+
+			roundTrip, err := convert.ParsePackBuild(pb.Args())
+			require.NoError(t, err)
+			require.Equal(t, pb, roundTrip)
+		})
+	})
+
+	when("project descriptor", func() {
+		it("parses a v0.2 descriptor", func() {
+			d, err := convert.ParseProjectDescriptor(strings.NewReader(`
+[_]
+schema-version = "0.2"
+
+[io.buildpacks]
+builder = "paketobuildpacks/builder:base"
+exclude = ["*.md"]
+
+[[io.buildpacks.group]]
+id = "paketo-buildpacks/java"
+version = "1.0.0"
+
+[[io.buildpacks.build.env]]
+name = "BP_JVM_VERSION"
+value = "17"
+`))
+			require.NoError(t, err)
+			require.Equal(t, convert.BuildpacksTable{
+				Builder: "paketobuildpacks/builder:base",
+				Exclude: []string{"*.md"},
+				Group:   []convert.DescriptorBuildpack{{ID: "paketo-buildpacks/java", Version: "1.0.0"}},
+				Build:   convert.DescriptorBuild{Env: []convert.DescriptorEnv{{Name: "BP_JVM_VERSION", Value: "17"}}},
+			}, d.IO.Buildpacks)
+		})
+
+		it("parses the deprecated env table", func() {
+			d, err := convert.ParseProjectDescriptor(strings.NewReader(`
+[_]
+schema-version = "0.2"
+
+[[io.buildpacks.env]]
+name = "BP_JVM_VERSION"
+value = "17"
+`))
+			require.NoError(t, err)
+			require.Equal(t, []convert.DescriptorEnv{{Name: "BP_JVM_VERSION", Value: "17"}}, d.IO.Buildpacks.Build.Env)
+		})
+
+		it("parses a v0.1 descriptor", func() {
+			d, err := convert.ParseProjectDescriptor(strings.NewReader(`
+[build]
+builder = "paketobuildpacks/builder:base"
+
+[[build.buildpacks]]
+uri = "docker://some/buildpack"
+
+[[build.env]]
+name = "BP_JVM_VERSION"
+value = "17"
+`))
+			require.NoError(t, err)
+			require.Equal(t, "0.2", d.Project.SchemaVersion)
+			require.Equal(t, convert.BuildpacksTable{
+				Builder: "paketobuildpacks/builder:base",
+				Group:   []convert.DescriptorBuildpack{{URI: "docker://some/buildpack"}},
+				Build:   convert.DescriptorBuild{Env: []convert.DescriptorEnv{{Name: "BP_JVM_VERSION", Value: "17"}}},
+			}, d.IO.Buildpacks)
+		})
+
+		it("returns an error for an unsupported schema version", func() {
+			_, err := convert.ParseProjectDescriptor(strings.NewReader(`
+[_]
+schema-version = "9.9"
+`))
+			require.EqualError(t, err, "unsupported project descriptor schema version 9.9")
+		})
+
+		it("converts to an image", func() {
+			image, warnings := convert.ImageFromProjectDescriptor(convert.ProjectDescriptor{
+				IO: convert.IOTable{
+					Buildpacks: convert.BuildpacksTable{
+						Builder: "paketobuildpacks/builder:base",
+						Exclude: []string{"*.md"},
+						Group:   []convert.DescriptorBuildpack{{ID: "paketo-buildpacks/java", Version: "1.0.0"}},
+						Build:   convert.DescriptorBuild{Env: []convert.DescriptorEnv{{Name: "BP_JVM_VERSION", Value: "17"}}},
+					},
+				},
+			}, "registry.io/app", opts)
+
+			require.Equal(t, "registry.io/app", image.Spec.Tag)
+			require.Equal(t, []corev1.EnvVar{{Name: "BP_JVM_VERSION", Value: "17"}}, image.Spec.Build.Env)
+			require.Equal(t, []string{
+				"buildpacks paketo-buildpacks/java@1.0.0 must be added to the order of the builder",
+				"include and exclude are only applied when the project descriptor is part of the source",
+			}, warnings)
+		})
+
+		it("converts from an image", func() {
+			image := &buildapi.Image{
+				Spec: buildapi.ImageSpec{
+					Tag:            "registry.io/app",
+					DefaultProcess: "web",
+					Build: &buildapi.ImageBuild{
+						Env:      []corev1.EnvVar{{Name: "BP_JVM_VERSION", Value: "17"}},
+						Services: buildapi.Services{{Kind: "Secret", Name: "some-binding"}},
+					},
+				},
+			}
+
+			d, warnings := convert.ProjectDescriptorFromImage(image, "registry.io/builder")
+			require.Equal(t, []string{
+				"service bindings were not converted",
+				"the default process cannot be set in a project descriptor",
+			}, warnings)
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, d.Encode(buf))
+
+			roundTrip, err := convert.ParseProjectDescriptor(buf)
+			require.NoError(t, err)
+			require.Equal(t, d, roundTrip)
+			require.Equal(t, "registry.io/builder", roundTrip.IO.Buildpacks.Builder)
+		})
+	})
+}
@@ -0,0 +1,190 @@
+package convert
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+// PackBuild holds the flags of a `pack build` invocation that affect the
+// built image.
+type PackBuild struct {
+	Image          string
+	Builder        string
+	Env            []string
+	Tags           []string
+	RunImage       string
+	Buildpacks     []string
+	DefaultProcess string
+	Descriptor     string
+	CacheImage     string
+	Path           string
+}
+
+type stringSlice []string
+
+func (s *stringSlice) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSlice) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// ParsePackBuild parses the arguments following `pack build`. Flags that
+// only affect the local pack run, such as --publish, are accepted and
+// ignored.
+func ParsePackBuild(args []string) (PackBuild, error) {
+	var pb PackBuild
+
+	flags := flag.NewFlagSet("pack build", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+
+	stringFlag := func(value *string, names ...string) {
+		for _, n := range names {
+			flags.StringVar(value, n, "", "")
+		}
+	}
+	sliceFlag := func(value *[]string, names ...string) {
+		for _, n := range names {
+			flags.Var((*stringSlice)(value), n, "")
+		}
+	}
+
+	stringFlag(&pb.Builder, "builder", "B")
+	sliceFlag(&pb.Env, "env", "e")
+	sliceFlag(&pb.Tags, "tag", "t")
+	stringFlag(&pb.RunImage, "run-image")
+	sliceFlag(&pb.Buildpacks, "buildpack", "b")
+	stringFlag(&pb.DefaultProcess, "default-process", "D")
+	stringFlag(&pb.Descriptor, "descriptor", "d")
+	stringFlag(&pb.CacheImage, "cache-image")
+	stringFlag(&pb.Path, "path", "p")
+
+	var ignored string
+	for _, n := range []string{"pull-policy", "network", "lifecycle-image", "creation-time"} {
+		flags.StringVar(&ignored, n, "", "")
+	}
+	var ignoredBool bool
+	for _, n := range []string{"publish", "clear-cache", "trust-builder"} {
+		flags.BoolVar(&ignoredBool, n, false, "")
+	}
+
+	// pack accepts the image name before or after the flags
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		pb.Image = args[0]
+		args = args[1:]
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return PackBuild{}, errors.Wrap(err, "parsing pack build arguments")
+	}
+
+	switch remaining := flags.Args(); {
+	case len(remaining) == 1 && pb.Image == "":
+		pb.Image = remaining[0]
+	case len(remaining) > 0:
+		return PackBuild{}, errors.Errorf("unexpected arguments: %s", strings.Join(remaining, " "))
+	}
+
+	if pb.Image == "" {
+		return PackBuild{}, errors.New("image name is required")
+	}
+	return pb, nil
+}
+
+// Args returns the arguments of the equivalent `pack build` invocation.
+func (pb PackBuild) Args() []string {
+	args := []string{pb.Image}
+	add := func(name, value string) {
+		if value != "" {
+			args = append(args, "--"+name, value)
+		}
+	}
+
+	add("builder", pb.Builder)
+	for _, e := range pb.Env {
+		add("env", e)
+	}
+	for _, t := range pb.Tags {
+		add("tag", t)
+	}
+	add("run-image", pb.RunImage)
+	for _, b := range pb.Buildpacks {
+		add("buildpack", b)
+	}
+	add("default-process", pb.DefaultProcess)
+	add("descriptor", pb.Descriptor)
+	add("cache-image", pb.CacheImage)
+	add("path", pb.Path)
+	return args
+}
+
+// ImageFromPackBuild returns the Image that builds what pb builds.
+func ImageFromPackBuild(pb PackBuild, opts ImageOptions) (*buildapi.Image, []string, error) {
+	image := opts.image(pb.Image)
+	image.Spec.AdditionalTags = pb.Tags
+	image.Spec.DefaultProcess = pb.DefaultProcess
+	image.Spec.ProjectDescriptorPath = pb.Descriptor
+	if pb.CacheImage != "" {
+		image.Spec.Cache = &buildapi.ImageCacheConfig{
+			Registry: &buildapi.RegistryCache{Tag: pb.CacheImage},
+		}
+	}
+
+	warnings := opts.builderWarning(pb.Builder)
+
+	var env []corev1.EnvVar
+	for _, e := range pb.Env {
+		parts := strings.SplitN(e, "=", 2)
+		if len(parts) != 2 {
+			warnings = append(warnings, fmt.Sprintf("env %s is read from the local environment by pack and was not converted", e))
+			continue
+		}
+		env = append(env, corev1.EnvVar{Name: parts[0], Value: parts[1]})
+	}
+	setEnv(image, env)
+
+	if pb.RunImage != "" {
+		warnings = append(warnings, fmt.Sprintf("run image %s must be set on the stack of the builder", pb.RunImage))
+	}
+	if len(pb.Buildpacks) > 0 {
+		warnings = append(warnings, fmt.Sprintf("buildpacks %s must be added to the order of the builder", strings.Join(pb.Buildpacks, ", ")))
+	}
+	if pb.Path != "" && opts.Source.Source() == nil {
+		warnings = append(warnings, fmt.Sprintf("local path %s must be replaced with a git, blob or registry source", pb.Path))
+	}
+
+	return image, warnings, nil
+}
+
+// PackBuildFromImage returns the `pack build` flags that build what image
+// builds. builderImage is the image of the Builder or ClusterBuilder the
+// Image uses.
+func PackBuildFromImage(image *buildapi.Image, builderImage string) (PackBuild, []string) {
+	pb := PackBuild{
+		Image:          image.Spec.Tag,
+		Builder:        builderImage,
+		Tags:           image.Spec.AdditionalTags,
+		DefaultProcess: image.Spec.DefaultProcess,
+		Descriptor:     image.Spec.ProjectDescriptorPath,
+	}
+	if image.Spec.Cache != nil && image.Spec.Cache.Registry != nil {
+		pb.CacheImage = image.Spec.Cache.Registry.Tag
+	}
+
+	env, warnings := imageEnv(image)
+	for _, e := range env {
+		pb.Env = append(pb.Env, e.Name+"="+e.Value)
+	}
+	warnings = append(warnings, bindingWarnings(image)...)
+
+	return pb, warnings
+}
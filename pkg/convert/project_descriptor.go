@@ -0,0 +1,189 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+const projectDescriptorSchemaVersion = "0.2"
+
+// ProjectDescriptor is a v0.2 project.toml. See
+// https://github.com/buildpacks/spec/blob/main/extensions/project-descriptor.md
+type ProjectDescriptor struct {
+	Project ProjectTable `toml:"_"`
+	IO      IOTable      `toml:"io"`
+}
+
+type ProjectTable struct {
+	SchemaVersion string `toml:"schema-version"`
+}
+
+type IOTable struct {
+	Buildpacks BuildpacksTable `toml:"buildpacks"`
+}
+
+type BuildpacksTable struct {
+	Builder string                `toml:"builder,omitempty"`
+	Include []string              `toml:"include,omitempty"`
+	Exclude []string              `toml:"exclude,omitempty"`
+	Group   []DescriptorBuildpack `toml:"group,omitempty"`
+	Build   DescriptorBuild       `toml:"build,omitempty"`
+}
+
+type DescriptorBuildpack struct {
+	ID      string `toml:"id,omitempty"`
+	Version string `toml:"version,omitempty"`
+	URI     string `toml:"uri,omitempty"`
+}
+
+type DescriptorBuild struct {
+	Env []DescriptorEnv `toml:"env,omitempty"`
+}
+
+type DescriptorEnv struct {
+	Name  string `toml:"name"`
+	Value string `toml:"value"`
+}
+
+type projectDescriptorV1 struct {
+	Build struct {
+		Include    []string              `toml:"include"`
+		Exclude    []string              `toml:"exclude"`
+		Builder    string                `toml:"builder"`
+		Buildpacks []DescriptorBuildpack `toml:"buildpacks"`
+		Env        []DescriptorEnv       `toml:"env"`
+	} `toml:"build"`
+}
+
+// ParseProjectDescriptor reads a project.toml. Descriptors without a schema
+// version are read as v0.1 and returned as v0.2.
+func ParseProjectDescriptor(r io.Reader) (ProjectDescriptor, error) {
+	contents, err := io.ReadAll(r)
+	if err != nil {
+		return ProjectDescriptor{}, err
+	}
+
+	var d ProjectDescriptor
+	if _, err := toml.Decode(string(contents), &d); err != nil {
+		return ProjectDescriptor{}, errors.Wrap(err, "parsing project descriptor")
+	}
+
+	// the deprecated [[io.buildpacks.env]] table is used when
+	// [[io.buildpacks.build.env]] is not set
+	var deprecated struct {
+		IO struct {
+			Buildpacks struct {
+				Env []DescriptorEnv `toml:"env"`
+			} `toml:"buildpacks"`
+		} `toml:"io"`
+	}
+
+	switch sv := d.Project.SchemaVersion; sv {
+	case projectDescriptorSchemaVersion:
+		if d.IO.Buildpacks.Build.Env == nil {
+			if _, err := toml.Decode(string(contents), &deprecated); err != nil {
+				return ProjectDescriptor{}, errors.Wrap(err, "parsing project descriptor")
+			}
+			d.IO.Buildpacks.Build.Env = deprecated.IO.Buildpacks.Env
+		}
+		return d, nil
+	case "":
+		var v1 projectDescriptorV1
+		if _, err := toml.Decode(string(contents), &v1); err != nil {
+			return ProjectDescriptor{}, errors.Wrap(err, "parsing project descriptor")
+		}
+		return ProjectDescriptor{
+			Project: ProjectTable{SchemaVersion: projectDescriptorSchemaVersion},
+			IO: IOTable{
+				Buildpacks: BuildpacksTable{
+					Builder: v1.Build.Builder,
+					Include: v1.Build.Include,
+					Exclude: v1.Build.Exclude,
+					Group:   v1.Build.Buildpacks,
+					Build:   DescriptorBuild{Env: v1.Build.Env},
+				},
+			},
+		}, nil
+	default:
+		return ProjectDescriptor{}, errors.Errorf("unsupported project descriptor schema version %s", sv)
+	}
+}
+
+// Encode writes the descriptor as toml.
+func (d ProjectDescriptor) Encode(w io.Writer) error {
+	return toml.NewEncoder(w).Encode(d)
+}
+
+// ImageFromProjectDescriptor returns the Image that builds tag with the
+// settings of the project descriptor. The build env of the descriptor is
+// copied to the Image so the descriptor does not need to be part of the
+// source.
+func ImageFromProjectDescriptor(d ProjectDescriptor, tag string, opts ImageOptions) (*buildapi.Image, []string) {
+	image := opts.image(tag)
+
+	warnings := opts.builderWarning(d.IO.Buildpacks.Builder)
+
+	var env []corev1.EnvVar
+	for _, e := range d.IO.Buildpacks.Build.Env {
+		env = append(env, corev1.EnvVar{Name: e.Name, Value: e.Value})
+	}
+	setEnv(image, env)
+
+	if len(d.IO.Buildpacks.Group) > 0 {
+		var ids []string
+		for _, bp := range d.IO.Buildpacks.Group {
+			ids = append(ids, bpRef(bp))
+		}
+		warnings = append(warnings, fmt.Sprintf("buildpacks %s must be added to the order of the builder", strings.Join(ids, ", ")))
+	}
+	if len(d.IO.Buildpacks.Include) > 0 || len(d.IO.Buildpacks.Exclude) > 0 {
+		warnings = append(warnings, "include and exclude are only applied when the project descriptor is part of the source")
+	}
+
+	return image, warnings
+}
+
+// ProjectDescriptorFromImage returns the project descriptor that builds what
+// image builds. builderImage is the image of the Builder or ClusterBuilder
+// the Image uses.
+func ProjectDescriptorFromImage(image *buildapi.Image, builderImage string) (ProjectDescriptor, []string) {
+	d := ProjectDescriptor{
+		Project: ProjectTable{SchemaVersion: projectDescriptorSchemaVersion},
+		IO: IOTable{
+			Buildpacks: BuildpacksTable{Builder: builderImage},
+		},
+	}
+
+	env, warnings := imageEnv(image)
+	for _, e := range env {
+		d.IO.Buildpacks.Build.Env = append(d.IO.Buildpacks.Build.Env, DescriptorEnv{Name: e.Name, Value: e.Value})
+	}
+	warnings = append(warnings, bindingWarnings(image)...)
+
+	if len(image.Spec.AdditionalTags) > 0 {
+		warnings = append(warnings, "additional tags cannot be set in a project descriptor")
+	}
+	if image.Spec.DefaultProcess != "" {
+		warnings = append(warnings, "the default process cannot be set in a project descriptor")
+	}
+
+	return d, warnings
+}
+
+func bpRef(bp DescriptorBuildpack) string {
+	switch {
+	case bp.URI != "":
+		return bp.URI
+	case bp.Version != "":
+		return bp.ID + "@" + bp.Version
+	default:
+		return bp.ID
+	}
+}
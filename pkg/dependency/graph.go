@@ -0,0 +1,140 @@
+package dependency
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+)
+
+// Graph maps kpack resources to the Images that depend on them, either
+// directly through the Image builder or indirectly through the stack, store
+// and buildpacks of that builder.
+type Graph struct {
+	images          []buildapi.Image
+	builders        map[resource][]resource
+	clusterBuilders map[resource][]resource
+}
+
+type resource struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// NewGraph builds a Graph from the Images, Builders and ClusterBuilders in
+// every namespace.
+func NewGraph(ctx context.Context, client versioned.Interface) (*Graph, error) {
+	images, err := client.KpackV1alpha2().Images(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	builders, err := client.KpackV1alpha2().Builders(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	clusterBuilders, err := client.KpackV1alpha2().ClusterBuilders().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return newGraph(images.Items, builders.Items, clusterBuilders.Items), nil
+}
+
+func newGraph(images []buildapi.Image, builders []buildapi.Builder, clusterBuilders []buildapi.ClusterBuilder) *Graph {
+	g := &Graph{
+		images:          images,
+		builders:        map[resource][]resource{},
+		clusterBuilders: map[resource][]resource{},
+	}
+
+	for _, builder := range builders {
+		key := resource{kind: buildapi.BuilderKind, namespace: builder.Namespace, name: builder.Name}
+		g.builders[key] = builderDependencies(builder.Spec.BuilderSpec, builder.Namespace)
+	}
+
+	for _, builder := range clusterBuilders {
+		key := resource{kind: buildapi.ClusterBuilderKind, name: builder.Name}
+		g.clusterBuilders[key] = builderDependencies(builder.Spec.BuilderSpec, "")
+	}
+
+	return g
+}
+
+// Dependents returns the Images that depend on the referenced resource. The
+// reference may be a ClusterStack, ClusterStore, ClusterBuildpack,
+// ClusterBuilder or, with a namespace, a Stack, Store, Buildpack or Builder.
+func (g *Graph) Dependents(ref corev1.ObjectReference) []buildapi.Image {
+	target := newResource(ref.Kind, ref.Namespace, ref.Name)
+
+	builders := map[resource]bool{}
+	if target.kind == buildapi.BuilderKind || target.kind == buildapi.ClusterBuilderKind {
+		builders[target] = true
+	}
+
+	for _, dependencies := range []map[resource][]resource{g.builders, g.clusterBuilders} {
+		for builder, builderDependencies := range dependencies {
+			for _, dependency := range builderDependencies {
+				if dependency == target {
+					builders[builder] = true
+				}
+			}
+		}
+	}
+
+	var dependents []buildapi.Image
+	for _, image := range g.images {
+		if builders[imageBuilder(image)] {
+			dependents = append(dependents, image)
+		}
+	}
+
+	sort.Slice(dependents, func(i, j int) bool {
+		if dependents[i].Namespace != dependents[j].Namespace {
+			return dependents[i].Namespace < dependents[j].Namespace
+		}
+		return dependents[i].Name < dependents[j].Name
+	})
+	return dependents
+}
+
+func builderDependencies(spec buildapi.BuilderSpec, namespace string) []resource {
+	dependencies := []resource{
+		newResource(spec.Stack.Kind, namespace, spec.Stack.Name),
+	}
+
+	if spec.Store.Name != "" {
+		dependencies = append(dependencies, newResource(spec.Store.Kind, namespace, spec.Store.Name))
+	}
+
+	for _, entry := range spec.Order {
+		for _, ref := range entry.Group {
+			if ref.Name != "" {
+				dependencies = append(dependencies, newResource(ref.Kind, namespace, ref.Name))
+			}
+		}
+	}
+	return dependencies
+}
+
+func imageBuilder(image buildapi.Image) resource {
+	namespace := image.Namespace
+	if image.Spec.Builder.Namespace != "" {
+		namespace = image.Spec.Builder.Namespace
+	}
+	return newResource(image.Spec.Builder.Kind, namespace, image.Spec.Builder.Name)
+}
+
+func newResource(kind, namespace, name string) resource {
+	switch kind {
+	case buildapi.ClusterBuilderKind, buildapi.ClusterStackKind, buildapi.ClusterStoreKind, buildapi.ClusterBuildpackKind:
+		namespace = ""
+	}
+	return resource{kind: kind, namespace: namespace, name: name}
+}
@@ -0,0 +1,124 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+)
+
+func TestGraph(t *testing.T) {
+	spec.Run(t, "Dependency Graph", testGraph)
+}
+
+func testGraph(t *testing.T, when spec.G, it spec.S) {
+	clusterBuilder := &buildapi.ClusterBuilder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "some-cluster-builder",
+		},
+		Spec: buildapi.ClusterBuilderSpec{
+			BuilderSpec: buildapi.BuilderSpec{
+				Stack: corev1.ObjectReference{Kind: buildapi.ClusterStackKind, Name: "some-cluster-stack"},
+				Store: corev1.ObjectReference{Kind: buildapi.ClusterStoreKind, Name: "some-cluster-store"},
+				Order: []buildapi.BuilderOrderEntry{
+					{
+						Group: []buildapi.BuilderBuildpackRef{
+							{
+								ObjectReference: corev1.ObjectReference{Kind: buildapi.ClusterBuildpackKind, Name: "some-cluster-buildpack"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	builder := &buildapi.Builder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-builder",
+			Namespace: "platform",
+		},
+		Spec: buildapi.NamespacedBuilderSpec{
+			BuilderSpec: buildapi.BuilderSpec{
+				Stack: corev1.ObjectReference{Kind: buildapi.ClusterStackKind, Name: "some-cluster-stack"},
+				Store: corev1.ObjectReference{Kind: buildapi.StoreKind, Name: "some-store"},
+			},
+		},
+	}
+
+	image := func(namespace, name string, builder corev1.ObjectReference) *buildapi.Image {
+		return &buildapi.Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: buildapi.ImageSpec{
+				Builder: builder,
+			},
+		}
+	}
+
+	clusterBuilderImage := image("team-a", "cluster-builder-image", corev1.ObjectReference{Kind: buildapi.ClusterBuilderKind, Name: "some-cluster-builder"})
+	builderImage := image("platform", "builder-image", corev1.ObjectReference{Kind: buildapi.BuilderKind, Name: "some-builder"})
+	crossNamespaceImage := image("team-b", "cross-namespace-image", corev1.ObjectReference{Kind: buildapi.BuilderKind, Name: "some-builder", Namespace: "platform"})
+	otherImage := image("team-b", "other-image", corev1.ObjectReference{Kind: buildapi.BuilderKind, Name: "some-builder"})
+
+	client := fake.NewSimpleClientset(clusterBuilder, builder, clusterBuilderImage, builderImage, crossNamespaceImage, otherImage)
+
+	var graph *Graph
+	it.Before(func() {
+		var err error
+		graph, err = NewGraph(context.TODO(), client)
+		require.NoError(t, err)
+	})
+
+	names := func(images []buildapi.Image) []string {
+		var names []string
+		for _, image := range images {
+			names = append(names, image.Namespace+"/"+image.Name)
+		}
+		return names
+	}
+
+	it("returns images that depend on a cluster stack through any builder", func() {
+		dependents := graph.Dependents(corev1.ObjectReference{Kind: buildapi.ClusterStackKind, Name: "some-cluster-stack"})
+
+		require.Equal(t, []string{"platform/builder-image", "team-a/cluster-builder-image", "team-b/cross-namespace-image"}, names(dependents))
+	})
+
+	it("returns images that depend on a cluster store", func() {
+		dependents := graph.Dependents(corev1.ObjectReference{Kind: buildapi.ClusterStoreKind, Name: "some-cluster-store"})
+
+		require.Equal(t, []string{"team-a/cluster-builder-image"}, names(dependents))
+	})
+
+	it("returns images that depend on a cluster buildpack", func() {
+		dependents := graph.Dependents(corev1.ObjectReference{Kind: buildapi.ClusterBuildpackKind, Name: "some-cluster-buildpack"})
+
+		require.Equal(t, []string{"team-a/cluster-builder-image"}, names(dependents))
+	})
+
+	it("returns images that depend on a namespaced store", func() {
+		dependents := graph.Dependents(corev1.ObjectReference{Kind: buildapi.StoreKind, Namespace: "platform", Name: "some-store"})
+
+		require.Equal(t, []string{"platform/builder-image", "team-b/cross-namespace-image"}, names(dependents))
+
+		require.Empty(t, graph.Dependents(corev1.ObjectReference{Kind: buildapi.StoreKind, Namespace: "team-b", Name: "some-store"}))
+	})
+
+	it("returns images that reference a builder", func() {
+		dependents := graph.Dependents(corev1.ObjectReference{Kind: buildapi.BuilderKind, Namespace: "platform", Name: "some-builder"})
+
+		require.Equal(t, []string{"platform/builder-image", "team-b/cross-namespace-image"}, names(dependents))
+	})
+
+	it("returns nothing for unreferenced resources", func() {
+		require.Empty(t, graph.Dependents(corev1.ObjectReference{Kind: buildapi.ClusterStackKind, Name: "unused"}))
+	})
+}
@@ -0,0 +1,43 @@
+package dependency
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+)
+
+// Rebuild requests a new build for each Image by annotating its latest
+// build, the same way an individual Image build is triggered.
+func Rebuild(ctx context.Context, client versioned.Interface, images []buildapi.Image) error {
+	for _, image := range images {
+		if err := rebuild(ctx, client, image); err != nil {
+			return errors.Wrapf(err, "triggering build for image %s/%s", image.Namespace, image.Name)
+		}
+	}
+	return nil
+}
+
+func rebuild(ctx context.Context, client versioned.Interface, image buildapi.Image) error {
+	if image.Status.LatestBuildRef == "" {
+		return errors.New("image has no builds")
+	}
+
+	build, err := client.KpackV1alpha2().Builds(image.Namespace).Get(ctx, image.Status.LatestBuildRef, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	build = build.DeepCopy()
+	if build.Annotations == nil {
+		build.Annotations = map[string]string{}
+	}
+	build.Annotations[buildapi.BuildNeededAnnotation] = time.Now().String()
+
+	_, err = client.KpackV1alpha2().Builds(image.Namespace).Update(ctx, build, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,49 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+)
+
+func TestRebuild(t *testing.T) {
+	spec.Run(t, "Rebuild", testRebuild)
+}
+
+func testRebuild(t *testing.T, when spec.G, it spec.S) {
+	image := buildapi.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-image",
+			Namespace: "some-namespace",
+		},
+	}
+
+	build := &buildapi.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-image-build-1",
+			Namespace: "some-namespace",
+		},
+	}
+
+	it("annotates the latest build of each image", func() {
+		image.Status.LatestBuildRef = build.Name
+
+		client := fake.NewSimpleClientset(build)
+		require.NoError(t, Rebuild(context.TODO(), client, []buildapi.Image{image}))
+
+		updated, err := client.KpackV1alpha2().Builds("some-namespace").Get(context.TODO(), build.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Contains(t, updated.Annotations, buildapi.BuildNeededAnnotation)
+	})
+
+	it("errors for images without builds", func() {
+		err := Rebuild(context.TODO(), fake.NewSimpleClientset(), []buildapi.Image{image})
+		require.EqualError(t, err, "triggering build for image some-namespace/some-image: image has no builds")
+	})
+}
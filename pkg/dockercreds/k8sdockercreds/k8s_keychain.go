@@ -73,8 +73,17 @@ func keychainFromServiceAccount(ctx context.Context, secretRef registry.SecretRe
 	secrets, err := fetcher.SecretsForServiceAccount(ctx, secretRef.ServiceAccountOrDefault(), secretRef.Namespace)
 	if err != nil && !k8serrors.IsNotFound(err) {
 		return nil, err
-	} else if k8serrors.IsNotFound(err) {
-		return dockerCreds, nil
+	}
+
+	pullSecrets, err := fetcher.Secrets(ctx, secretRef.ImagePullSecrets, secretRef.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	// docker config pull secrets are read by the k8schain keychain
+	for _, s := range pullSecrets {
+		if s.Type == corev1.SecretTypeBasicAuth {
+			secrets = append(secrets, s)
+		}
 	}
 
 	for _, s := range secrets {
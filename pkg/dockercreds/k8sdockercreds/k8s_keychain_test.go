@@ -239,6 +239,48 @@ func testK8sSecretKeychainFactory(t *testing.T, when spec.G, it spec.S) {
 			}, authConfig)
 		})
 
+		it("keychain provides auth from annotated basic auth ImagePull secrets", func() {
+			fakeClient := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-auth-pull-secret",
+					Namespace: testNamespace,
+					Annotations: map[string]string{
+						buildapi.DOCKERSecretAnnotationPrefix: "private.io",
+					},
+				},
+				Type: corev1.SecretTypeBasicAuth,
+				Data: map[string][]byte{
+					corev1.BasicAuthUsernameKey: []byte("private-username"),
+					corev1.BasicAuthPasswordKey: []byte("private-password"),
+				},
+			},
+				&corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "default",
+						Namespace: testNamespace,
+					},
+				})
+			keychainFactory, err := NewSecretKeychainFactory(fakeClient)
+			require.NoError(t, err)
+
+			keychain, err := keychainFactory.KeychainForSecretRef(context.TODO(), registry.SecretRef{
+				Namespace:        testNamespace,
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "basic-auth-pull-secret"}},
+			})
+			require.NoError(t, err)
+
+			reg, err := name.NewRegistry("private.io")
+			require.NoError(t, err)
+
+			authenticator, err := keychain.Resolve(reg)
+			require.NoError(t, err)
+
+			assert.Equal(t, authn.FromConfig(authn.AuthConfig{
+				Username: "private-username",
+				Password: "private-password",
+			}), authenticator)
+		})
+
 		it("keychain provides Anonymous auth for no matching credentials", func() {
 			keychainFactory, err := NewSecretKeychainFactory(fake.NewSimpleClientset(&corev1.ServiceAccount{
 				ObjectMeta: metav1.ObjectMeta{
@@ -3,6 +3,7 @@ package duckbuilder
 import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
@@ -26,6 +27,30 @@ func (b *DuckBuilder) GetKind() string {
 
 type DuckBuilderSpec struct {
 	ImagePullSecrets []v1.LocalObjectReference
+	Rollout          *buildapi.BuilderRollout
+}
+
+// ForImage returns the builder that should be used by the image. While a
+// rollout is in progress, images outside the canary selector keep using the
+// stable builder.
+func (b *DuckBuilder) ForImage(image *buildapi.Image) *DuckBuilder {
+	if b.Spec.Rollout == nil || b.Status.Rollout == nil {
+		return b
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&b.Spec.Rollout.CanarySelector)
+	if err != nil || selector.Matches(labels.Set(image.Labels)) {
+		return b
+	}
+
+	stable := *b
+	stable.Status.LatestImage = b.Status.Rollout.Stable.LatestImage
+	stable.Status.BuilderMetadata = b.Status.Rollout.Stable.BuilderMetadata
+	stable.Status.Stack = b.Status.Rollout.Stable.Stack
+	if stable.Status.Stack.ID != b.Status.Stack.ID {
+		stable.Status.StackDeprecation = nil
+	}
+	return &stable
 }
 
 func (b *DuckBuilder) Ready() bool {
@@ -48,6 +73,14 @@ func (b *DuckBuilder) RunImage() string {
 	return b.Status.Stack.RunImage
 }
 
+func (b *DuckBuilder) StackId() string {
+	return b.Status.Stack.ID
+}
+
+func (b *DuckBuilder) StackDeprecation() *buildapi.StackDeprecation {
+	return b.Status.StackDeprecation
+}
+
 func (b *DuckBuilder) ConditionReadyMessage() string {
 	condition := b.Status.GetCondition(corev1alpha1.ConditionReady)
 	if condition == nil {
@@ -105,4 +105,55 @@ func testDuckBuilder(t *testing.T, when spec.G, it spec.S) {
 		require.Equal(t, "some/run@sha256:12345678", duckBuilder.RunImage())
 	})
 
+	when("ForImage", func() {
+		canaryImage := &buildapi.Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{"canary": "true"},
+			},
+		}
+
+		otherImage := &buildapi.Image{}
+
+		it.Before(func() {
+			duckBuilder.Spec.Rollout = &buildapi.BuilderRollout{
+				CanarySelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"canary": "true"},
+				},
+			}
+			duckBuilder.Status.Rollout = &buildapi.BuilderRolloutStatus{
+				Stable: buildapi.BuilderRevision{
+					LatestImage: "some/builder@sha256:stable",
+					BuilderMetadata: corev1alpha1.BuildpackMetadataList{
+						{
+							Id:      "test.builder",
+							Version: "stable.version",
+						},
+					},
+					Stack: corev1alpha1.BuildStack{
+						RunImage: "some/run@sha256:stable",
+					},
+				},
+			}
+		})
+
+		it("provides the latest builder to canary images", func() {
+			require.Equal(t, duckBuilder, duckBuilder.ForImage(canaryImage))
+		})
+
+		it("provides the stable builder to other images during a rollout", func() {
+			builder := duckBuilder.ForImage(otherImage)
+
+			require.Equal(t, "some/builder@sha256:stable", builder.BuildBuilderSpec().Image)
+			require.Equal(t, "some/run@sha256:stable", builder.RunImage())
+			require.Equal(t, "stable.version", builder.BuildpackMetadata()[0].Version)
+			require.Equal(t, "some/builder@sha256:12345678", duckBuilder.BuildBuilderSpec().Image)
+		})
+
+		it("provides the latest builder when no rollout is in progress", func() {
+			duckBuilder.Status.Rollout = nil
+
+			require.Equal(t, duckBuilder, duckBuilder.ForImage(otherImage))
+		})
+	})
+
 }
@@ -50,7 +50,12 @@ type DuckBuilderNamespaceLister struct {
 func (bl *DuckBuilderNamespaceLister) Get(reference corev1.ObjectReference) (*DuckBuilder, error) {
 	switch reference.Kind {
 	case buildapi.BuilderKind:
-		builder, err := bl.DuckBuilderLister.BuilderLister.Builders(bl.namespace).Get(reference.Name)
+		namespace := bl.namespace
+		if reference.Namespace != "" {
+			namespace = reference.Namespace
+		}
+
+		builder, err := bl.DuckBuilderLister.BuilderLister.Builders(namespace).Get(reference.Name)
 		return convertBuilder(builder), err
 	case buildapi.ClusterBuilderKind:
 		builder, err := bl.DuckBuilderLister.ClusterBuilderLister.Get(reference.Name)
@@ -80,6 +85,9 @@ func convertClusterBuilder(builder *buildapi.ClusterBuilder) *DuckBuilder {
 	return &DuckBuilder{
 		TypeMeta:   builder.TypeMeta,
 		ObjectMeta: builder.ObjectMeta,
-		Status:     builder.Status,
+		Spec: DuckBuilderSpec{
+			Rollout: builder.Spec.Rollout,
+		},
+		Status: builder.Status,
 	}
 }
@@ -103,6 +103,17 @@ func testDuckBuilderInformer(t *testing.T, when spec.G, it spec.S) {
 			require.Equal(t, []v1.LocalObjectReference(nil), duckBuilder.Spec.ImagePullSecrets)
 		})
 
+		it("can return a builder of type Builder from the referenced namespace", func() {
+			duckBuilder, err := duckBuilderLister.Namespace("some-image-namespace").Get(v1.ObjectReference{
+				Kind:      buildapi.BuilderKind,
+				Namespace: builderNamespace,
+				Name:      builderName,
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, builder.ObjectMeta, duckBuilder.ObjectMeta)
+		})
+
 		it("can return a builder of type ClusterBuilder", func() {
 			duckBuilder, err := duckBuilderLister.Namespace("").Get(v1.ObjectReference{
 				Kind: buildapi.ClusterBuilderKind,
@@ -5,7 +5,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func certificateCheckCallback() git2go.CertificateCheckCallback {
+func certificateCheckCallback(keychain GitKeychain, hostKeyPolicy HostKeyPolicy) git2go.CertificateCheckCallback {
 	return func(cert *git2go.Certificate, valid bool, hostname string) error {
 		if valid {
 			return nil
@@ -19,15 +19,7 @@ func certificateCheckCallback() git2go.CertificateCheckCallback {
 				}
 			}
 		} else if cert.Kind == git2go.CertificateHostkey {
-			if cert.Hostkey.Kind == git2go.HostkeyMD5 {
-				if !isByteArrayEmpty(cert.Hostkey.HashMD5[:]) {
-					return errors.New("invalid host key MD5")
-				}
-			} else if cert.Hostkey.Kind == git2go.HostkeySHA1 {
-				if !isByteArrayEmpty(cert.Hostkey.HashSHA1[:]) {
-					return errors.New("invalid host key SHA1")
-				}
-			}
+			return hostKeyPolicy.verify(keychain, hostname, cert.Hostkey)
 		}
 
 		return nil
@@ -0,0 +1,205 @@
+package git
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	git2go "github.com/libgit2/git2go/v33"
+	"github.com/pkg/errors"
+	giturls "github.com/whilp/git-urls"
+)
+
+const defaultGitBinary = "git"
+
+// CLIFetcher fetches source with the git command line client instead of
+// libgit2. It is used for servers whose protocol quirks libgit2 cannot
+// negotiate, such as Azure DevOps or older Gerrit releases.
+type CLIFetcher struct {
+	Logger        *log.Logger
+	Keychain      GitKeychain
+	HostKeyPolicy HostKeyPolicy
+	GitBinary     string
+}
+
+func (f CLIFetcher) Fetch(dir, gitURL, gitRevision, gitBranch, metadataDir string) error {
+	f.Logger.Printf("Cloning %q @ %q with the git cli...", gitURL, gitRevision)
+
+	gitBinary := f.GitBinary
+	if gitBinary == "" {
+		gitBinary = defaultGitBinary
+	}
+	gitPath, err := exec.LookPath(gitBinary)
+	if err != nil {
+		return errors.Wrap(err, "git cli compatibility mode requires git")
+	}
+
+	credentialsDir, err := ioutil.TempDir("", "git-cli-credentials")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(credentialsDir)
+
+	cli, err := f.gitCli(gitPath, dir, credentialsDir, gitURL)
+	if err != nil {
+		return err
+	}
+
+	if _, err := cli.run("init", "--quiet"); err != nil {
+		return errors.Wrap(err, "initializing repo")
+	}
+
+	if _, err := cli.run("remote", "add", defaultRemote, parseURL(gitURL)); err != nil {
+		return errors.Wrap(err, "creating remote")
+	}
+
+	lsRemote, err := cli.run("ls-remote", defaultRemote)
+	if err != nil {
+		return errors.Wrap(err, "fetching remote")
+	}
+
+	var remoteRefs []string
+	for _, line := range strings.Split(lsRemote, "\n") {
+		if _, ref, ok := strings.Cut(line, "\t"); ok {
+			remoteRefs = append(remoteRefs, ref)
+		}
+	}
+
+	fetch := func(refspecs []string) error {
+		args := []string{"fetch", "--quiet", "--update-head-ok", defaultRemote}
+		if refspecs[0] == allRefsRefspec {
+			args = append(args, "--tags")
+		}
+		if _, err := cli.run(append(args, refspecs...)...); err != nil {
+			return errors.Wrap(err, "fetching remote")
+		}
+		return nil
+	}
+
+	refspecs := fetchRefspecs(remoteRefs, gitRevision, gitBranch)
+	if err := fetch(refspecs); err != nil {
+		return err
+	}
+
+	commit, err := cli.run("rev-parse", "--verify", "--quiet", gitRevision+"^{commit}")
+	if err != nil && refspecs[0] != allRefsRefspec {
+		f.Logger.Printf("Revision %q not found in %s, fetching all refs...", gitRevision, strings.Join(refspecs, ", "))
+		if err := fetch([]string{allRefsRefspec}); err != nil {
+			return err
+		}
+		commit, err = cli.run("rev-parse", "--verify", "--quiet", gitRevision+"^{commit}")
+	}
+	if err != nil {
+		return errors.Errorf("could not find reference: %s", gitRevision)
+	}
+
+	if gitBranch == "" {
+		if ref, err := cli.run("rev-parse", "--verify", "--quiet", "--symbolic-full-name", gitRevision); err == nil && strings.HasPrefix(ref, branchRefPrefix) {
+			gitBranch = strings.TrimPrefix(ref, branchRefPrefix)
+		}
+	}
+
+	checkoutArgs := []string{"checkout", "--quiet", "--force", "--detach", commit}
+	if gitBranch != "" {
+		checkoutArgs = []string{"checkout", "--quiet", "--force", "-B", gitBranch, commit}
+	}
+	if _, err := cli.run(checkoutArgs...); err != nil {
+		return errors.Wrap(err, "checkout head")
+	}
+
+	if err := writeProjectMetadata(metadataDir, gitURL, gitRevision, gitBranch, commit); err != nil {
+		return err
+	}
+
+	f.Logger.Printf("Successfully cloned %q @ %q in path %q", gitURL, gitRevision, dir)
+	return nil
+}
+
+type gitCli struct {
+	path       string
+	dir        string
+	configArgs []string
+	env        []string
+}
+
+func (g gitCli) run(args ...string) (string, error) {
+	cmd := exec.Command(g.path, append(g.configArgs, args...)...)
+	cmd.Dir = g.dir
+	cmd.Env = append(os.Environ(), g.env...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", errors.Errorf("git %s: %s: %s", args[0], err, strings.TrimSpace(string(output)))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// gitCli configures the git cli with the credentials from the keychain for
+// gitURL and the host key policy. Credentials are written to credentialsDir
+// so they never appear in process arguments or logs.
+func (f CLIFetcher) gitCli(gitPath, dir, credentialsDir, gitURL string) (gitCli, error) {
+	cli := gitCli{
+		path: gitPath,
+		dir:  dir,
+		env:  []string{"GIT_TERMINAL_PROMPT=0"},
+	}
+
+	u, err := giturls.Parse(parseURL(gitURL))
+	if err != nil {
+		return gitCli{}, err
+	}
+
+	sshCommand, err := f.sshCommand(credentialsDir)
+	if err != nil {
+		return gitCli{}, err
+	}
+
+	if u.Scheme != "ssh" {
+		cred, err := f.Keychain.Resolve(gitURL, "", git2go.CredentialTypeUserpassPlaintext)
+		if basicAuth, ok := cred.(BasicGit2GoAuth); err == nil && ok {
+			credentialsFile := filepath.Join(credentialsDir, "git-credentials")
+			credentialURL := url.URL{Scheme: u.Scheme, Host: u.Host, User: url.UserPassword(basicAuth.Username, basicAuth.Password)}
+			if err := ioutil.WriteFile(credentialsFile, []byte(credentialURL.String()+"\n"), 0600); err != nil {
+				return gitCli{}, err
+			}
+			cli.configArgs = append(cli.configArgs, "-c", "credential.helper=", "-c", fmt.Sprintf("credential.helper=store --file=%s", credentialsFile))
+		}
+	} else {
+		cred, err := f.Keychain.Resolve(gitURL, "", git2go.CredentialTypeSSHKey)
+		if sshAuth, ok := cred.(SSHGit2GoAuth); err == nil && ok {
+			keyFile := filepath.Join(credentialsDir, "id")
+			if err := ioutil.WriteFile(keyFile, []byte(sshAuth.PrivateKey), 0600); err != nil {
+				return gitCli{}, err
+			}
+			sshCommand = append(sshCommand, "-i", keyFile, "-o", "IdentitiesOnly=yes")
+		}
+	}
+
+	cli.env = append(cli.env, "GIT_SSH_COMMAND="+strings.Join(sshCommand, " "))
+	return cli, nil
+}
+
+// sshCommand returns the ssh invocation used by the git cli, verifying host
+// keys the same way as the libgit2 fetcher.
+func (f CLIFetcher) sshCommand(credentialsDir string) ([]string, error) {
+	if f.HostKeyPolicy.InsecureSkipVerify {
+		return []string{"ssh", "-o", "StrictHostKeyChecking=no", "-o", "UserKnownHostsFile=/dev/null"}, nil
+	}
+
+	secretKnownHosts, err := f.Keychain.KnownHosts()
+	if err != nil {
+		return nil, err
+	}
+
+	knownHostsFile := filepath.Join(credentialsDir, "known_hosts")
+	if err := ioutil.WriteFile(knownHostsFile, []byte(f.HostKeyPolicy.KnownHosts+"\n"+secretKnownHosts+"\n"), 0600); err != nil {
+		return nil, err
+	}
+
+	return []string{"ssh", "-o", "StrictHostKeyChecking=yes", "-o", "UserKnownHostsFile=" + knownHostsFile}, nil
+}
@@ -0,0 +1,157 @@
+package git
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitCLIFetch(t *testing.T) {
+	spec.Run(t, "Test Git CLI Fetch", testGitCLIFetch)
+}
+
+func testGitCLIFetch(t *testing.T, when spec.G, it spec.S) {
+	when("#Fetch", func() {
+		outputBuffer := &bytes.Buffer{}
+		fetcher := CLIFetcher{
+			Logger:   log.New(outputBuffer, "", 0),
+			Keychain: fakeGitKeychain{},
+		}
+		var (
+			originDir   string
+			testDir     string
+			metadataDir string
+			commit      string
+		)
+
+		git := func(dir string, args ...string) string {
+			cmd := exec.Command("git", args...)
+			cmd.Dir = dir
+			cmd.Env = append(os.Environ(),
+				"GIT_AUTHOR_NAME=kpack", "GIT_AUTHOR_EMAIL=kpack@example.com",
+				"GIT_COMMITTER_NAME=kpack", "GIT_COMMITTER_EMAIL=kpack@example.com",
+			)
+			output, err := cmd.CombinedOutput()
+			require.NoError(t, err, string(output))
+			return strings.TrimSpace(string(output))
+		}
+
+		it.Before(func() {
+			if _, err := exec.LookPath("git"); err != nil {
+				t.Skip("git is not installed")
+			}
+
+			var err error
+			originDir, err = ioutil.TempDir("", "test-git-origin")
+			require.NoError(t, err)
+
+			testDir, err = ioutil.TempDir("", "test-git")
+			require.NoError(t, err)
+
+			metadataDir, err = ioutil.TempDir("", "test-git")
+			require.NoError(t, err)
+
+			git(originDir, "init", "--quiet")
+			require.NoError(t, ioutil.WriteFile(path.Join(originDir, "file.txt"), []byte("contents"), 0644))
+			git(originDir, "add", "file.txt")
+			git(originDir, "commit", "--quiet", "-m", "initial")
+			git(originDir, "tag", "some-tag")
+			commit = git(originDir, "rev-parse", "HEAD")
+		})
+
+		it.After(func() {
+			require.NoError(t, os.RemoveAll(originDir))
+			require.NoError(t, os.RemoveAll(testDir))
+			require.NoError(t, os.RemoveAll(metadataDir))
+		})
+
+		for _, revision := range []string{"some-tag", "HEAD"} {
+			revision := revision
+			it("fetches "+revision+" with the git cli", func() {
+				gitUrl := "file://" + originDir
+
+				err := fetcher.Fetch(testDir, gitUrl, revision, "", metadataDir)
+				require.NoError(t, err)
+
+				require.FileExists(t, path.Join(testDir, "file.txt"))
+				require.Equal(t, commit, git(testDir, "rev-parse", "HEAD"))
+				require.Contains(t, outputBuffer.String(), "Successfully cloned")
+
+				var projectMetadata project
+				_, err = toml.DecodeFile(path.Join(metadataDir, "project-metadata.toml"), &projectMetadata)
+				require.NoError(t, err)
+
+				require.Equal(t, gitUrl, projectMetadata.Source.Metadata.Repository)
+				require.Equal(t, revision, projectMetadata.Source.Metadata.Revision)
+				require.Equal(t, commit, projectMetadata.Source.Version.Commit)
+			})
+		}
+
+		it("checks out a branch revision on a local branch", func() {
+			git(originDir, "branch", "feature")
+
+			err := fetcher.Fetch(testDir, "file://"+originDir, "feature", "", metadataDir)
+			require.NoError(t, err)
+			require.Equal(t, "feature", git(testDir, "rev-parse", "--abbrev-ref", "HEAD"))
+
+			var projectMetadata project
+			_, err = toml.DecodeFile(path.Join(metadataDir, "project-metadata.toml"), &projectMetadata)
+			require.NoError(t, err)
+			require.Equal(t, "feature", projectMetadata.Source.Metadata.Branch)
+		})
+
+		it("checks out a commit on the requested branch", func() {
+			err := fetcher.Fetch(testDir, "file://"+originDir, commit, "release", metadataDir)
+			require.NoError(t, err)
+			require.Equal(t, "release", git(testDir, "rev-parse", "--abbrev-ref", "HEAD"))
+			require.Equal(t, commit, git(testDir, "rev-parse", "HEAD"))
+		})
+
+		it("fetches only the requested ref", func() {
+			git(originDir, "branch", "other")
+
+			err := fetcher.Fetch(testDir, "file://"+originDir, "some-tag", "", metadataDir)
+			require.NoError(t, err)
+
+			refs := git(testDir, "for-each-ref", "--format=%(refname)")
+			require.Equal(t, "refs/tags/some-tag", refs)
+		})
+
+		it("fetches all refs when the commit is not on the requested branch", func() {
+			git(originDir, "checkout", "--quiet", "-b", "other")
+			require.NoError(t, ioutil.WriteFile(path.Join(originDir, "other.txt"), []byte("other"), 0644))
+			git(originDir, "add", "other.txt")
+			git(originDir, "commit", "--quiet", "-m", "other")
+			otherCommit := git(originDir, "rev-parse", "HEAD")
+			git(originDir, "checkout", "--quiet", "-")
+			git(originDir, "branch", "unrelated", commit)
+
+			err := fetcher.Fetch(testDir, "file://"+originDir, otherCommit, "unrelated", metadataDir)
+			require.NoError(t, err)
+			require.Equal(t, otherCommit, git(testDir, "rev-parse", "HEAD"))
+			require.Contains(t, outputBuffer.String(), "fetching all refs")
+		})
+
+		it("returns error on non-existent ref", func() {
+			err := fetcher.Fetch(testDir, "file://"+originDir, "doesnotexist", "", metadataDir)
+			require.EqualError(t, err, "could not find reference: doesnotexist")
+		})
+
+		it("returns error when git is not installed", func() {
+			fetcher.GitBinary = "does-not-exist-git"
+
+			err := fetcher.Fetch(testDir, "file://"+originDir, "HEAD", "", metadataDir)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "git cli compatibility mode requires git")
+		})
+	})
+}
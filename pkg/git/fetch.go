@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 	git2go "github.com/libgit2/git2go/v33"
@@ -11,11 +12,12 @@ import (
 )
 
 type Fetcher struct {
-	Logger   *log.Logger
-	Keychain GitKeychain
+	Logger        *log.Logger
+	Keychain      GitKeychain
+	HostKeyPolicy HostKeyPolicy
 }
 
-func (f Fetcher) Fetch(dir, gitURL, gitRevision, metadataDir string) error {
+func (f Fetcher) Fetch(dir, gitURL, gitRevision, gitBranch, metadataDir string) error {
 	f.Logger.Printf("Cloning %q @ %q...", gitURL, gitRevision)
 
 	repository, err := git2go.InitRepository(dir, false)
@@ -33,34 +35,82 @@ func (f Fetcher) Fetch(dir, gitURL, gitRevision, metadataDir string) error {
 	}
 	defer remote.Free()
 
-	err = remote.Fetch([]string{"refs/*:refs/*"}, &git2go.FetchOptions{
-		DownloadTags: git2go.DownloadTagsAll,
-		RemoteCallbacks: git2go.RemoteCallbacks{
-			CredentialsCallback:      keychainAsCredentialsCallback(f.Keychain),
-			CertificateCheckCallback: certificateCheckCallback(),
-		},
-		ProxyOptions: git2go.ProxyOptions{
-			Type: git2go.ProxyTypeAuto,
-		},
-	}, "")
+	callbacks := git2go.RemoteCallbacks{
+		CredentialsCallback:      keychainAsCredentialsCallback(f.Keychain),
+		CertificateCheckCallback: certificateCheckCallback(f.Keychain, f.HostKeyPolicy),
+	}
+	proxyOptions := git2go.ProxyOptions{
+		Type: git2go.ProxyTypeAuto,
+	}
+
+	err = remote.ConnectFetch(&callbacks, &proxyOptions, nil)
 	if err != nil {
 		return errors.Wrap(err, "fetching remote")
 	}
 
-	oid, err := resolveRevision(repository, gitRevision)
+	remoteHeads, err := remote.Ls()
 	if err != nil {
+		return errors.Wrap(err, "fetching remote")
+	}
+
+	var remoteRefs []string
+	for _, head := range remoteHeads {
+		remoteRefs = append(remoteRefs, head.Name)
+	}
+
+	fetch := func(refspecs []string) error {
+		downloadTags := git2go.DownloadTagsAuto
+		if refspecs[0] == allRefsRefspec {
+			downloadTags = git2go.DownloadTagsAll
+		}
+
+		err := remote.Fetch(refspecs, &git2go.FetchOptions{
+			DownloadTags:    downloadTags,
+			RemoteCallbacks: callbacks,
+			ProxyOptions:    proxyOptions,
+		}, "")
+		return errors.Wrap(err, "fetching remote")
+	}
+
+	refspecs := fetchRefspecs(remoteRefs, gitRevision, gitBranch)
+	if err := fetch(refspecs); err != nil {
 		return err
 	}
 
-	commit, err := repository.LookupCommit(oid)
+	commit, err := lookupRevision(repository, gitRevision)
+	if err != nil && refspecs[0] != allRefsRefspec {
+		f.Logger.Printf("Revision %q not found in %s, fetching all refs...", gitRevision, strings.Join(refspecs, ", "))
+		if err := fetch([]string{allRefsRefspec}); err != nil {
+			return err
+		}
+		commit, err = lookupRevision(repository, gitRevision)
+	}
 	if err != nil {
-		return errors.Wrap(err, "looking up commit")
+		return err
 	}
+	defer commit.Free()
 
 	err = repository.SetHeadDetached(commit.Id())
 	if err != nil {
 		return errors.Wrap(err, "setting head detached")
 	}
+
+	if gitBranch == "" {
+		gitBranch = localBranch(repository, gitRevision)
+	}
+	if gitBranch != "" {
+		branch, err := repository.CreateBranch(gitBranch, commit, true)
+		if err != nil {
+			return errors.Wrapf(err, "creating branch %s", gitBranch)
+		}
+		defer branch.Free()
+
+		err = repository.SetHead(branch.Reference.Name())
+		if err != nil {
+			return errors.Wrapf(err, "setting head to branch %s", gitBranch)
+		}
+	}
+
 	err = repository.CheckoutHead(&git2go.CheckoutOpts{
 		Strategy: git2go.CheckoutForce,
 	})
@@ -68,6 +118,15 @@ func (f Fetcher) Fetch(dir, gitURL, gitRevision, metadataDir string) error {
 		return errors.Wrap(err, "checkout head")
 	}
 
+	if err := writeProjectMetadata(metadataDir, gitURL, gitRevision, gitBranch, commit.Id().String()); err != nil {
+		return err
+	}
+
+	f.Logger.Printf("Successfully cloned %q @ %q in path %q", gitURL, gitRevision, dir)
+	return nil
+}
+
+func writeProjectMetadata(metadataDir, gitURL, gitRevision, gitBranch, commit string) error {
 	projectMetadataFile, err := os.Create(path.Join(metadataDir, "project-metadata.toml"))
 	if err != nil {
 		return errors.Wrapf(err, "invalid metadata destination '%s/project-metadata.toml' for git repository: %s", metadataDir, gitURL)
@@ -80,20 +139,32 @@ func (f Fetcher) Fetch(dir, gitURL, gitRevision, metadataDir string) error {
 			Metadata: metadata{
 				Repository: gitURL,
 				Revision:   gitRevision,
+				Branch:     gitBranch,
 			},
 			Version: version{
-				Commit: commit.Id().String(),
+				Commit: commit,
 			},
 		},
 	}
 	if err := toml.NewEncoder(projectMetadataFile).Encode(projectMd); err != nil {
 		return errors.Wrapf(err, "invalid metadata destination '%s/project-metadata.toml' for git repository: %s", metadataDir, gitRevision)
 	}
-
-	f.Logger.Printf("Successfully cloned %q @ %q in path %q", gitURL, gitRevision, dir)
 	return nil
 }
 
+func lookupRevision(repository *git2go.Repository, gitRevision string) (*git2go.Commit, error) {
+	oid, err := resolveRevision(repository, gitRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := repository.LookupCommit(oid)
+	if err != nil {
+		return nil, errors.Wrap(err, "looking up commit")
+	}
+	return commit, nil
+}
+
 func resolveRevision(repository *git2go.Repository, gitRevision string) (*git2go.Oid, error) {
 	ref, err := repository.References.Dwim(gitRevision)
 	if err != nil {
@@ -103,6 +174,21 @@ func resolveRevision(repository *git2go.Repository, gitRevision string) (*git2go
 	return ref.Target(), nil
 }
 
+// localBranch is the name of the branch gitRevision refers to, if any, so
+// that fetching a branch by name does not leave a detached HEAD.
+func localBranch(repository *git2go.Repository, gitRevision string) string {
+	ref, err := repository.References.Dwim(gitRevision)
+	if err != nil {
+		return ""
+	}
+	defer ref.Free()
+
+	if !ref.IsBranch() {
+		return ""
+	}
+	return strings.TrimPrefix(ref.Name(), branchRefPrefix)
+}
+
 func resolveCommit(gitRevision string) (*git2go.Oid, error) {
 	oid, err := git2go.NewOid(gitRevision)
 	if err != nil {
@@ -124,6 +210,7 @@ type source struct {
 type metadata struct {
 	Repository string `toml:"repository"`
 	Revision   string `toml:"revision"`
+	Branch     string `toml:"branch,omitempty"`
 }
 
 type version struct {
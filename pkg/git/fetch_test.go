@@ -44,9 +44,9 @@ func testGitCheckout(t *testing.T, when spec.G, it spec.S) {
 			require.NoError(t, os.RemoveAll(metadataDir))
 		})
 
-		testFetch := func(gitUrl, revision string) func() {
+		testFetch := func(gitUrl, revision, branch, expectedBranch string) func() {
 			return func() {
-				err := fetcher.Fetch(testDir, gitUrl, revision, metadataDir)
+				err := fetcher.Fetch(testDir, gitUrl, revision, branch, metadataDir)
 				require.NoError(t, err)
 
 				repository, err := git2go.InitRepository(testDir, false)
@@ -76,31 +76,50 @@ func testGitCheckout(t *testing.T, when spec.G, it spec.S) {
 				require.NoError(t, err)
 				defer head.Free()
 				require.Equal(t, head.Target().String(), projectMetadata.Source.Version.Commit)
+
+				require.Equal(t, expectedBranch, projectMetadata.Source.Metadata.Branch)
+				detached, err := repository.IsHeadDetached()
+				require.NoError(t, err)
+				if expectedBranch == "" {
+					require.True(t, detached)
+				} else {
+					require.False(t, detached)
+					require.Equal(t, "refs/heads/"+expectedBranch, head.Name())
+				}
 			}
 		}
 
-		it("fetches remote HEAD", testFetch("https://github.com/git-fixtures/basic", "master"))
+		it("fetches remote HEAD", testFetch("https://github.com/git-fixtures/basic", "master", "", "master"))
 
-		it("fetches a branch", testFetch("https://github.com/git-fixtures/basic", "branch"))
+		it("fetches a branch", testFetch("https://github.com/git-fixtures/basic", "branch", "", "branch"))
 
-		it("fetches a tag", testFetch("https://github.com/git-fixtures/tags", "lightweight-tag"))
+		it("fetches a tag", testFetch("https://github.com/git-fixtures/tags", "lightweight-tag", "", ""))
 
-		it("fetches a revision", testFetch("https://github.com/git-fixtures/basic", "b029517f6300c2da0f4b651b8642506cd6aaf45d"))
+		it("fetches a revision", testFetch("https://github.com/git-fixtures/basic", "b029517f6300c2da0f4b651b8642506cd6aaf45d", "", ""))
+
+		it("fetches a revision on a branch", testFetch("https://github.com/git-fixtures/basic", "b029517f6300c2da0f4b651b8642506cd6aaf45d", "master", "master"))
 
 		it("returns error on non-existent ref", func() {
-			err := fetcher.Fetch(testDir, "https://github.com/git-fixtures/basic", "doesnotexist", metadataDir)
+			err := fetcher.Fetch(testDir, "https://github.com/git-fixtures/basic", "doesnotexist", "", metadataDir)
 			require.EqualError(t, err, "could not find reference: doesnotexist")
 		})
 
 		it("returns error from remote fetch when authentication required", func() {
-			err := fetcher.Fetch(testDir, "git@bitbucket.com:org/repo", "main", metadataDir)
+			fetcher.HostKeyPolicy.InsecureSkipVerify = true
+
+			err := fetcher.Fetch(testDir, "git@bitbucket.com:org/repo", "main", "", metadataDir)
 			require.EqualError(t, err, "fetching remote: no auth available")
 		})
 
+		it("returns error from remote fetch when the host key cannot be verified", func() {
+			err := fetcher.Fetch(testDir, "git@bitbucket.com:org/repo", "main", "", metadataDir)
+			require.EqualError(t, err, "fetching remote: host key for bitbucket.com could not be verified: no known_hosts entry for host")
+		})
+
 		it("uses the http proxy env vars", func() {
 			require.NoError(t, os.Setenv("HTTPS_PROXY", "http://invalid-proxy"))
 			defer os.Unsetenv("HTTPS_PROXY")
-			err := fetcher.Fetch(testDir, "https://github.com/git-fixtures/basic", "master", metadataDir)
+			err := fetcher.Fetch(testDir, "https://github.com/git-fixtures/basic", "master", "", metadataDir)
 			require.Error(t, err)
 			require.Contains(t, err.Error(), "fetching remote: failed to resolve address for invalid-proxy")
 		})
@@ -112,3 +131,7 @@ type fakeGitKeychain struct{}
 func (f fakeGitKeychain) Resolve(url string, usernameFromUrl string, allowedTypes git2go.CredentialType) (Git2GoCredential, error) {
 	return nil, errors.New("no auth available")
 }
+
+func (f fakeGitKeychain) KnownHosts() (string, error) {
+	return "", nil
+}
@@ -28,6 +28,7 @@ func keychainAsCredentialsCallback(gitKeychain GitKeychain) git2go.CredentialsCa
 
 type GitKeychain interface {
 	Resolve(url string, usernameFromUrl string, allowedTypes git2go.CredentialType) (Git2GoCredential, error)
+	KnownHosts() (string, error)
 }
 
 type BasicGit2GoAuth struct {
@@ -131,7 +132,7 @@ func NewMountedSecretGitKeychain(volumeName string, basicAuthSecrets, sshAuthSec
 			Domain:     splitSecret[1],
 			SecretName: splitSecret[0],
 			fetchSecret: func() (secret.BasicAuth, error) {
-				return secret.ReadBasicAuthSecret(volumeName, splitSecret[0])
+				return secret.ReadGitBasicAuthSecret(volumeName, splitSecret[0], annotatedHost(splitSecret[1]))
 			},
 		})
 	}
@@ -174,3 +175,24 @@ func (k *secretGitKeychain) Resolve(url string, username string, allowedTypes gi
 	}
 	return nil, errors.Errorf("no credentials found for %s", url)
 }
+
+// KnownHosts returns the known_hosts entries of all ssh secrets in the
+// keychain.
+func (k *secretGitKeychain) KnownHosts() (string, error) {
+	var knownHosts []string
+	for _, cred := range k.creds {
+		sshCred, ok := cred.(gitSshAuthCred)
+		if !ok {
+			continue
+		}
+
+		sshSecret, err := sshCred.fetchSecret()
+		if err != nil {
+			return "", err
+		}
+		if sshSecret.KnownHosts != "" {
+			knownHosts = append(knownHosts, sshSecret.KnownHosts)
+		}
+	}
+	return strings.Join(knownHosts, "\n"), nil
+}
@@ -33,6 +33,7 @@ func testGitFileKeychain(t *testing.T, when spec.G, it spec.S) {
 		require.NoError(t, os.MkdirAll(path.Join(testDir, "zzz-ssh-bitbucket-creds"), 0777))
 		require.NoError(t, os.MkdirAll(path.Join(testDir, "noscheme-creds"), 0777))
 		require.NoError(t, os.MkdirAll(path.Join(testDir, "git-ssh-creds"), 0777))
+		require.NoError(t, os.MkdirAll(path.Join(testDir, "netrc-creds"), 0777))
 
 		require.NoError(t, ioutil.WriteFile(path.Join(testDir, "github-creds", corev1.BasicAuthUsernameKey), []byte("saved-username"), 0600))
 		require.NoError(t, ioutil.WriteFile(path.Join(testDir, "github-creds", corev1.BasicAuthPasswordKey), []byte("saved-password"), 0600))
@@ -49,11 +50,18 @@ func testGitFileKeychain(t *testing.T, when spec.G, it spec.S) {
 		require.NoError(t, ioutil.WriteFile(path.Join(testDir, "noscheme-creds", corev1.BasicAuthUsernameKey), []byte("noschemegit-username"), 0600))
 		require.NoError(t, ioutil.WriteFile(path.Join(testDir, "noscheme-creds", corev1.BasicAuthPasswordKey), []byte("noschemegit-password"), 0600))
 
+		require.NoError(t, ioutil.WriteFile(path.Join(testDir, "netrc-creds", ".netrc"), []byte(`machine other.com login other-username password other-password
+machine gitlab.com
+  login netrc-username
+  password netrc-password
+`), 0600))
+
 		keychain, err = NewMountedSecretGitKeychain(testDir, []string{
 			"github-creds=https://github.com",
 			"more-github-creds=https://github.com",
 			"basic-bitbucket-creds=https://bitbucket.com",
-			"noscheme-creds=noschemegit.com"}, []string{
+			"noscheme-creds=noschemegit.com",
+			"netrc-creds=https://gitlab.com"}, []string{
 			"zzz-ssh-bitbucket-creds=https://bitbucket.com",
 			"bitbucket-creds=https://bitbucket.com",
 		})
@@ -83,6 +91,13 @@ func testGitFileKeychain(t *testing.T, when spec.G, it spec.S) {
 			require.Equal(t, BasicGit2GoAuth{Username: "noschemegit-username", Password: "noschemegit-password"}, cred)
 		})
 
+		it("returns git Auth for the matching machine of a .netrc secret", func() {
+			cred, err := keychain.Resolve("https://gitlab.com/org/repo", "", git2go.CredentialTypeUserpassPlaintext)
+			require.NoError(t, err)
+
+			require.Equal(t, BasicGit2GoAuth{Username: "netrc-username", Password: "netrc-password"}, cred)
+		})
+
 		when("there are ssh and basic auth secret types", func() {
 			it("returns ssh cred for requested ssh credentials", func() {
 				cred, err := keychain.Resolve("git@bitbucket.com:org/repo", "git", git2go.CredentialTypeSSHKey)
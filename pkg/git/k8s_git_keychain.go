@@ -3,6 +3,7 @@ package git
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -37,6 +38,17 @@ func (k *k8sGitKeychainFactory) KeychainForServiceAccount(ctx context.Context, n
 					fetchSecret: fetchBasicAuth(s),
 				})
 			}
+		case v1.SecretTypeOpaque:
+			{
+				if !secret.HasGitCredentialFile(s.Data) {
+					continue
+				}
+				creds = append(creds, gitBasicAuthCred{
+					Domain:      s.Annotations[buildapi.GITSecretAnnotationPrefix],
+					SecretName:  s.Name,
+					fetchSecret: fetchGitCredentialFile(s),
+				})
+			}
 		case v1.SecretTypeSSHAuth:
 			{
 				creds = append(creds, gitSshAuthCred{
@@ -60,9 +72,22 @@ func fetchBasicAuth(s *v1.Secret) func() (secret.BasicAuth, error) {
 	}
 }
 
+func fetchGitCredentialFile(s *v1.Secret) func() (secret.BasicAuth, error) {
+	return func() (secret.BasicAuth, error) {
+		auth, err := secret.GitBasicAuthFromData(s.Data, annotatedHost(s.Annotations[buildapi.GITSecretAnnotationPrefix]))
+		if err != nil {
+			return secret.BasicAuth{}, fmt.Errorf("%s in secret %s", err, s.Name)
+		}
+		return auth, nil
+	}
+}
+
 func fetchSshAuth(s *v1.Secret) func() (secret.SSH, error) {
 	return func() (auth secret.SSH, err error) {
-		return secret.SSH{PrivateKey: string(s.Data[v1.SSHAuthPrivateKey])}, nil
+		return secret.SSH{
+			PrivateKey: string(s.Data[v1.SSHAuthPrivateKey]),
+			KnownHosts: string(s.Data[secret.SSHKnownHostsKey]),
+		}, nil
 	}
 }
 
@@ -75,6 +100,17 @@ var matchingDomains = []string{
 	"git@%s",
 }
 
+// annotatedHost returns the host of a git secret annotation, which may be
+// prefixed with any of the matchingDomains.
+func annotatedHost(annotatedUrl string) string {
+	for _, format := range matchingDomains {
+		if prefix := strings.TrimSuffix(format, "%s"); prefix != "" && strings.HasPrefix(annotatedUrl, prefix) {
+			return strings.TrimPrefix(annotatedUrl, prefix)
+		}
+	}
+	return annotatedUrl
+}
+
 func gitUrlMatch(urlMatch, annotatedUrl string) bool {
 	for _, format := range matchingDomains {
 		if fmt.Sprintf(format, urlMatch) == annotatedUrl {
@@ -73,6 +73,7 @@ func (keys gitTest) testK8sGitKeychain(t *testing.T, when spec.G, it spec.S) {
 				Type: v1.SecretTypeSSHAuth,
 				Data: map[string][]byte{
 					v1.SSHAuthPrivateKey: keys.key1,
+					"known_hosts":        []byte("bitbucket.com ssh-ed25519 AAAA"),
 				},
 			},
 			&v1.Secret{
@@ -129,6 +130,32 @@ func (keys gitTest) testK8sGitKeychain(t *testing.T, when spec.G, it spec.S) {
 					v1.BasicAuthPasswordKey: []byte("other-password"),
 				},
 			},
+			&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret-8",
+					Namespace: testNamespace,
+					Annotations: map[string]string{
+						buildapi.GITSecretAnnotationPrefix: "https://gitea.com",
+					},
+				},
+				Type: v1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					"git-credential": []byte("protocol=https\nhost=gitea.com\nusername=gitea-username\npassword=gitea-password\n"),
+				},
+			},
+			&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret-9",
+					Namespace: testNamespace,
+					Annotations: map[string]string{
+						buildapi.GITSecretAnnotationPrefix: "https://codeberg.org",
+					},
+				},
+				Type: v1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					".netrc": []byte("machine other.org login other-username password other-password\n"),
+				},
+			},
 			&v1.ServiceAccount{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      serviceAccount,
@@ -142,6 +169,8 @@ func (keys gitTest) testK8sGitKeychain(t *testing.T, when spec.G, it spec.S) {
 					{Name: "secret-5"},
 					{Name: "secret-6"},
 					{Name: "secret-7"},
+					{Name: "secret-8"},
+					{Name: "secret-9"},
 				},
 			})
 		keychainFactory = newK8sGitKeychainFactory(fakeClient)
@@ -196,6 +225,28 @@ func (keys gitTest) testK8sGitKeychain(t *testing.T, when spec.G, it spec.S) {
 			}, cred)
 		})
 
+		it("returns git Auth for matching secrets with git credential helper output", func() {
+			cred, err := keychain.Resolve("https://gitea.com/org/repo", "", git2go.CredentialTypeUserpassPlaintext)
+			require.NoError(t, err)
+
+			require.Equal(t, BasicGit2GoAuth{
+				Username: "gitea-username",
+				Password: "gitea-password",
+			}, cred)
+		})
+
+		it("returns an error if a .netrc secret has no credentials for the host", func() {
+			_, err := keychain.Resolve("https://codeberg.org/org/repo", "", git2go.CredentialTypeUserpassPlaintext)
+			require.EqualError(t, err, "no credentials for codeberg.org in secret secret-9")
+		})
+
+		it("returns the known_hosts of ssh secrets", func() {
+			knownHosts, err := keychain.KnownHosts()
+			require.NoError(t, err)
+
+			require.Equal(t, "bitbucket.com ssh-ed25519 AAAA", knownHosts)
+		})
+
 		it("returns an error if no credentials found", func() {
 			_, err := keychain.Resolve("https://no-creds-github.com/org/repo", "git", git2go.CredentialTypeUserpassPlaintext)
 			require.EqualError(t, err, "no credentials found for https://no-creds-github.com/org/repo")
@@ -0,0 +1,161 @@
+package git
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net"
+	"path"
+	"strings"
+
+	git2go "github.com/libgit2/git2go/v33"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// HostKeyPolicy determines how the host keys of git remotes accessed over ssh
+// are verified. Unless InsecureSkipVerify is set, a host key must match an
+// entry for the host in KnownHosts or in the known_hosts of the keychain's
+// ssh secrets.
+type HostKeyPolicy struct {
+	KnownHosts         string
+	InsecureSkipVerify bool
+}
+
+func (p HostKeyPolicy) verify(keychain GitKeychain, hostname string, hostkey git2go.HostkeyCertificate) error {
+	if p.InsecureSkipVerify {
+		return nil
+	}
+
+	secretKnownHosts, err := keychain.KnownHosts()
+	if err != nil {
+		return err
+	}
+
+	trusted, revoked, err := knownHostKeys(p.KnownHosts+"\n"+secretKnownHosts, hostname)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range revoked {
+		if hostKeyMatches(hostkey, key) {
+			return errors.Errorf("host key for %s is revoked", hostname)
+		}
+	}
+
+	if len(trusted) == 0 {
+		return errors.Errorf("host key for %s could not be verified: no known_hosts entry for host", hostname)
+	}
+
+	for _, key := range trusted {
+		if hostKeyMatches(hostkey, key) {
+			return nil
+		}
+	}
+	return errors.Errorf("host key for %s does not match known_hosts", hostname)
+}
+
+// knownHostKeys returns the trusted and revoked keys for hostname in a
+// known_hosts file. Certificate authorities are not supported and skipped.
+func knownHostKeys(knownHosts, hostname string) ([]ssh.PublicKey, []ssh.PublicKey, error) {
+	var trusted, revoked []ssh.PublicKey
+
+	rest := []byte(knownHosts)
+	for {
+		marker, hosts, key, _, next, err := ssh.ParseKnownHosts(rest)
+		if err == io.EOF {
+			return trusted, revoked, nil
+		} else if err != nil {
+			return nil, nil, errors.Wrap(err, "parsing known_hosts")
+		}
+		rest = next
+
+		if !hostPatternsMatch(hosts, hostname) {
+			continue
+		}
+
+		switch marker {
+		case "":
+			trusted = append(trusted, key)
+		case "revoked":
+			revoked = append(revoked, key)
+		}
+	}
+}
+
+func hostPatternsMatch(patterns []string, hostname string) bool {
+	matched := false
+	for _, pattern := range patterns {
+		negated := strings.HasPrefix(pattern, "!")
+		if !hostPatternMatches(strings.TrimPrefix(pattern, "!"), hostname) {
+			continue
+		}
+		if negated {
+			return false
+		}
+		matched = true
+	}
+	return matched
+}
+
+// hostPatternMatches matches a single known_hosts host pattern. The ssh port
+// of the remote is not available when verifying, so a port in the pattern is
+// ignored.
+func hostPatternMatches(pattern, hostname string) bool {
+	if strings.HasPrefix(pattern, "|1|") {
+		return hashedHostMatches(pattern, hostname) || hashedHostMatches(pattern, "["+hostname+"]:22")
+	}
+
+	if strings.HasPrefix(pattern, "[") {
+		if host, _, err := net.SplitHostPort(pattern); err == nil {
+			pattern = host
+		}
+	}
+
+	matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(hostname))
+	return matched
+}
+
+func hashedHostMatches(pattern, hostname string) bool {
+	parts := strings.Split(strings.TrimPrefix(pattern, "|1|"), "|")
+	if len(parts) != 2 {
+		return false
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	hash, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha1.New, salt)
+	mac.Write([]byte(hostname))
+	return hmac.Equal(mac.Sum(nil), hash)
+}
+
+// hostKeyMatches compares the host key presented by libgit2 against a known
+// key, using the raw key when available and otherwise the strongest hash.
+func hostKeyMatches(hostkey git2go.HostkeyCertificate, key ssh.PublicKey) bool {
+	if hostkey.SSHPublicKey != nil {
+		return bytes.Equal(hostkey.SSHPublicKey.Marshal(), key.Marshal())
+	}
+
+	wire := key.Marshal()
+	switch {
+	case !isByteArrayEmpty(hostkey.HashSHA256[:]):
+		return sha256.Sum256(wire) == hostkey.HashSHA256
+	case !isByteArrayEmpty(hostkey.HashSHA1[:]):
+		return sha1.Sum(wire) == hostkey.HashSHA1
+	case !isByteArrayEmpty(hostkey.HashMD5[:]):
+		return md5.Sum(wire) == hostkey.HashMD5
+	default:
+		return false
+	}
+}
@@ -0,0 +1,125 @@
+package git
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	git2go "github.com/libgit2/git2go/v33"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestKnownHosts(t *testing.T) {
+	spec.Run(t, "Test Known Hosts", testKnownHosts)
+}
+
+func testKnownHosts(t *testing.T, when spec.G, it spec.S) {
+	var (
+		hostKey  = generateHostKey(t)
+		otherKey = generateHostKey(t)
+		keychain = knownHostsKeychain{}
+		policy   HostKeyPolicy
+	)
+
+	when("#verify", func() {
+		it("accepts a host key in the known_hosts", func() {
+			policy.KnownHosts = knownHostsLine("github.com", hostKey)
+
+			require.NoError(t, policy.verify(keychain, "github.com", git2go.HostkeyCertificate{SSHPublicKey: hostKey}))
+		})
+
+		it("accepts a host key matching by hash", func() {
+			policy.KnownHosts = knownHostsLine("github.com", hostKey)
+
+			require.NoError(t, policy.verify(keychain, "github.com", git2go.HostkeyCertificate{HashSHA256: sha256.Sum256(hostKey.Marshal())}))
+		})
+
+		it("accepts a host key in the known_hosts of the keychain", func() {
+			keychain.knownHosts = knownHostsLine("gitlab.com", hostKey)
+			policy.KnownHosts = knownHostsLine("github.com", otherKey)
+
+			require.NoError(t, policy.verify(keychain, "gitlab.com", git2go.HostkeyCertificate{SSHPublicKey: hostKey}))
+		})
+
+		it("matches hashed, wildcard and port host patterns", func() {
+			for _, pattern := range []string{hashedHost(t, "github.com"), "*.com", "[github.com]:2222", "gitlab.com,github.com"} {
+				policy.KnownHosts = knownHostsLine(pattern, hostKey)
+
+				require.NoError(t, policy.verify(keychain, "github.com", git2go.HostkeyCertificate{SSHPublicKey: hostKey}), pattern)
+			}
+		})
+
+		it("rejects a host key that does not match the known_hosts", func() {
+			policy.KnownHosts = knownHostsLine("github.com", otherKey)
+
+			err := policy.verify(keychain, "github.com", git2go.HostkeyCertificate{SSHPublicKey: hostKey})
+			require.EqualError(t, err, "host key for github.com does not match known_hosts")
+		})
+
+		it("rejects a host without a known_hosts entry", func() {
+			policy.KnownHosts = knownHostsLine("gitlab.com", hostKey)
+
+			err := policy.verify(keychain, "github.com", git2go.HostkeyCertificate{SSHPublicKey: hostKey})
+			require.EqualError(t, err, "host key for github.com could not be verified: no known_hosts entry for host")
+		})
+
+		it("rejects a host excluded by a negated pattern", func() {
+			policy.KnownHosts = knownHostsLine("*.com,!github.com", hostKey)
+
+			err := policy.verify(keychain, "github.com", git2go.HostkeyCertificate{SSHPublicKey: hostKey})
+			require.EqualError(t, err, "host key for github.com could not be verified: no known_hosts entry for host")
+		})
+
+		it("rejects a revoked host key", func() {
+			policy.KnownHosts = knownHostsLine("github.com", hostKey) + "\n@revoked " + knownHostsLine("*", hostKey)
+
+			err := policy.verify(keychain, "github.com", git2go.HostkeyCertificate{SSHPublicKey: hostKey})
+			require.EqualError(t, err, "host key for github.com is revoked")
+		})
+
+		it("accepts any host key when verification is skipped", func() {
+			policy.InsecureSkipVerify = true
+
+			require.NoError(t, policy.verify(keychain, "github.com", git2go.HostkeyCertificate{SSHPublicKey: hostKey}))
+		})
+	})
+}
+
+type knownHostsKeychain struct {
+	fakeGitKeychain
+	knownHosts string
+}
+
+func (k knownHostsKeychain) KnownHosts() (string, error) {
+	return k.knownHosts, nil
+}
+
+func generateHostKey(t *testing.T) ssh.PublicKey {
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := ssh.NewPublicKey(public)
+	require.NoError(t, err)
+	return key
+}
+
+func knownHostsLine(hosts string, key ssh.PublicKey) string {
+	return fmt.Sprintf("%s %s %s", hosts, key.Type(), base64.StdEncoding.EncodeToString(key.Marshal()))
+}
+
+func hashedHost(t *testing.T, host string) string {
+	salt := make([]byte, sha1.Size)
+	_, err := rand.Read(salt)
+	require.NoError(t, err)
+
+	mac := hmac.New(sha1.New, salt)
+	mac.Write([]byte(host))
+	return fmt.Sprintf("|1|%s|%s", base64.StdEncoding.EncodeToString(salt), base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+}
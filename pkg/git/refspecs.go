@@ -0,0 +1,35 @@
+package git
+
+import (
+	"fmt"
+)
+
+const allRefsRefspec = "+refs/*:refs/*"
+
+// fetchRefspecs narrows a fetch to the remote reference matching gitRevision,
+// or to the branch a resolved commit belongs to, so that busy repositories
+// with many branches and pull request refs are not fetched in full. All refs
+// are fetched when the revision cannot be matched to a remote reference.
+func fetchRefspecs(remoteRefs []string, gitRevision, gitBranch string) []string {
+	for _, format := range refRevParseRules {
+		for _, ref := range remoteRefs {
+			if fmt.Sprintf(format, gitRevision) == ref {
+				return []string{refspec(ref)}
+			}
+		}
+	}
+
+	if gitBranch != "" {
+		for _, ref := range remoteRefs {
+			if ref == branchRefPrefix+gitBranch {
+				return []string{refspec(ref)}
+			}
+		}
+	}
+
+	return []string{allRefsRefspec}
+}
+
+func refspec(ref string) string {
+	return fmt.Sprintf("+%s:%s", ref, ref)
+}
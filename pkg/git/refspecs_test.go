@@ -0,0 +1,51 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchRefspecs(t *testing.T) {
+	spec.Run(t, "Fetch Refspecs", testFetchRefspecs)
+}
+
+func testFetchRefspecs(t *testing.T, when spec.G, it spec.S) {
+	remoteRefs := []string{
+		"HEAD",
+		"refs/heads/main",
+		"refs/heads/release",
+		"refs/pull/1/head",
+		"refs/tags/main",
+		"refs/tags/v1.0.0",
+	}
+
+	when("#fetchRefspecs", func() {
+		it("fetches only the branch matching the revision", func() {
+			require.Equal(t, []string{"+refs/heads/release:refs/heads/release"}, fetchRefspecs(remoteRefs, "release", ""))
+		})
+
+		it("fetches only the tag matching the revision", func() {
+			require.Equal(t, []string{"+refs/tags/v1.0.0:refs/tags/v1.0.0"}, fetchRefspecs(remoteRefs, "v1.0.0", ""))
+		})
+
+		it("prefers tags over branches like git rev-parse", func() {
+			require.Equal(t, []string{"+refs/tags/main:refs/tags/main"}, fetchRefspecs(remoteRefs, "main", ""))
+		})
+
+		it("fetches a fully qualified ref", func() {
+			require.Equal(t, []string{"+refs/pull/1/head:refs/pull/1/head"}, fetchRefspecs(remoteRefs, "refs/pull/1/head", ""))
+		})
+
+		it("fetches the branch of a resolved commit", func() {
+			require.Equal(t, []string{"+refs/heads/main:refs/heads/main"}, fetchRefspecs(remoteRefs, "b029517f6300c2da0f4b651b8642506cd6aaf45d", "main"))
+		})
+
+		it("fetches all refs when the revision does not match a remote ref", func() {
+			require.Equal(t, []string{"+refs/*:refs/*"}, fetchRefspecs(remoteRefs, "b029517f6300c2da0f4b651b8642506cd6aaf45d", ""))
+			require.Equal(t, []string{"+refs/*:refs/*"}, fetchRefspecs(remoteRefs, "b029517f6300c2da0f4b651b8642506cd6aaf45d", "deleted"))
+			require.Equal(t, []string{"+refs/*:refs/*"}, fetchRefspecs(remoteRefs, "HEAD", ""))
+		})
+	})
+}
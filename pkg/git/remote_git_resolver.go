@@ -20,7 +20,7 @@ var discardLogger = log.New(ioutil.Discard, "", 0)
 type remoteGitResolver struct {
 }
 
-func (*remoteGitResolver) Resolve(keychain GitKeychain, sourceConfig corev1alpha1.SourceConfig) (corev1alpha1.ResolvedSourceConfig, error) {
+func (*remoteGitResolver) Resolve(keychain GitKeychain, hostKeyPolicy HostKeyPolicy, sourceConfig corev1alpha1.SourceConfig) (corev1alpha1.ResolvedSourceConfig, error) {
 	dir, err := ioutil.TempDir("", "git-resolve")
 	if err != nil {
 		return corev1alpha1.ResolvedSourceConfig{}, err
@@ -45,16 +45,19 @@ func (*remoteGitResolver) Resolve(keychain GitKeychain, sourceConfig corev1alpha
 	err = remote.ConnectFetch(
 		&git2go.RemoteCallbacks{
 			CredentialsCallback:      keychainAsCredentialsCallback(keychain),
-			CertificateCheckCallback: certificateCheckCallback(),
+			CertificateCheckCallback: certificateCheckCallback(keychain, hostKeyPolicy),
 		},
 		&git2go.ProxyOptions{Type: git2go.ProxyTypeAuto}, nil)
 	if err != nil {
 		return corev1alpha1.ResolvedSourceConfig{
 			Git: &corev1alpha1.ResolvedGitSource{
-				URL:      sourceConfig.Git.URL,
-				Revision: sourceConfig.Git.Revision,
-				Type:     corev1alpha1.Unknown,
-				SubPath:  sourceConfig.SubPath,
+				URL:                 sourceConfig.Git.URL,
+				Revision:            sourceConfig.Git.Revision,
+				Branch:              sourceConfig.Git.Branch,
+				Type:                corev1alpha1.Unknown,
+				SubPath:             sourceConfig.SubPath,
+				CompatibilityMode:   sourceConfig.Git.CompatibilityMode,
+				DereferenceSymlinks: sourceConfig.DereferenceSymlinks,
 			},
 		}, nil
 	}
@@ -69,10 +72,13 @@ func (*remoteGitResolver) Resolve(keychain GitKeychain, sourceConfig corev1alpha
 			if fmt.Sprintf(format, sourceConfig.Git.Revision) == ref.Name {
 				return corev1alpha1.ResolvedSourceConfig{
 					Git: &corev1alpha1.ResolvedGitSource{
-						URL:      sourceConfig.Git.URL,
-						Revision: ref.Id.String(),
-						Type:     sourceType(ref),
-						SubPath:  sourceConfig.SubPath,
+						URL:                 sourceConfig.Git.URL,
+						Revision:            ref.Id.String(),
+						Branch:              branchName(ref, sourceConfig.Git.Branch),
+						Type:                sourceType(ref),
+						SubPath:             sourceConfig.SubPath,
+						CompatibilityMode:   sourceConfig.Git.CompatibilityMode,
+						DereferenceSymlinks: sourceConfig.DereferenceSymlinks,
 					},
 				}, nil
 			}
@@ -81,10 +87,13 @@ func (*remoteGitResolver) Resolve(keychain GitKeychain, sourceConfig corev1alpha
 
 	return corev1alpha1.ResolvedSourceConfig{
 		Git: &corev1alpha1.ResolvedGitSource{
-			URL:      sourceConfig.Git.URL,
-			Revision: sourceConfig.Git.Revision,
-			Type:     corev1alpha1.Commit,
-			SubPath:  sourceConfig.SubPath,
+			URL:                 sourceConfig.Git.URL,
+			Revision:            sourceConfig.Git.Revision,
+			Branch:              sourceConfig.Git.Branch,
+			Type:                corev1alpha1.Commit,
+			SubPath:             sourceConfig.SubPath,
+			CompatibilityMode:   sourceConfig.Git.CompatibilityMode,
+			DereferenceSymlinks: sourceConfig.DereferenceSymlinks,
 		},
 	}, nil
 }
@@ -100,6 +109,17 @@ func sourceType(reference git2go.RemoteHead) corev1alpha1.GitSourceKind {
 	}
 }
 
+// branchName is the branch a reference points into, falling back to the
+// configured branch for tags and other references.
+func branchName(reference git2go.RemoteHead, configured string) string {
+	if strings.HasPrefix(reference.Name, branchRefPrefix) {
+		return strings.TrimPrefix(reference.Name, branchRefPrefix)
+	}
+	return configured
+}
+
+const branchRefPrefix = "refs/heads/"
+
 var refRevParseRules = []string{
 	"refs/%s",
 	"refs/tags/%s",
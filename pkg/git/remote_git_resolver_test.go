@@ -28,7 +28,7 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 			it("returns type commit", func() {
 				gitResolver := &remoteGitResolver{}
 
-				resolvedGitSource, err := gitResolver.Resolve(&fakeGitKeychain{}, corev1alpha1.SourceConfig{
+				resolvedGitSource, err := gitResolver.Resolve(&fakeGitKeychain{}, HostKeyPolicy{}, corev1alpha1.SourceConfig{
 					Git: &corev1alpha1.Git{
 						URL:      url,
 						Revision: nonHEADCommit,
@@ -52,7 +52,7 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 			it("returns branch with resolved commit", func() {
 				gitResolver := &remoteGitResolver{}
 
-				resolvedGitSource, err := gitResolver.Resolve(&fakeGitKeychain{}, corev1alpha1.SourceConfig{
+				resolvedGitSource, err := gitResolver.Resolve(&fakeGitKeychain{}, HostKeyPolicy{}, corev1alpha1.SourceConfig{
 					Git: &corev1alpha1.Git{
 						URL:      url,
 						Revision: "master",
@@ -65,6 +65,7 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 					Git: &corev1alpha1.ResolvedGitSource{
 						URL:      url,
 						Revision: fixtureHEADMasterCommit,
+						Branch:   "master",
 						Type:     corev1alpha1.Branch,
 						SubPath:  "/foo/bar",
 					},
@@ -78,7 +79,7 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 
 				gitResolver := &remoteGitResolver{}
 
-				resolvedGitSource, err := gitResolver.Resolve(&fakeGitKeychain{}, corev1alpha1.SourceConfig{
+				resolvedGitSource, err := gitResolver.Resolve(&fakeGitKeychain{}, HostKeyPolicy{}, corev1alpha1.SourceConfig{
 					Git: &corev1alpha1.Git{
 						URL:      tagsUrl,
 						Revision: tag,
@@ -102,7 +103,7 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 			it("returns an unknown type", func() {
 				gitResolver := &remoteGitResolver{}
 
-				resolvedGitSource, err := gitResolver.Resolve(&fakeGitKeychain{}, corev1alpha1.SourceConfig{
+				resolvedGitSource, err := gitResolver.Resolve(&fakeGitKeychain{}, HostKeyPolicy{}, corev1alpha1.SourceConfig{
 					Git: &corev1alpha1.Git{
 						URL:      "git@localhost:org/repo",
 						Revision: tag,
@@ -7,17 +7,22 @@ import (
 
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/config"
 )
 
 type Resolver struct {
 	remoteGitResolver remoteGitResolver
 	gitKeychain       *k8sGitKeychainFactory
+	k8sClient         k8sclient.Interface
+	systemNamespace   string
 }
 
-func NewResolver(k8sClient k8sclient.Interface) *Resolver {
+func NewResolver(k8sClient k8sclient.Interface, systemNamespace string) *Resolver {
 	return &Resolver{
 		remoteGitResolver: remoteGitResolver{},
 		gitKeychain:       newK8sGitKeychainFactory(k8sClient),
+		k8sClient:         k8sClient,
+		systemNamespace:   systemNamespace,
 	}
 }
 
@@ -27,7 +32,15 @@ func (r *Resolver) Resolve(ctx context.Context, sourceResolver *buildapi.SourceR
 		return corev1alpha1.ResolvedSourceConfig{}, err
 	}
 
-	return r.remoteGitResolver.Resolve(keychain, sourceResolver.Spec.Source)
+	hostKeys, err := config.FetchGitHostKeys(ctx, r.k8sClient, r.systemNamespace)
+	if err != nil {
+		return corev1alpha1.ResolvedSourceConfig{}, err
+	}
+
+	return r.remoteGitResolver.Resolve(keychain, HostKeyPolicy{
+		KnownHosts:         hostKeys.KnownHosts,
+		InsecureSkipVerify: hostKeys.InsecureSkipVerify,
+	}, sourceResolver.Spec.Source)
 }
 
 func (*Resolver) CanResolve(sourceResolver *buildapi.SourceResolver) bool {
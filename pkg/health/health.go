@@ -0,0 +1,139 @@
+package health
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const (
+	DefaultPort  = 8081
+	checkTimeout = 5 * time.Second
+)
+
+// Check reports an error if the dependency it verifies is not available.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker serves /healthz and /readyz. /healthz only reports that the
+// process is serving requests while /readyz runs every registered
+// readiness check.
+type Checker struct {
+	mu     sync.RWMutex
+	checks []namedCheck
+}
+
+func (c *Checker) AddReadinessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+func (c *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "ok")
+	})
+	mux.HandleFunc("/readyz", c.serveReadyz)
+	return mux
+}
+
+func (c *Checker) NewServer(port int) *http.Server {
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           c.Handler(),
+		ReadHeaderTimeout: checkTimeout,
+	}
+}
+
+func (c *Checker) serveReadyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+	defer cancel()
+
+	c.mu.RLock()
+	checks := append([]namedCheck(nil), c.checks...)
+	c.mu.RUnlock()
+
+	var (
+		body   strings.Builder
+		failed bool
+	)
+	for _, nc := range checks {
+		if err := nc.check(ctx); err != nil {
+			failed = true
+			fmt.Fprintf(&body, "[-]%s failed: %s\n", nc.name, err)
+			continue
+		}
+		fmt.Fprintf(&body, "[+]%s ok\n", nc.name)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if failed {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	fmt.Fprint(w, body.String())
+}
+
+// InformersSynced fails until every informer has completed its initial list.
+func InformersSynced(informers ...cache.SharedIndexInformer) Check {
+	return func(context.Context) error {
+		for _, informer := range informers {
+			if !informer.HasSynced() {
+				return errors.New("informer caches are not synced")
+			}
+		}
+		return nil
+	}
+}
+
+// KeychainAvailable fails if a keychain cannot be created for the secret
+// ref, which requires the service account and its secrets to be readable.
+func KeychainAvailable(keychainFactory registry.KeychainFactory, ref registry.SecretRef) Check {
+	return func(ctx context.Context) error {
+		_, err := keychainFactory.KeychainForSecretRef(ctx, ref)
+		return err
+	}
+}
+
+// CertificateValid fails if the webhook serving certificate in the secret is
+// missing, cannot be parsed or has expired.
+func CertificateValid(client k8sclient.Interface, namespace, name string, now func() time.Time) Check {
+	return func(ctx context.Context) error {
+		secret, err := client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		block, _ := pem.Decode(secret.Data[certresources.ServerCert])
+		if block == nil {
+			return errors.Errorf("secret %s/%s does not contain a server certificate", namespace, name)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return errors.Wrap(err, "invalid server certificate")
+		}
+
+		if t := now(); t.Before(cert.NotBefore) || t.After(cert.NotAfter) {
+			return errors.Errorf("server certificate is only valid from %s to %s", cert.NotBefore.Format(time.RFC3339), cert.NotAfter.Format(time.RFC3339))
+		}
+		return nil
+	}
+}
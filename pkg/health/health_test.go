@@ -0,0 +1,133 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	"github.com/pivotal/kpack/pkg/health"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestHealth(t *testing.T) {
+	spec.Run(t, "Health", testHealth)
+}
+
+func testHealth(t *testing.T, when spec.G, it spec.S) {
+	var checker = &health.Checker{}
+
+	it.Before(func() {
+		checker = &health.Checker{}
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		checker.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	when("/healthz", func() {
+		it("is ok even if readiness checks fail", func() {
+			checker.AddReadinessCheck("failing", func(context.Context) error { return errors.New("down") })
+
+			recorder := get("/healthz")
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, "ok", recorder.Body.String())
+		})
+	})
+
+	when("/readyz", func() {
+		it("is ok when all checks pass", func() {
+			checker.AddReadinessCheck("first", func(context.Context) error { return nil })
+			checker.AddReadinessCheck("second", func(context.Context) error { return nil })
+
+			recorder := get("/readyz")
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, "[+]first ok\n[+]second ok\n", recorder.Body.String())
+		})
+
+		it("is unavailable and reports every failing check", func() {
+			checker.AddReadinessCheck("first", func(context.Context) error { return errors.New("down") })
+			checker.AddReadinessCheck("second", func(context.Context) error { return nil })
+
+			recorder := get("/readyz")
+			assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			assert.Equal(t, "[-]first failed: down\n[+]second ok\n", recorder.Body.String())
+		})
+	})
+
+	when("KeychainAvailable", func() {
+		ref := registry.SecretRef{ServiceAccount: "controller", Namespace: "kpack"}
+
+		it("passes when a keychain can be created", func() {
+			keychainFactory := &registryfakes.FakeKeychainFactory{}
+			keychainFactory.AddKeychainForSecretRef(t, ref, &registryfakes.FakeKeychain{})
+
+			assert.NoError(t, health.KeychainAvailable(keychainFactory, ref)(context.Background()))
+		})
+
+		it("fails when a keychain cannot be created", func() {
+			keychainFactory := &registryfakes.FakeKeychainFactory{}
+
+			assert.Error(t, health.KeychainAvailable(keychainFactory, ref)(context.Background()))
+		})
+	})
+
+	when("CertificateValid", func() {
+		const (
+			namespace  = "kpack"
+			secretName = "webhook-certs"
+		)
+		now := time.Now()
+
+		certSecret := func(notAfter time.Time) *corev1.Secret {
+			_, serverCert, _, err := certresources.CreateCerts(context.Background(), "kpack-webhook", namespace, notAfter)
+			require.NoError(t, err)
+
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+				Data:       map[string][]byte{certresources.ServerCert: serverCert},
+			}
+		}
+
+		it("passes for an unexpired certificate", func() {
+			client := fake.NewSimpleClientset(certSecret(now.Add(time.Hour)))
+
+			check := health.CertificateValid(client, namespace, secretName, func() time.Time { return now })
+			assert.NoError(t, check(context.Background()))
+		})
+
+		it("fails for an expired certificate", func() {
+			client := fake.NewSimpleClientset(certSecret(now.Add(time.Hour)))
+
+			check := health.CertificateValid(client, namespace, secretName, func() time.Time { return now.Add(2 * time.Hour) })
+			assert.ErrorContains(t, check(context.Background()), "server certificate is only valid from")
+		})
+
+		it("fails when the secret has no certificate", func() {
+			client := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+			})
+
+			check := health.CertificateValid(client, namespace, secretName, time.Now)
+			assert.EqualError(t, check(context.Background()), "secret kpack/webhook-certs does not contain a server certificate")
+		})
+
+		it("fails when the secret does not exist", func() {
+			check := health.CertificateValid(fake.NewSimpleClientset(), namespace, secretName, time.Now)
+			assert.Error(t, check(context.Background()))
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package loadtest
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+)
+
+// FakeRegistry is an in-memory registry that built images can be exported to
+// without load on a real registry.
+func FakeRegistry() http.Handler {
+	return ggcrregistry.New(ggcrregistry.Logger(log.New(ioutil.Discard, "", 0)))
+}
+
+// FakeSourceServer serves a small application as a tar.gz blob source.
+func FakeSourceServer() (http.Handler, error) {
+	blob, err := sourceBlob()
+	if err != nil {
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-gzip")
+		http.ServeContent(w, r, "source.tar.gz", time.Time{}, bytes.NewReader(blob))
+	}), nil
+}
+
+func sourceBlob() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+
+	for name, contents := range map[string]string{
+		"index.html": "<html><body>kpack load test</body></html>\n",
+		"Procfile":   "web: ruby -run -e httpd . -p ${PORT:-8080}\n",
+	} {
+		if err := tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0644,
+			Size: int64(len(contents)),
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write([]byte(contents)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
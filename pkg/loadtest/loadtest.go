@@ -0,0 +1,162 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+)
+
+const RunLabel = "loadtest.kpack.io/run"
+
+type Config struct {
+	// RunID identifies the Images and Builds created by a run.
+	RunID     string
+	Namespace string
+	Images    int
+	// Repository is the repository the image tags are created in, one
+	// image per Image resource.
+	Repository string
+	// Spec is the template of every Image. The tag is set per Image.
+	Spec buildapi.ImageSpec
+	// WaitForCompletion waits for the first Build of every Image to finish
+	// instead of only waiting for it to be scheduled.
+	WaitForCompletion bool
+	PollInterval      time.Duration
+	Timeout           time.Duration
+}
+
+// Runner creates synthetic Images and measures how quickly the controller
+// reconciles them.
+type Runner struct {
+	Client versioned.Interface
+	Config Config
+}
+
+type imageTimes struct {
+	created   time.Time
+	scheduled time.Time
+	completed time.Time
+	failed    bool
+}
+
+func (r *Runner) Run(ctx context.Context) (Report, error) {
+	start := time.Now()
+	times := make(map[string]*imageTimes, r.Config.Images)
+
+	for i := 0; i < r.Config.Images; i++ {
+		image := r.image(i)
+		_, err := r.Client.KpackV1alpha2().Images(r.Config.Namespace).Create(ctx, image, metav1.CreateOptions{})
+		if err != nil {
+			return Report{}, errors.Wrapf(err, "creating image %s", image.Name)
+		}
+		times[image.Name] = &imageTimes{created: time.Now()}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.Config.Timeout)
+	defer cancel()
+
+	err := wait.PollImmediateUntil(r.Config.PollInterval, func() (bool, error) {
+		return r.observe(ctx, times)
+	}, ctx.Done())
+	if err != nil && ctx.Err() == nil {
+		return Report{}, err
+	}
+
+	return r.report(start, times), nil
+}
+
+// Cleanup deletes the Images of the run. Their Builds are garbage collected.
+func (r *Runner) Cleanup(ctx context.Context) error {
+	return r.Client.KpackV1alpha2().Images(r.Config.Namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, r.listOptions())
+}
+
+func (r *Runner) image(i int) *buildapi.Image {
+	name := fmt.Sprintf("loadtest-%s-%d", r.Config.RunID, i)
+
+	spec := *r.Config.Spec.DeepCopy()
+	spec.Tag = fmt.Sprintf("%s/%s", r.Config.Repository, name)
+
+	return &buildapi.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: r.Config.Namespace,
+			Labels: map[string]string{
+				RunLabel: r.Config.RunID,
+			},
+		},
+		Spec: spec,
+	}
+}
+
+func (r *Runner) listOptions() metav1.ListOptions {
+	return metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", RunLabel, r.Config.RunID)}
+}
+
+func (r *Runner) observe(ctx context.Context, times map[string]*imageTimes) (bool, error) {
+	builds, err := r.Client.KpackV1alpha2().Builds(r.Config.Namespace).List(ctx, r.listOptions())
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	for i := range builds.Items {
+		build := &builds.Items[i]
+
+		t, ok := times[build.Labels[buildapi.ImageLabel]]
+		if !ok {
+			continue
+		}
+
+		if t.scheduled.IsZero() {
+			t.scheduled = now
+		}
+
+		if t.completed.IsZero() && (build.IsSuccess() || build.IsFailure()) {
+			t.completed = now
+			t.failed = build.IsFailure()
+		}
+	}
+
+	for _, t := range times {
+		if t.scheduled.IsZero() || (r.Config.WaitForCompletion && t.completed.IsZero()) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (r *Runner) report(start time.Time, times map[string]*imageTimes) Report {
+	report := Report{
+		Images:   len(times),
+		Duration: time.Now().Sub(start),
+	}
+
+	var scheduleLatencies, buildLatencies []time.Duration
+	for _, t := range times {
+		if t.scheduled.IsZero() {
+			continue
+		}
+		report.Scheduled++
+		scheduleLatencies = append(scheduleLatencies, t.scheduled.Sub(t.created))
+
+		if t.completed.IsZero() {
+			continue
+		}
+		report.Completed++
+		if t.failed {
+			report.Failed++
+		}
+		buildLatencies = append(buildLatencies, t.completed.Sub(t.scheduled))
+	}
+
+	report.ScheduleLatency = summarize(scheduleLatencies)
+	report.BuildLatency = summarize(buildLatencies)
+	return report
+}
@@ -0,0 +1,161 @@
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+)
+
+func TestLoadTest(t *testing.T) {
+	spec.Run(t, "LoadTest", testLoadTest)
+}
+
+func testLoadTest(t *testing.T, when spec.G, it spec.S) {
+	const namespace = "some-namespace"
+
+	var (
+		client = fake.NewSimpleClientset()
+		runner = &Runner{
+			Client: client,
+			Config: Config{
+				RunID:      "some-run",
+				Namespace:  namespace,
+				Images:     5,
+				Repository: "registry.example.com/loadtest",
+				Spec: buildapi.ImageSpec{
+					Builder: corev1.ObjectReference{
+						Kind: buildapi.ClusterBuilderKind,
+						Name: "some-builder",
+					},
+				},
+				WaitForCompletion: true,
+				PollInterval:      time.Millisecond,
+				Timeout:           5 * time.Second,
+			},
+		}
+	)
+
+	// simulateController creates a Build for every Image and completes it on
+	// the next pass, the way the image and build reconcilers would.
+	simulateController := func(ctx context.Context) {
+		for ctx.Err() == nil {
+			images, err := client.KpackV1alpha2().Images(namespace).List(ctx, metav1.ListOptions{})
+			require.NoError(t, err)
+
+			for _, image := range images.Items {
+				build, err := client.KpackV1alpha2().Builds(namespace).Get(ctx, image.Name+"-build-1", metav1.GetOptions{})
+				if err != nil {
+					_, err = client.KpackV1alpha2().Builds(namespace).Create(ctx, &buildapi.Build{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      image.Name + "-build-1",
+							Namespace: namespace,
+							Labels: map[string]string{
+								RunLabel:            image.Labels[RunLabel],
+								buildapi.ImageLabel: image.Name,
+							},
+						},
+					}, metav1.CreateOptions{})
+					require.NoError(t, err)
+					continue
+				}
+
+				build.Status.Conditions = corev1alpha1.Conditions{{
+					Type:   corev1alpha1.ConditionSucceeded,
+					Status: corev1.ConditionTrue,
+				}}
+				_, err = client.KpackV1alpha2().Builds(namespace).UpdateStatus(ctx, build, metav1.UpdateOptions{})
+				require.NoError(t, err)
+			}
+
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	when("#Run", func() {
+		it("creates labeled images from the spec template", func() {
+			runner.Config.Timeout = 10 * time.Millisecond
+
+			_, err := runner.Run(context.TODO())
+			require.NoError(t, err)
+
+			images, err := client.KpackV1alpha2().Images(namespace).List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			require.Len(t, images.Items, 5)
+
+			image := images.Items[0]
+			assert.Equal(t, "loadtest-some-run-0", image.Name)
+			assert.Equal(t, "some-run", image.Labels[RunLabel])
+			assert.Equal(t, "registry.example.com/loadtest/loadtest-some-run-0", image.Spec.Tag)
+			assert.Equal(t, "some-builder", image.Spec.Builder.Name)
+		})
+
+		it("reports when every image has been built", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			go simulateController(ctx)
+
+			report, err := runner.Run(context.TODO())
+			require.NoError(t, err)
+
+			assert.Equal(t, 5, report.Images)
+			assert.Equal(t, 5, report.Scheduled)
+			assert.Equal(t, 5, report.Completed)
+			assert.Equal(t, 0, report.Failed)
+			assert.Less(t, report.Duration, runner.Config.Timeout)
+			assert.Greater(t, report.Throughput(), 0.0)
+			assert.LessOrEqual(t, report.ScheduleLatency.Min, report.ScheduleLatency.Max)
+		})
+
+		it("reports partial results when the timeout is reached", func() {
+			runner.Config.Timeout = 10 * time.Millisecond
+
+			report, err := runner.Run(context.TODO())
+			require.NoError(t, err)
+
+			assert.Equal(t, 5, report.Images)
+			assert.Equal(t, 0, report.Scheduled)
+			assert.Equal(t, LatencySummary{}, report.ScheduleLatency)
+		})
+	})
+
+	when("Report", func() {
+		it("summarizes latencies with nearest-rank percentiles", func() {
+			var latencies []time.Duration
+			for i := 100; i > 0; i-- {
+				latencies = append(latencies, time.Duration(i)*time.Millisecond)
+			}
+
+			assert.Equal(t, LatencySummary{
+				Min: 1 * time.Millisecond,
+				P50: 50 * time.Millisecond,
+				P90: 90 * time.Millisecond,
+				P99: 99 * time.Millisecond,
+				Max: 100 * time.Millisecond,
+			}, summarize(latencies))
+		})
+
+		it("writes a readable summary", func() {
+			buf := &bytes.Buffer{}
+			require.NoError(t, Report{
+				Images:    2,
+				Scheduled: 2,
+				Completed: 1,
+				Duration:  2 * time.Second,
+			}.Write(buf))
+
+			assert.Contains(t, buf.String(), "throughput:  1.00 images/s")
+			assert.Contains(t, buf.String(), "schedule latency: min=0s p50=0s p90=0s p99=0s max=0s")
+		})
+	})
+}
@@ -0,0 +1,87 @@
+package loadtest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+type Report struct {
+	Images    int
+	Scheduled int
+	Completed int
+	Failed    int
+	Duration  time.Duration
+	// ScheduleLatency is the time from creating an Image until its first
+	// Build is observed.
+	ScheduleLatency LatencySummary
+	// BuildLatency is the time from observing the first Build of an Image
+	// until it finishes.
+	BuildLatency LatencySummary
+}
+
+type LatencySummary struct {
+	Min time.Duration
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+	Max time.Duration
+}
+
+// Throughput is the number of Images scheduled per second.
+func (r Report) Throughput() float64 {
+	if r.Duration <= 0 {
+		return 0
+	}
+	return float64(r.Scheduled) / r.Duration.Seconds()
+}
+
+func (r Report) Write(w io.Writer) error {
+	_, err := fmt.Fprintf(w, `images:      %d
+scheduled:   %d
+completed:   %d
+failed:      %d
+duration:    %s
+throughput:  %.2f images/s
+schedule latency: %s
+build latency:    %s
+`, r.Images, r.Scheduled, r.Completed, r.Failed, r.Duration.Round(time.Millisecond), r.Throughput(), r.ScheduleLatency, r.BuildLatency)
+	return err
+}
+
+func (l LatencySummary) String() string {
+	return fmt.Sprintf("min=%s p50=%s p90=%s p99=%s max=%s",
+		l.Min.Round(time.Millisecond),
+		l.P50.Round(time.Millisecond),
+		l.P90.Round(time.Millisecond),
+		l.P99.Round(time.Millisecond),
+		l.Max.Round(time.Millisecond),
+	)
+}
+
+func summarize(latencies []time.Duration) LatencySummary {
+	if len(latencies) == 0 {
+		return LatencySummary{}
+	}
+
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return LatencySummary{
+		Min: sorted[0],
+		P50: percentile(sorted, 50),
+		P90: percentile(sorted, 90),
+		P99: percentile(sorted, 99),
+		Max: sorted[len(sorted)-1],
+	}
+}
+
+// percentile uses the nearest-rank method on sorted latencies.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
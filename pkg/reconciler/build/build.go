@@ -3,6 +3,8 @@ package build
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/pkg/errors"
@@ -11,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	corev1Informers "k8s.io/client-go/informers/core/v1"
 	k8sclient "k8s.io/client-go/kubernetes"
@@ -26,6 +29,7 @@ import (
 	buildinformers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha2"
 	buildlisters "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha2"
 	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/config"
 	"github.com/pivotal/kpack/pkg/reconciler"
 	"github.com/pivotal/kpack/pkg/registry"
 )
@@ -35,6 +39,8 @@ const (
 	Kind            = "Build"
 	k8sOSLabel      = "kubernetes.io/os"
 	ReasonCompleted = "Completed"
+
+	ReasonPausedAtBreakpoint = "PausedAtBreakpoint"
 )
 
 //go:generate counterfeiter . MetadataRetriever
@@ -46,16 +52,20 @@ type PodGenerator interface {
 	Generate(context.Context, buildpod.BuildPodable) (*corev1.Pod, error)
 }
 
-func NewController(ctx context.Context, opt reconciler.Options, k8sClient k8sclient.Interface, informer buildinformers.BuildInformer, podInformer corev1Informers.PodInformer, metadataRetriever MetadataRetriever, podGenerator PodGenerator, keychainFactory registry.KeychainFactory, injectedSidecarSupport bool) *controller.Impl {
+func NewController(ctx context.Context, opt reconciler.Options, k8sClient k8sclient.Interface, informer buildinformers.BuildInformer, podInformer corev1Informers.PodInformer, pvcInformer corev1Informers.PersistentVolumeClaimInformer, buildQuotaInformer buildinformers.BuildQuotaInformer, metadataRetriever MetadataRetriever, podGenerator PodGenerator, keychainFactory registry.KeychainFactory, imageCopier ImageCopier, runImageVerifier RunImageVerifier, kpackConfig *config.KpackConfigStore) *controller.Impl {
 	c := &Reconciler{
-		Client:                 opt.Client,
-		K8sClient:              k8sClient,
-		MetadataRetriever:      metadataRetriever,
-		Lister:                 informer.Lister(),
-		PodLister:              podInformer.Lister(),
-		PodGenerator:           podGenerator,
-		KeychainFactory:        keychainFactory,
-		InjectedSidecarSupport: injectedSidecarSupport,
+		Client:            opt.Client,
+		K8sClient:         k8sClient,
+		MetadataRetriever: metadataRetriever,
+		Lister:            informer.Lister(),
+		PodLister:         podInformer.Lister(),
+		PvcLister:         pvcInformer.Lister(),
+		BuildQuotaLister:  buildQuotaInformer.Lister(),
+		PodGenerator:      podGenerator,
+		KeychainFactory:   keychainFactory,
+		ImageCopier:       imageCopier,
+		RunImageVerifier:  runImageVerifier,
+		KpackConfig:       kpackConfig,
 	}
 
 	logger := opt.Logger.With(
@@ -63,6 +73,7 @@ func NewController(ctx context.Context, opt reconciler.Options, k8sClient k8scli
 	)
 
 	impl := controller.NewContext(ctx, c, controller.ControllerOptions{WorkQueueName: ReconcilerName, Logger: logger})
+	c.EnqueueAfter = impl.EnqueueAfter
 
 	informer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
@@ -71,18 +82,41 @@ func NewController(ctx context.Context, opt reconciler.Options, k8sClient k8scli
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
+	buildQuotaInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		quota, ok := obj.(*buildapi.BuildQuota)
+		if !ok {
+			return
+		}
+
+		builds, err := c.Lister.Builds(quota.Namespace).List(labels.Everything())
+		if err != nil {
+			return
+		}
+
+		for _, build := range builds {
+			if !build.Finished() && build.Status.PodName == "" {
+				impl.Enqueue(build)
+			}
+		}
+	}))
+
 	return impl
 }
 
 type Reconciler struct {
-	Client                 versioned.Interface
-	KeychainFactory        registry.KeychainFactory
-	Lister                 buildlisters.BuildLister
-	MetadataRetriever      MetadataRetriever
-	K8sClient              k8sclient.Interface
-	PodLister              v1Listers.PodLister
-	PodGenerator           PodGenerator
-	InjectedSidecarSupport bool
+	Client            versioned.Interface
+	KeychainFactory   registry.KeychainFactory
+	Lister            buildlisters.BuildLister
+	MetadataRetriever MetadataRetriever
+	K8sClient         k8sclient.Interface
+	PodLister         v1Listers.PodLister
+	PvcLister         v1Listers.PersistentVolumeClaimLister
+	BuildQuotaLister  buildlisters.BuildQuotaLister
+	PodGenerator      PodGenerator
+	ImageCopier       ImageCopier
+	RunImageVerifier  RunImageVerifier
+	KpackConfig       *config.KpackConfigStore
+	EnqueueAfter      func(obj interface{}, after time.Duration)
 }
 
 func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
@@ -112,8 +146,27 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 }
 
 func (c *Reconciler) reconcile(ctx context.Context, build *buildapi.Build) error {
+	if build.Status.SequenceNumber == 0 {
+		build.Status.SequenceNumber = build.SequenceNumber()
+	}
+
 	if build.Finished() {
-		return nil
+		return c.reconcileFailureRetention(ctx, build)
+	}
+
+	kpackConfig := c.KpackConfig.Load()
+	featureGates := kpackConfig.FeatureGates
+
+	if featureGates.EnableBuildDeduplication {
+		reused, err := c.reuseEquivalentBuild(ctx, build)
+		if err != nil || reused {
+			return err
+		}
+	}
+
+	admitted, err := c.admitBuild(ctx, build, featureGates.PrioritizeFirstBuilds)
+	if err != nil || !admitted {
+		return err
 	}
 
 	pod, err := c.reconcileBuildPod(ctx, build)
@@ -123,7 +176,7 @@ func (c *Reconciler) reconcile(ctx context.Context, build *buildapi.Build) error
 		return controller.NewPermanentError(err)
 	}
 
-	if c.InjectedSidecarSupport {
+	if featureGates.InjectedSidecarSupport {
 		pod, err = c.setBuildReady(ctx, pod)
 		if err != nil {
 			return err
@@ -157,22 +210,43 @@ func (c *Reconciler) reconcile(ctx context.Context, build *buildapi.Build) error
 				return err
 			}
 		} else {
-			buildMetadata, err = c.buildMetadataFromBuildPod(pod)
+			buildMetadata, err = c.buildMetadataFromBuildPod(ctx, pod)
 			if err != nil {
 				return errors.Wrap(err, "failed to get build metadata from build pod")
 			}
 		}
 		build.Status.BuildMetadata = buildMetadata.BuildpackMetadata
 		build.Status.LatestImage = buildMetadata.LatestImage
+		build.Status.PushedImages = build.PushedImages(buildMetadata.LatestImage)
 		build.Status.LatestCacheImage = buildMetadata.LatestCacheImage
 		build.Status.Stack.RunImage = buildMetadata.StackRunImage
 		build.Status.Stack.ID = buildMetadata.StackID
+		build.Status.CacheMetrics = buildMetadata.CacheMetrics
+		build.Status.ImageDigest = buildMetadata.ImageDigest
+		build.Status.PushedTags = buildMetadata.PushedTags
+		build.Status.ImageLabels = buildMetadata.ImageLabels
+		recordCacheMetrics(ctx, build)
 	}
 
 	build.Status.PodName = pod.Name
 	build.Status.StepStates = stepStates(pod)
 	build.Status.StepsCompleted = stepsCompleted(pod)
+	build.Status.Steps = stepReferences(pod)
+	build.Status.ActiveStep = activeStep(pod)
+	build.Status.DetectOutput = detectOutput(pod)
 	build.Status.Conditions = conditionForPod(pod, build.Status.StepsCompleted)
+
+	if build.Finished() {
+		build.Status.ResourceUsage = resourceUsage(pod)
+		recordResourceUsage(ctx, build)
+	}
+
+	if build.IsSuccess() {
+		if err := c.relocateRunImage(ctx, build); err != nil {
+			return err
+		}
+		return c.verifyRunImage(ctx, build, kpackConfig)
+	}
 	return nil
 }
 
@@ -227,6 +301,10 @@ func (c *Reconciler) reconcileBuildPod(ctx context.Context, build *buildapi.Buil
 		if err != nil {
 			return nil, controller.NewPermanentError(err)
 		}
+
+		if err := c.reconcileRetainedWorkspace(ctx, build, podConfig); err != nil {
+			return nil, err
+		}
 		return c.K8sClient.CoreV1().Pods(build.Namespace).Create(ctx, podConfig, metav1.CreateOptions{})
 	}
 
@@ -234,6 +312,18 @@ func (c *Reconciler) reconcileBuildPod(ctx context.Context, build *buildapi.Buil
 }
 
 func conditionForPod(pod *corev1.Pod, stepsCompleted []string) corev1alpha1.Conditions {
+	if pausedAtBreakpoint(pod) {
+		return corev1alpha1.Conditions{
+			{
+				Type:               corev1alpha1.ConditionSucceeded,
+				Status:             corev1.ConditionUnknown,
+				Reason:             ReasonPausedAtBreakpoint,
+				Message:            fmt.Sprintf("run 'kubectl exec -n %s %s -c %s -- touch %s' to continue", pod.Namespace, pod.Name, buildapi.BreakpointContainerName, buildapi.BreakpointContinueFile),
+				LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+			},
+		}
+	}
+
 	switch pod.Status.Phase {
 	case corev1.PodSucceeded:
 		return corev1alpha1.Conditions{
@@ -253,6 +343,9 @@ func conditionForPod(pod *corev1.Pod, stepsCompleted []string) corev1alpha1.Cond
 				},
 			}
 		}
+		if failedDetect(pod) != nil {
+			return noBuildpackDetectedCondition(pod)
+		}
 		return corev1alpha1.Conditions{
 			{
 				Type:               corev1alpha1.ConditionSucceeded,
@@ -286,6 +379,19 @@ func conditionForPod(pod *corev1.Pod, stepsCompleted []string) corev1alpha1.Cond
 	}
 }
 
+func pausedAtBreakpoint(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodPending && pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+
+	for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if s.Name == buildapi.BreakpointContainerName && s.State.Running != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func stepStates(pod *corev1.Pod) []corev1.ContainerState {
 	states := make([]corev1.ContainerState, 0, len(buildapi.BuildSteps()))
 	for _, s := range pod.Status.InitContainerStatuses {
@@ -301,6 +407,34 @@ func stepStates(pod *corev1.Pod) []corev1.ContainerState {
 	return states
 }
 
+func isStepContainer(name string) bool {
+	return buildapi.IsBuildStep(name) || name == buildapi.BreakpointContainerName
+}
+
+func stepReferences(pod *corev1.Pod) []buildapi.BuildStepReference {
+	var steps []buildapi.BuildStepReference
+	for _, c := range pod.Spec.InitContainers {
+		if isStepContainer(c.Name) {
+			steps = append(steps, buildapi.BuildStepReference{Name: c.Name, ContainerName: c.Name, InitContainer: true})
+		}
+	}
+	for _, c := range pod.Spec.Containers {
+		if isStepContainer(c.Name) {
+			steps = append(steps, buildapi.BuildStepReference{Name: c.Name, ContainerName: c.Name})
+		}
+	}
+	return steps
+}
+
+func activeStep(pod *corev1.Pod) string {
+	for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if isStepContainer(s.Name) && s.State.Running != nil {
+			return s.Name
+		}
+	}
+	return ""
+}
+
 func stepsCompleted(pod *corev1.Pod) []string {
 	completed := make([]string, 0, len(buildapi.BuildSteps()))
 	for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
@@ -330,15 +464,32 @@ func (c *Reconciler) updateStatus(ctx context.Context, desired *buildapi.Build)
 	return err
 }
 
-func (c *Reconciler) buildMetadataFromBuildPod(pod *corev1.Pod) (*cnb.BuildMetadata, error) {
+func (c *Reconciler) buildMetadataFromBuildPod(ctx context.Context, pod *corev1.Pod) (*cnb.BuildMetadata, error) {
 	for _, status := range pod.Status.ContainerStatuses {
 		if status.Name == buildapi.CompletionContainerName {
-			return cnb.DecompressBuildMetadata(status.State.Terminated.Message)
+			buildMetadata, err := cnb.DecompressBuildMetadata(status.State.Terminated.Message)
+			if err != nil || buildMetadata.ResultsConfigMap == "" {
+				return buildMetadata, err
+			}
+			return c.buildMetadataFromConfigMap(ctx, pod.Namespace, buildMetadata.ResultsConfigMap)
 		}
 	}
 	return nil, errors.New(buildapi.CompletionContainerName + " container not found")
 }
 
+func (c *Reconciler) buildMetadataFromConfigMap(ctx context.Context, namespace, name string) (*cnb.BuildMetadata, error) {
+	configMap, err := c.K8sClient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	buildMetadata := &cnb.BuildMetadata{}
+	if err := json.Unmarshal([]byte(configMap.Data[buildapi.ResultsConfigMapKey]), buildMetadata); err != nil {
+		return nil, errors.Wrapf(err, "invalid build metadata in configmap %s", name)
+	}
+	return buildMetadata, nil
+}
+
 func contains(arr []string, s string) bool {
 	for _, item := range arr {
 		if s == item {
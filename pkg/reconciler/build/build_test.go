@@ -11,6 +11,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/sclevine/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +35,7 @@ import (
 	"github.com/pivotal/kpack/pkg/buildpod"
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
 	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/config"
 	"github.com/pivotal/kpack/pkg/reconciler/build"
 	"github.com/pivotal/kpack/pkg/reconciler/build/buildfakes"
 	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
@@ -60,6 +63,13 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 		ctx                    = context.Background()
 		injectedSidecarSupport = false
 		reactors               = make([]reactor, 0)
+
+		fakeImageCopier          = &buildfakes.FakeImageCopier{}
+		enableBuildDeduplication = false
+		fakeRunImageVerifier     = &buildfakes.FakeRunImageVerifier{}
+		runImageRegistries       map[string]string
+		enqueuedAfter            []time.Duration
+		prioritizeFirstBuilds    = false
 	)
 
 	rt := testhelpers.ReconcilerTester(t,
@@ -75,14 +85,28 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 			eventList := rtesting.EventList{Recorder: eventRecorder}
 
 			r := &build.Reconciler{
-				K8sClient:              k8sfakeClient,
-				Client:                 fakeClient,
-				KeychainFactory:        keychainFactory,
-				Lister:                 listers.GetBuildLister(),
-				MetadataRetriever:      fakeMetadataRetriever,
-				PodLister:              listers.GetPodLister(),
-				PodGenerator:           podGenerator,
-				InjectedSidecarSupport: injectedSidecarSupport,
+				K8sClient:         k8sfakeClient,
+				Client:            fakeClient,
+				KeychainFactory:   keychainFactory,
+				Lister:            listers.GetBuildLister(),
+				MetadataRetriever: fakeMetadataRetriever,
+				PodLister:         listers.GetPodLister(),
+				PvcLister:         listers.GetPersistentVolumeClaimLister(),
+				BuildQuotaLister:  listers.GetBuildQuotaLister(),
+				PodGenerator:      podGenerator,
+				ImageCopier:       fakeImageCopier,
+				RunImageVerifier:  fakeRunImageVerifier,
+				KpackConfig: config.NewKpackConfigStore(config.KpackConfig{
+					FeatureGates: config.FeatureGates{
+						InjectedSidecarSupport:   injectedSidecarSupport,
+						EnableBuildDeduplication: enableBuildDeduplication,
+						PrioritizeFirstBuilds:    prioritizeFirstBuilds,
+					},
+					RunImageRegistries: runImageRegistries,
+				}),
+				EnqueueAfter: func(obj interface{}, after time.Duration) {
+					enqueuedAfter = append(enqueuedAfter, after)
+				},
 			}
 
 			rtesting.PrependGenerateNameReactor(&fakeClient.Fake)
@@ -243,6 +267,71 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
+		it("records the sequence number assigned by the image", func() {
+			bld.Labels = map[string]string{buildapi.BuildNumberLabel: "4"}
+			buildPod, err := podGenerator.Generate(ctx, bld)
+			require.NoError(t, err)
+
+			rt.Test(rtesting.TableRow{
+				Key: key,
+				Objects: []runtime.Object{
+					bld,
+					buildPod,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &buildapi.Build{
+							ObjectMeta: bld.ObjectMeta,
+							Spec:       bld.Spec,
+							Status: buildapi.BuildStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: 1,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionUnknown,
+										},
+									},
+								},
+								PodName:        "build-name-build-pod",
+								SequenceNumber: 4,
+							},
+						},
+					},
+				},
+			})
+		})
+
+		it("does not change a recorded sequence number", func() {
+			bld.Labels = map[string]string{buildapi.BuildNumberLabel: "5"}
+			buildPod, err := podGenerator.Generate(ctx, bld)
+			require.NoError(t, err)
+
+			bld.Status = buildapi.BuildStatus{
+				Status: corev1alpha1.Status{
+					ObservedGeneration: 1,
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionUnknown,
+						},
+					},
+				},
+				PodName:        buildPod.Name,
+				SequenceNumber: 4,
+			}
+
+			rt.Test(rtesting.TableRow{
+				Key: key,
+				Objects: []runtime.Object{
+					bld,
+					buildPod,
+				},
+				WantErr: false,
+			})
+		})
+
 		it("does not update status if there is no update", func() {
 			buildPod, err := podGenerator.Generate(ctx, bld)
 			require.NoError(t, err)
@@ -422,6 +511,145 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 									StepsCompleted: []string{
 										"prepare",
 									},
+									ActiveStep: "analyze",
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("references the containers of each step and the active step", func() {
+				pod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+
+				pod.Spec.InitContainers = []corev1.Container{{Name: "prepare"}, {Name: "detect"}, {Name: "build"}}
+				pod.Spec.Containers = []corev1.Container{{Name: "completion"}}
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "prepare",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode: 0,
+							},
+						},
+					},
+					{
+						Name: "detect",
+						State: corev1.ContainerState{
+							Running: &corev1.ContainerStateRunning{},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionUnknown,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode: 0,
+											},
+										},
+										{
+											Running: &corev1.ContainerStateRunning{},
+										},
+									},
+									StepsCompleted: []string{
+										"prepare",
+									},
+									Steps: []buildapi.BuildStepReference{
+										{Name: "prepare", ContainerName: "prepare", InitContainer: true},
+										{Name: "detect", ContainerName: "detect", InitContainer: true},
+										{Name: "build", ContainerName: "build", InitContainer: true},
+										{Name: "completion", ContainerName: "completion"},
+									},
+									ActiveStep: "detect",
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("reports when the build is paused at a breakpoint", func() {
+				pod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+
+				pod.Status.Phase = corev1.PodPending
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "prepare",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode: 0,
+							},
+						},
+					},
+					{
+						Name: "breakpoint",
+						State: corev1.ContainerState{
+							Running: &corev1.ContainerStateRunning{},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionUnknown,
+												Reason:  "PausedAtBreakpoint",
+												Message: "run 'kubectl exec -n some-namespace build-name-build-pod -c breakpoint -- touch /tmp/kpack-continue' to continue",
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode: 0,
+											},
+										},
+									},
+									StepsCompleted: []string{
+										"prepare",
+									},
+									ActiveStep: "breakpoint",
 								},
 							},
 						},
@@ -628,44 +856,225 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 
-			it("does not recreate pods if build has finished", func() {
+			it("records cache metrics and export report details from the completion container", func() {
+				pod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodSucceeded
+
+				cacheMetrics := &corev1alpha1.BuildCacheMetrics{
+					Layers:              4,
+					ReusedLayers:        3,
+					CacheLayers:         4,
+					RestoredCacheLayers: 2,
+					HitRatioPercent:     62,
+				}
+				compressedBuildMetadata, err := cnb.CompressBuildMetadata(&cnb.BuildMetadata{
+					BuildpackMetadata: corev1alpha1.BuildpackMetadataList{{
+						Id:      "some-id",
+						Version: "some-version",
+					}},
+					LatestImage:   "some-latest-image",
+					StackRunImage: "some-run-image",
+					StackID:       "some-stack-id",
+					CacheMetrics:  cacheMetrics,
+					ImageDigest:   "sha256:some-digest",
+					PushedTags:    []string{"some/image:tag", "some/image:other-tag"},
+					ImageLabels:   map[string]string{"some-label": "some-value"},
+				})
+				require.NoError(t, err)
+
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "completion",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								Message: string(compressedBuildMetadata),
+							},
+						},
+					},
+				}
+
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
-						&buildapi.Build{
-							ObjectMeta: bld.ObjectMeta,
-							Spec:       bld.Spec,
-							Status: buildapi.BuildStatus{
-								Status: corev1alpha1.Status{
-									ObservedGeneration: originalGeneration,
-									Conditions: corev1alpha1.Conditions{
-										{
-											Type:   corev1alpha1.ConditionSucceeded,
-											Status: corev1.ConditionTrue,
+						bld,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
 										},
 									},
-								},
-								BuildMetadata: corev1alpha1.BuildpackMetadataList{{
-									Id:      "io.buildpack.previouslyfetched",
-									Version: "1.1",
-								}},
-								PodName:     "build-name-build-pod",
-								LatestImage: "previously/fetched@sha256:abcd",
-								StepStates: []corev1.ContainerState{
-									{
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    0,
-											Reason:      "Terminated",
-											Message:     "Message",
-											ContainerID: "container.ID",
-										},
+									PodName: "build-name-build-pod",
+									BuildMetadata: corev1alpha1.BuildpackMetadataList{{
+										Id:      "some-id",
+										Version: "some-version",
+									}},
+									LatestImage: "some-latest-image",
+									Stack: corev1alpha1.BuildStack{
+										RunImage: "some-run-image",
+										ID:       "some-stack-id",
 									},
-									{
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    0,
-											Reason:      "Terminated",
-											Message:     "Message",
-											ContainerID: "container.ID2",
+									CacheMetrics: cacheMetrics,
+									ImageDigest:  "sha256:some-digest",
+									PushedTags:   []string{"some/image:tag", "some/image:other-tag"},
+									ImageLabels:  map[string]string{"some-label": "some-value"},
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												Message: string(compressedBuildMetadata),
+											},
+										},
+									},
+									StepsCompleted: []string{
+										"completion",
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("reads build metadata that did not fit in the termination message from the results configmap", func() {
+				pod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodSucceeded
+
+				compressedBuildMetadata, err := cnb.CompressBuildMetadata(&cnb.BuildMetadata{
+					ResultsConfigMap: "build-name-results",
+				})
+				require.NoError(t, err)
+
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "completion",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								Message: string(compressedBuildMetadata),
+							},
+						},
+					},
+				}
+
+				buildMetadata, err := json.Marshal(&cnb.BuildMetadata{
+					BuildpackMetadata: corev1alpha1.BuildpackMetadataList{{
+						Id:      "some-id",
+						Version: "some-version",
+					}},
+					LatestImage:   "some-latest-image",
+					StackRunImage: "some-run-image",
+					StackID:       "some-stack-id",
+				})
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						pod,
+						&corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "build-name-results",
+								Namespace: namespace,
+							},
+							Data: map[string]string{
+								"metadata.json": string(buildMetadata),
+							},
+						},
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									BuildMetadata: corev1alpha1.BuildpackMetadataList{{
+										Id:      "some-id",
+										Version: "some-version",
+									}},
+									LatestImage: "some-latest-image",
+									Stack: corev1alpha1.BuildStack{
+										RunImage: "some-run-image",
+										ID:       "some-stack-id",
+									},
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												Message: string(compressedBuildMetadata),
+											},
+										},
+									},
+									StepsCompleted: []string{
+										"completion",
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("does not recreate pods if build has finished", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						&buildapi.Build{
+							ObjectMeta: bld.ObjectMeta,
+							Spec:       bld.Spec,
+							Status: buildapi.BuildStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: originalGeneration,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								BuildMetadata: corev1alpha1.BuildpackMetadataList{{
+									Id:      "io.buildpack.previouslyfetched",
+									Version: "1.1",
+								}},
+								PodName:     "build-name-build-pod",
+								LatestImage: "previously/fetched@sha256:abcd",
+								StepStates: []corev1.ContainerState{
+									{
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    0,
+											Reason:      "Terminated",
+											Message:     "Message",
+											ContainerID: "container.ID",
+										},
+									},
+									{
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    0,
+											Reason:      "Terminated",
+											Message:     "Message",
+											ContainerID: "container.ID2",
 										},
 									},
 								},
@@ -856,34 +1265,73 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
-		when("pod failed", func() {
-			it("sets the build status to Failed", func() {
-				pod, err := podGenerator.Generate(ctx, bld)
+		when("run image registries are configured", func() {
+			var (
+				pod                     *corev1.Pod
+				compressedBuildMetadata []byte
+				runImageKeychain        = &registryfakes.FakeKeychain{Name: "run-image"}
+			)
+
+			it.Before(func() {
+				runImageRegistries = map[string]string{
+					"someimage": "app-registry.example.com/stacks",
+				}
+
+				keychainFactory.AddKeychainForSecretRef(t, registry.SecretRef{
+					ServiceAccount: serviceAccountName,
+					Namespace:      namespace,
+				}, runImageKeychain)
+
+				var err error
+				pod, err = podGenerator.Generate(ctx, bld)
 				require.NoError(t, err)
-				pod.Status.Phase = corev1.PodFailed
-				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+				pod.Status.Phase = corev1.PodSucceeded
+
+				compressedBuildMetadata, err = cnb.CompressBuildMetadata(&cnb.BuildMetadata{
+					LatestImage:   "some-latest-image",
+					StackRunImage: "gcr.io/paketo/run@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+					StackID:       "some-stack-id",
+				})
+				require.NoError(t, err)
+
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
 					{
-						Name: "prepare",
+						Name: "completion",
 						State: corev1.ContainerState{
 							Terminated: &corev1.ContainerStateTerminated{
-								ExitCode:    1,
-								Reason:      "Terminated",
-								Message:     "Errors",
-								ContainerID: "container.ID",
+								Message: string(compressedBuildMetadata),
 							},
 						},
 					},
-					{
-						Name: "analyze",
-						State: corev1.ContainerState{
-							Waiting: &corev1.ContainerStateWaiting{
-								Reason:  "Waiting",
-								Message: "My Turn",
+				}
+			})
+
+			expectedStatus := func(condition corev1alpha1.Condition) buildapi.BuildStatus {
+				return buildapi.BuildStatus{
+					Status: corev1alpha1.Status{
+						ObservedGeneration: originalGeneration,
+						Conditions:         corev1alpha1.Conditions{condition},
+					},
+					PodName:     "build-name-build-pod",
+					LatestImage: "some-latest-image",
+					Stack: corev1alpha1.BuildStack{
+						RunImage: "gcr.io/paketo/run@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+						ID:       "some-stack-id",
+					},
+					StepStates: []corev1.ContainerState{
+						{
+							Terminated: &corev1.ContainerStateTerminated{
+								Message: string(compressedBuildMetadata),
 							},
 						},
 					},
+					StepsCompleted: []string{
+						"completion",
+					},
 				}
+			}
 
+			it("verifies the run image in the registry of app clusters", func() {
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
@@ -896,138 +1344,699 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 							Object: &buildapi.Build{
 								ObjectMeta: bld.ObjectMeta,
 								Spec:       bld.Spec,
-								Status: buildapi.BuildStatus{
-									Status: corev1alpha1.Status{
-										ObservedGeneration: originalGeneration,
-										Conditions: corev1alpha1.Conditions{
-											{
-												Type:   corev1alpha1.ConditionSucceeded,
-												Status: corev1.ConditionFalse,
-											},
-										},
-									},
-									PodName: "build-name-build-pod",
-									StepStates: []corev1.ContainerState{
-										{
-											Terminated: &corev1.ContainerStateTerminated{
-												ExitCode:    1,
-												Reason:      "Terminated",
-												Message:     "Errors",
-												ContainerID: "container.ID",
-											},
-										},
-										{
-											Waiting: &corev1.ContainerStateWaiting{
-												Reason:  "Waiting",
-												Message: "My Turn",
-											},
-										},
-									},
-									StepsCompleted: []string{},
-								},
+								Status: expectedStatus(corev1alpha1.Condition{
+									Type:   corev1alpha1.ConditionSucceeded,
+									Status: corev1.ConditionTrue,
+								}),
 							},
 						},
 					},
 				})
+
+				require.Equal(t, 1, fakeRunImageVerifier.VerifyDigestCallCount())
+				keychain, image := fakeRunImageVerifier.VerifyDigestArgsForCall(0)
+				assert.Equal(t, runImageKeychain, keychain)
+				assert.Equal(t, "app-registry.example.com/stacks/run@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", image)
 			})
 
-			it("does not recreate pods if build has finished", func() {
+			it("fails the build when the run image is not reachable", func() {
+				fakeRunImageVerifier.VerifyDigestReturns(errors.New("MANIFEST_UNKNOWN"))
+
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
-						&buildapi.Build{
-							ObjectMeta: bld.ObjectMeta,
-							Spec:       bld.Spec,
-							Status: buildapi.BuildStatus{
-								Status: corev1alpha1.Status{
-									ObservedGeneration: originalGeneration,
-									Conditions: corev1alpha1.Conditions{
-										{
-											Type:   corev1alpha1.ConditionSucceeded,
-											Status: corev1.ConditionFalse,
-										},
-									},
-								},
-								PodName: "build-name-build-pod",
-								StepStates: []corev1.ContainerState{
-									{
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    1,
-											Reason:      "Terminated",
-											Message:     "Errors",
-											ContainerID: "container.ID",
-										},
-									},
-									{
-										Waiting: &corev1.ContainerStateWaiting{
-											Reason:  "Waiting",
-											Message: "My Turn",
-										},
-									},
-								},
-								StepsCompleted: []string{
-									"prepare",
-								},
+						bld,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: expectedStatus(corev1alpha1.Condition{
+									Type:    corev1alpha1.ConditionSucceeded,
+									Status:  corev1.ConditionFalse,
+									Reason:  build.ReasonRunImageNotReachable,
+									Message: "run image app-registry.example.com/stacks/run@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb is not reachable from app clusters: MANIFEST_UNKNOWN",
+								}),
 							},
 						},
 					},
-					WantErr: false,
 				})
 			})
-		})
-
-		when("a build pod cannot be created", func() {
-			it("returns a permanent error", func() {
-				pod, err := podGenerator.Generate(ctx, bld)
-				require.NoError(t, err)
 
-				podName := fmt.Sprintf("%s-build-pod", buildName)
-				reactors = append(reactors, reactor{
-					verb:     "create",
-					resource: "pods",
-					reactionFunc: func(action clientgotesting.Action) (handled bool, ret runtime.Object, err error) {
-						a := action.DeepCopy().(clientgotesting.CreateAction)
-						return a.GetObject().(metav1.Object).GetName() == podName, nil, k8serrors.NewInvalid(schema.ParseGroupKind("v1/pod"), podName, field.ErrorList{})
-					},
-				})
+			it("does not verify builds of other images", func() {
+				runImageRegistries = map[string]string{
+					"otherimage": "app-registry.example.com/stacks",
+				}
 
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
 						bld,
-					},
-					WantErr: false,
-					WantCreates: []runtime.Object{
 						pod,
 					},
+					WantErr: false,
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
 							Object: &buildapi.Build{
 								ObjectMeta: bld.ObjectMeta,
 								Spec:       bld.Spec,
-								Status: buildapi.BuildStatus{
-									Status: corev1alpha1.Status{
+								Status: expectedStatus(corev1alpha1.Condition{
+									Type:   corev1alpha1.ConditionSucceeded,
+									Status: corev1.ConditionTrue,
+								}),
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 0, fakeRunImageVerifier.VerifyDigestCallCount())
+			})
+		})
+
+		when("relocating the run image", func() {
+			var (
+				pod                     *corev1.Pod
+				compressedBuildMetadata []byte
+				appImageKeychain        = &registryfakes.FakeKeychain{Name: "app-image"}
+			)
+
+			it.Before(func() {
+				bld.Spec.RelocateRunImage = true
+
+				keychainFactory.AddKeychainForSecretRef(t, registry.SecretRef{
+					ServiceAccount: serviceAccountName,
+					Namespace:      namespace,
+				}, appImageKeychain)
+
+				var err error
+				pod, err = podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodSucceeded
+
+				compressedBuildMetadata, err = cnb.CompressBuildMetadata(&cnb.BuildMetadata{
+					LatestImage:   "some-latest-image",
+					StackRunImage: "gcr.io/paketo/run@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+					StackID:       "some-stack-id",
+				})
+				require.NoError(t, err)
+
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "completion",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								Message: string(compressedBuildMetadata),
+							},
+						},
+					},
+				}
+			})
+
+			it("copies the run image into the repository of the app image", func() {
+				fakeImageCopier.CopyReturns("index.docker.io/someimage/name@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", nil)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									PodName:     "build-name-build-pod",
+									LatestImage: "some-latest-image",
+									Stack: corev1alpha1.BuildStack{
+										RunImage: "gcr.io/paketo/run@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+										ID:       "some-stack-id",
+									},
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												Message: string(compressedBuildMetadata),
+											},
+										},
+									},
+									StepsCompleted: []string{
+										"completion",
+									},
+									RelocatedRunImage: "index.docker.io/someimage/name@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+								},
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 1, fakeImageCopier.CopyCallCount())
+				keychain, src, dst := fakeImageCopier.CopyArgsForCall(0)
+				assert.Equal(t, appImageKeychain, keychain)
+				assert.Equal(t, "gcr.io/paketo/run@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", src)
+				assert.Equal(t, "index.docker.io/someimage/name:run-sha256-aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", dst)
+			})
+
+			it("retries when the run image cannot be copied", func() {
+				fakeImageCopier.CopyReturns("", errors.New("DENIED"))
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						pod,
+					},
+					WantErr: true,
+				})
+			})
+		})
+
+		when("pod failed", func() {
+			it("sets the build status to Failed", func() {
+				pod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodFailed
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "prepare",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode:    1,
+								Reason:      "Terminated",
+								Message:     "Errors",
+								ContainerID: "container.ID",
+							},
+						},
+					},
+					{
+						Name: "analyze",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{
+								Reason:  "Waiting",
+								Message: "My Turn",
+							},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionFalse,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode:    1,
+												Reason:      "Terminated",
+												Message:     "Errors",
+												ContainerID: "container.ID",
+											},
+										},
+										{
+											Waiting: &corev1.ContainerStateWaiting{
+												Reason:  "Waiting",
+												Message: "My Turn",
+											},
+										},
+									},
+									StepsCompleted: []string{},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("records the resources reserved by the build pod", func() {
+				pod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+				pod.Spec.InitContainers[0] = corev1.Container{
+					Name: "prepare",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("128M"),
+						},
+					},
+				}
+				pod.Status.Phase = corev1.PodFailed
+				startedAt := metav1.NewTime(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+				terminated := &corev1.ContainerStateTerminated{
+					ExitCode:   1,
+					StartedAt:  startedAt,
+					FinishedAt: metav1.NewTime(startedAt.Add(10 * time.Second)),
+				}
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name:  "prepare",
+						State: corev1.ContainerState{Terminated: terminated},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionFalse,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									StepStates: []corev1.ContainerState{
+										{Terminated: terminated},
+									},
+									StepsCompleted: []string{},
+									Steps: []buildapi.BuildStepReference{
+										{Name: "prepare", ContainerName: "prepare", InitContainer: true},
+									},
+									ResourceUsage: &corev1alpha1.BuildResourceUsage{
+										CPUMillicoreSeconds: 10000,
+										MemoryByteSeconds:   1280000000,
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("does not recreate pods if build has finished", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						&buildapi.Build{
+							ObjectMeta: bld.ObjectMeta,
+							Spec:       bld.Spec,
+							Status: buildapi.BuildStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: originalGeneration,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionFalse,
+										},
+									},
+								},
+								PodName: "build-name-build-pod",
+								StepStates: []corev1.ContainerState{
+									{
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    1,
+											Reason:      "Terminated",
+											Message:     "Errors",
+											ContainerID: "container.ID",
+										},
+									},
+									{
+										Waiting: &corev1.ContainerStateWaiting{
+											Reason:  "Waiting",
+											Message: "My Turn",
+										},
+									},
+								},
+								StepsCompleted: []string{
+									"prepare",
+								},
+							},
+						},
+					},
+					WantErr: false,
+				})
+			})
+		})
+
+		when("failures are retained", func() {
+			var retainedBuild *buildapi.Build
+
+			it.Before(func() {
+				retainedBuild = bld.DeepCopy()
+				retainedBuild.Spec.FailureRetention = &buildapi.FailureRetention{TTLSeconds: 3600}
+				enqueuedAfter = nil
+			})
+
+			failedAt := func(ago time.Duration) buildapi.BuildStatus {
+				return buildapi.BuildStatus{
+					Status: corev1alpha1.Status{
+						ObservedGeneration: originalGeneration,
+						Conditions: corev1alpha1.Conditions{
+							{
+								Type:               corev1alpha1.ConditionSucceeded,
+								Status:             corev1.ConditionFalse,
+								LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.NewTime(time.Now().Add(-ago))},
+							},
+						},
+					},
+					PodName: "build-name-build-pod",
+				}
+			}
+
+			it("creates the retained workspace claim with the build pod", func() {
+				buildPod, err := podGenerator.Generate(ctx, retainedBuild)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						retainedBuild,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						retainedBuild.RetainedWorkspace(),
+						buildPod,
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: retainedBuild.ObjectMeta,
+								Spec:       retainedBuild.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionUnknown,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("runs a debug pod against the workspace of a failed build until the ttl expires", func() {
+				retainedBuild.Status = failedAt(time.Minute)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						retainedBuild,
+						retainedBuild.RetainedWorkspace(),
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						retainedBuild.DebugPod(3540),
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: retainedBuild.ObjectMeta,
+								Spec:       retainedBuild.Spec,
+								Status: func() buildapi.BuildStatus {
+									status := failedAt(time.Minute)
+									status.DebugPodName = "build-name-debug"
+									return status
+								}(),
+							},
+						},
+					},
+				})
+
+				require.Len(t, enqueuedAfter, 1)
+				assert.InDelta(t, (59 * time.Minute).Seconds(), enqueuedAfter[0].Seconds(), 1)
+			})
+
+			it("releases the workspace and debug pod once the ttl expires", func() {
+				retainedBuild.Status = failedAt(2 * time.Hour)
+				retainedBuild.Status.DebugPodName = "build-name-debug"
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						retainedBuild,
+						retainedBuild.RetainedWorkspace(),
+						retainedBuild.DebugPod(3600),
+					},
+					WantErr: false,
+					WantDeletes: []clientgotesting.DeleteActionImpl{
+						{
+							ActionImpl: clientgotesting.ActionImpl{
+								Namespace: namespace,
+								Resource: schema.GroupVersionResource{
+									Resource: "pods",
+								},
+							},
+							Name: "build-name-debug",
+						},
+						{
+							ActionImpl: clientgotesting.ActionImpl{
+								Namespace: namespace,
+								Resource: schema.GroupVersionResource{
+									Resource: "persistentvolumeclaims",
+								},
+							},
+							Name: "build-name-workspace",
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: retainedBuild.ObjectMeta,
+								Spec:       retainedBuild.Spec,
+								Status:     failedAt(2 * time.Hour),
+							},
+						},
+					},
+				})
+			})
+
+			it("releases the workspace of a successful build", func() {
+				retainedBuild.Status = failedAt(time.Minute)
+				retainedBuild.Status.Conditions[0].Status = corev1.ConditionTrue
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						retainedBuild,
+						retainedBuild.RetainedWorkspace(),
+					},
+					WantErr: false,
+					WantDeletes: []clientgotesting.DeleteActionImpl{
+						{
+							ActionImpl: clientgotesting.ActionImpl{
+								Namespace: namespace,
+								Resource: schema.GroupVersionResource{
+									Resource: "persistentvolumeclaims",
+								},
+							},
+							Name: "build-name-workspace",
+						},
+					},
+				})
+			})
+		})
+
+		when("a build pod cannot be created", func() {
+			it("returns a permanent error", func() {
+				pod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+
+				podName := fmt.Sprintf("%s-build-pod", buildName)
+				reactors = append(reactors, reactor{
+					verb:     "create",
+					resource: "pods",
+					reactionFunc: func(action clientgotesting.Action) (handled bool, ret runtime.Object, err error) {
+						a := action.DeepCopy().(clientgotesting.CreateAction)
+						return a.GetObject().(metav1.Object).GetName() == podName, nil, k8serrors.NewInvalid(schema.ParseGroupKind("v1/pod"), podName, field.ErrorList{})
+					},
+				})
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						pod,
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionFalse,
+												Message: `v1/pod "build-name-build-pod" is invalid`,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+		})
+		when("pod needs cleanup", func() {
+			injectedSidecarSupport = true
+			var startTime = time.Now()
+
+			it("updates activeDeadlineSeconds when a build terminates", func() {
+				pod := &corev1.Pod{
+					TypeMeta: metav1.TypeMeta{},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bld.GetName() + "-build-pod",
+						Namespace: bld.GetNamespace(),
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  "completion",
+								Image: "completion-image",
+							},
+							{
+								Name:  "sidecar",
+								Image: "sidecar-image",
+							},
+						},
+					},
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{
+							{
+								Name: "completion",
+								State: corev1.ContainerState{
+									Terminated: &corev1.ContainerStateTerminated{
+										ExitCode:    0,
+										Reason:      "Terminated",
+										Message:     "Message",
+										ContainerID: "container.ID",
+									},
+								},
+							},
+							{
+								Name: "sidecar",
+								State: corev1.ContainerState{
+									Running: &corev1.ContainerStateRunning{
+										StartedAt: metav1.Time{Time: startTime},
+									},
+								},
+							},
+						},
+						Phase: corev1.PodRunning,
+					},
+				}
+
+				deadlinePatch, err := json.Marshal(map[string]interface{}{
+					"spec": map[string]interface{}{
+						"activeDeadlineSeconds": 1,
+					},
+				})
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						pod,
+					},
+					WantErr: false,
+					WantPatches: []clientgotesting.PatchActionImpl{
+						{
+							Name:      pod.Name,
+							PatchType: types.MergePatchType,
+							Patch:     deadlinePatch,
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
 										ObservedGeneration: originalGeneration,
 										Conditions: corev1alpha1.Conditions{
 											{
-												Type:    corev1alpha1.ConditionSucceeded,
-												Status:  corev1.ConditionFalse,
-												Message: `v1/pod "build-name-build-pod" is invalid`,
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionUnknown,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode:    0,
+												Reason:      "Terminated",
+												Message:     "Message",
+												ContainerID: "container.ID",
 											},
 										},
 									},
+									StepsCompleted: []string{
+										"completion",
+									},
+									Steps: []buildapi.BuildStepReference{
+										{Name: "completion", ContainerName: "completion"},
+									},
 								},
 							},
 						},
 					},
 				})
 			})
-		})
-		when("pod needs cleanup", func() {
-			injectedSidecarSupport = true
-			var startTime = time.Now()
 
-			it("updates activeDeadlineSeconds when a build terminates", func() {
+			it("marks build as successful if completion completes even if pod fails", func() {
+
+				compressedBuildMetadata, err := os.ReadFile(filepath.Join("testdata", "metadata"))
+				require.NoError(t, err)
+
+				deadline := int64(1)
 				pod := &corev1.Pod{
 					TypeMeta: metav1.TypeMeta{},
 					ObjectMeta: metav1.ObjectMeta{
@@ -1035,6 +2044,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 						Namespace: bld.GetNamespace(),
 					},
 					Spec: corev1.PodSpec{
+						ActiveDeadlineSeconds: &deadline,
 						Containers: []corev1.Container{
 							{
 								Name:  "completion",
@@ -1054,7 +2064,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 									Terminated: &corev1.ContainerStateTerminated{
 										ExitCode:    0,
 										Reason:      "Terminated",
-										Message:     "Message",
+										Message:     string(compressedBuildMetadata),
 										ContainerID: "container.ID",
 									},
 								},
@@ -1062,36 +2072,306 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 							{
 								Name: "sidecar",
 								State: corev1.ContainerState{
-									Running: &corev1.ContainerStateRunning{
-										StartedAt: metav1.Time{Time: startTime},
+									Terminated: &corev1.ContainerStateTerminated{
+										ExitCode:    0,
+										Reason:      "Terminated",
+										Message:     "Message",
+										ContainerID: "container.ID2",
+									},
+								},
+							},
+						},
+						Phase:  corev1.PodFailed,
+						Reason: "DeadlineExceeded",
+					},
+				}
+
+				build := &buildapi.Build{
+					ObjectMeta: bld.ObjectMeta,
+					Spec:       bld.Spec,
+					Status: buildapi.BuildStatus{
+						Status: corev1alpha1.Status{
+							ObservedGeneration: originalGeneration,
+							Conditions: corev1alpha1.Conditions{
+								{
+									Type:   corev1alpha1.ConditionSucceeded,
+									Status: corev1.ConditionUnknown,
+								},
+							},
+						},
+						PodName: "build-name-build-pod",
+						StepStates: []corev1.ContainerState{
+							{
+								Terminated: &corev1.ContainerStateTerminated{
+									ExitCode:    0,
+									Reason:      "Terminated",
+									Message:     string(compressedBuildMetadata),
+									ContainerID: "container.ID",
+								},
+							},
+						},
+						StepsCompleted: []string{
+							"completion",
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									BuildMetadata: corev1alpha1.BuildpackMetadataList{
+										{
+											Id:       "some-id",
+											Version:  "some-version",
+											Homepage: "some-homepage",
+										},
+										{
+											Id:      "some-other-id",
+											Version: "some-other-version",
+										},
+									},
+									LatestImage:      "some-latest-image",
+									LatestCacheImage: "some-cache-image",
+									Stack: corev1alpha1.BuildStack{
+										RunImage: "some-run-image",
+										ID:       "some-stack-id",
+									},
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode:    0,
+												Reason:      "Terminated",
+												Message:     string(compressedBuildMetadata),
+												ContainerID: "container.ID",
+											},
+										},
+									},
+									StepsCompleted: []string{
+										"completion",
+									},
+									Steps: []buildapi.BuildStepReference{
+										{Name: "completion", ContainerName: "completion"},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+		})
+
+		when("build deduplication is enabled", func() {
+			const digest = "sha256:d3eb15a6fd25cb79039594294419de2328f14b443fa0546fa9e16f5214d61686"
+
+			var equivalentBuild *buildapi.Build
+
+			it.Before(func() {
+				enableBuildDeduplication = true
+
+				keychainFactory.AddKeychainForSecretRef(t, registry.SecretRef{
+					ServiceAccount: serviceAccountName,
+					Namespace:      namespace,
+				}, &registryfakes.FakeKeychain{Name: "build"})
+
+				fakeImageCopier.CopyStub = func(keychain authn.Keychain, src string, dst string) (string, error) {
+					tag, err := name.NewTag(dst, name.WeakValidation)
+					if err != nil {
+						return "", err
+					}
+					return tag.Context().Name() + "@" + digest, nil
+				}
+
+				equivalentBuild = bld.DeepCopy()
+				equivalentBuild.Name = "equivalent-build"
+				equivalentBuild.Spec.Tags = []string{"otherimage/name"}
+				equivalentBuild.Spec.ServiceAccountName = "other-service-account"
+				equivalentBuild.Status = buildapi.BuildStatus{
+					Status: corev1alpha1.Status{
+						Conditions: corev1alpha1.Conditions{
+							{
+								Type:   corev1alpha1.ConditionSucceeded,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					BuildMetadata: corev1alpha1.BuildpackMetadataList{
+						{
+							Id:      "some-id",
+							Version: "some-version",
+						},
+					},
+					LatestImage: "index.docker.io/otherimage/name@" + digest,
+					Stack: corev1alpha1.BuildStack{
+						RunImage: "some-run-image",
+						ID:       "some-stack-id",
+					},
+				}
+			})
+
+			it("reuses the image of a successful build with identical inputs", func() {
+				latestImage := "index.docker.io/someimage/name@" + digest
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						equivalentBuild,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:               corev1alpha1.ConditionSucceeded,
+												Status:             corev1.ConditionTrue,
+												Reason:             build.ReasonDeduplicated,
+												Message:            "reused image from build equivalent-build",
+												LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+											},
+										},
+									},
+									BuildMetadata: equivalentBuild.Status.BuildMetadata,
+									LatestImage:   latestImage,
+									PushedImages:  []string{latestImage},
+									Stack:         equivalentBuild.Status.Stack,
+								},
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 3, fakeImageCopier.CopyCallCount())
+				keychain, src, dst := fakeImageCopier.CopyArgsForCall(1)
+				assert.Equal(t, &registryfakes.FakeKeychain{Name: "build"}, keychain)
+				assert.Equal(t, equivalentBuild.Status.LatestImage, src)
+				assert.Equal(t, "someimage/name:tag2", dst)
+			})
+
+			it("schedules a pod when no build has identical inputs", func() {
+				equivalentBuild.Spec.Source.Git.Revision = "other-revision"
+
+				buildPod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						equivalentBuild,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						buildPod,
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:               corev1alpha1.ConditionSucceeded,
+												Status:             corev1.ConditionUnknown,
+												LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+								},
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 0, fakeImageCopier.CopyCallCount())
+			})
+
+			it("schedules a pod when the image cannot be copied", func() {
+				fakeImageCopier.CopyStub = nil
+				fakeImageCopier.CopyReturns("", errors.New("some copy error"))
+
+				buildPod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						equivalentBuild,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						buildPod,
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Build{
+								ObjectMeta: bld.ObjectMeta,
+								Spec:       bld.Spec,
+								Status: buildapi.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:               corev1alpha1.ConditionSucceeded,
+												Status:             corev1.ConditionUnknown,
+												LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+											},
+										},
 									},
+									PodName: "build-name-build-pod",
 								},
 							},
 						},
-						Phase: corev1.PodRunning,
-					},
-				}
-
-				deadlinePatch, err := json.Marshal(map[string]interface{}{
-					"spec": map[string]interface{}{
-						"activeDeadlineSeconds": 1,
 					},
 				})
+			})
+
+			it("does not reuse images for builds that are signed", func() {
+				bld.Spec.Cosign = &buildapi.CosignConfig{}
+				equivalentBuild.Spec.Cosign = &buildapi.CosignConfig{}
+
+				buildPod, err := podGenerator.Generate(ctx, bld)
 				require.NoError(t, err)
 
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
 						bld,
-						pod,
+						equivalentBuild,
 					},
 					WantErr: false,
-					WantPatches: []clientgotesting.PatchActionImpl{
-						{
-							Name:      pod.Name,
-							PatchType: types.MergePatchType,
-							Patch:     deadlinePatch,
-						},
+					WantCreates: []runtime.Object{
+						buildPod,
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -1103,88 +2383,55 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 										ObservedGeneration: originalGeneration,
 										Conditions: corev1alpha1.Conditions{
 											{
-												Type:   corev1alpha1.ConditionSucceeded,
-												Status: corev1.ConditionUnknown,
+												Type:               corev1alpha1.ConditionSucceeded,
+												Status:             corev1.ConditionUnknown,
+												LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
 											},
 										},
 									},
 									PodName: "build-name-build-pod",
-									StepStates: []corev1.ContainerState{
-										{
-											Terminated: &corev1.ContainerStateTerminated{
-												ExitCode:    0,
-												Reason:      "Terminated",
-												Message:     "Message",
-												ContainerID: "container.ID",
-											},
-										},
-									},
-									StepsCompleted: []string{
-										"completion",
-									},
 								},
 							},
 						},
 					},
 				})
+
+				require.Equal(t, 0, fakeImageCopier.CopyCallCount())
 			})
+		})
 
-			it("marks build as successful if completion completes even if pod fails", func() {
+		when("build quotas are configured", func() {
+			var (
+				otherBuild *buildapi.Build
+				otherPod   *corev1.Pod
+				quota      *buildapi.BuildQuota
+			)
 
-				compressedBuildMetadata, err := os.ReadFile(filepath.Join("testdata", "metadata"))
-				require.NoError(t, err)
+			it.Before(func() {
+				enqueuedAfter = nil
 
-				deadline := int64(1)
-				pod := &corev1.Pod{
-					TypeMeta: metav1.TypeMeta{},
+				otherBuild = bld.DeepCopy()
+				otherBuild.Name = "other-build"
+				otherBuild.Status = buildapi.BuildStatus{PodName: "other-build-pod"}
+
+				otherPod = &corev1.Pod{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      bld.GetName() + "-build-pod",
-						Namespace: bld.GetNamespace(),
-					},
-					Spec: corev1.PodSpec{
-						ActiveDeadlineSeconds: &deadline,
-						Containers: []corev1.Container{
-							{
-								Name:  "completion",
-								Image: "completion-image",
-							},
-							{
-								Name:  "sidecar",
-								Image: "sidecar-image",
-							},
-						},
+						Name:              "other-build-pod",
+						Namespace:         namespace,
+						CreationTimestamp: metav1.NewTime(time.Now().Add(-30 * time.Minute)),
 					},
-					Status: corev1.PodStatus{
-						ContainerStatuses: []corev1.ContainerStatus{
-							{
-								Name: "completion",
-								State: corev1.ContainerState{
-									Terminated: &corev1.ContainerStateTerminated{
-										ExitCode:    0,
-										Reason:      "Terminated",
-										Message:     string(compressedBuildMetadata),
-										ContainerID: "container.ID",
-									},
-								},
-							},
-							{
-								Name: "sidecar",
-								State: corev1.ContainerState{
-									Terminated: &corev1.ContainerStateTerminated{
-										ExitCode:    0,
-										Reason:      "Terminated",
-										Message:     "Message",
-										ContainerID: "container.ID2",
-									},
-								},
-							},
-						},
-						Phase:  corev1.PodFailed,
-						Reason: "DeadlineExceeded",
+				}
+
+				quota = &buildapi.BuildQuota{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-quota",
+						Namespace: namespace,
 					},
 				}
+			})
 
-				build := &buildapi.Build{
+			queuedBuild := func(message string) *buildapi.Build {
+				return &buildapi.Build{
 					ObjectMeta: bld.ObjectMeta,
 					Spec:       bld.Spec,
 					Status: buildapi.BuildStatus{
@@ -1192,35 +2439,90 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 							ObservedGeneration: originalGeneration,
 							Conditions: corev1alpha1.Conditions{
 								{
-									Type:   corev1alpha1.ConditionSucceeded,
-									Status: corev1.ConditionUnknown,
-								},
-							},
-						},
-						PodName: "build-name-build-pod",
-						StepStates: []corev1.ContainerState{
-							{
-								Terminated: &corev1.ContainerStateTerminated{
-									ExitCode:    0,
-									Reason:      "Terminated",
-									Message:     string(compressedBuildMetadata),
-									ContainerID: "container.ID",
+									Type:               corev1alpha1.ConditionSucceeded,
+									Status:             corev1.ConditionUnknown,
+									Reason:             buildapi.BuildQuotaExceededReason,
+									Message:            message,
+									LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
 								},
 							},
 						},
-						StepsCompleted: []string{
-							"completion",
+					},
+				}
+			}
+
+			it("queues the build when the concurrent builds are exhausted", func() {
+				maxConcurrentBuilds := int64(1)
+				quota.Spec.MaxConcurrentBuilds = &maxConcurrentBuilds
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						otherBuild,
+						otherPod,
+						quota,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: queuedBuild("build queued by build quota some-quota: 1 of 1 concurrent builds running"),
 						},
 					},
+				})
+
+				assert.Equal(t, []time.Duration{30 * time.Second}, enqueuedAfter)
+			})
+
+			it("queues the build until an hourly build is available", func() {
+				maxBuildsPerHour := int64(1)
+				quota.Spec.MaxBuildsPerHour = &maxBuildsPerHour
+				otherBuild.Status.Conditions = corev1alpha1.Conditions{
+					{
+						Type:   corev1alpha1.ConditionSucceeded,
+						Status: corev1.ConditionTrue,
+					},
 				}
 
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
-						build,
-						pod,
+						bld,
+						otherBuild,
+						otherPod,
+						quota,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: queuedBuild("build queued by build quota some-quota: 1 of 1 builds started in the last hour"),
+						},
+					},
+				})
+
+				require.Len(t, enqueuedAfter, 1)
+				assert.InDelta(t, 30*time.Minute, enqueuedAfter[0], float64(time.Minute))
+			})
+
+			it("schedules a pod within the quota", func() {
+				maxConcurrentBuilds := int64(2)
+				quota.Spec.MaxConcurrentBuilds = &maxConcurrentBuilds
+
+				buildPod, err := podGenerator.Generate(ctx, bld)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						bld,
+						otherBuild,
+						otherPod,
+						quota,
 					},
 					WantErr: false,
+					WantCreates: []runtime.Object{
+						buildPod,
+					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
 							Object: &buildapi.Build{
@@ -1231,47 +2533,133 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 										ObservedGeneration: originalGeneration,
 										Conditions: corev1alpha1.Conditions{
 											{
-												Type:   corev1alpha1.ConditionSucceeded,
-												Status: corev1.ConditionTrue,
+												Type:               corev1alpha1.ConditionSucceeded,
+												Status:             corev1.ConditionUnknown,
+												LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
 											},
 										},
 									},
 									PodName: "build-name-build-pod",
-									BuildMetadata: corev1alpha1.BuildpackMetadataList{
-										{
-											Id:       "some-id",
-											Version:  "some-version",
-											Homepage: "some-homepage",
-										},
-										{
-											Id:      "some-other-id",
-											Version: "some-other-version",
-										},
-									},
-									LatestImage:      "some-latest-image",
-									LatestCacheImage: "some-cache-image",
-									Stack: corev1alpha1.BuildStack{
-										RunImage: "some-run-image",
-										ID:       "some-stack-id",
-									},
-									StepStates: []corev1.ContainerState{
-										{
-											Terminated: &corev1.ContainerStateTerminated{
-												ExitCode:    0,
-												Reason:      "Terminated",
-												Message:     string(compressedBuildMetadata),
-												ContainerID: "container.ID",
-											},
-										},
-									},
-									StepsCompleted: []string{
-										"completion",
-									},
 								},
 							},
 						},
 					},
 				})
+
+				assert.Empty(t, enqueuedAfter)
+			})
+
+			when("other builds are queued", func() {
+				var (
+					rebuild    *buildapi.Build
+					firstBuild *buildapi.Build
+				)
+
+				it.Before(func() {
+					maxConcurrentBuilds := int64(2)
+					quota.Spec.MaxConcurrentBuilds = &maxConcurrentBuilds
+
+					rebuild = bld.DeepCopy()
+					rebuild.Labels = map[string]string{buildapi.BuildNumberLabel: "2"}
+					rebuild.Annotations = map[string]string{buildapi.BuildReasonAnnotation: buildapi.BuildReasonStack}
+
+					firstBuild = bld.DeepCopy()
+					firstBuild.Name = "first-build"
+					firstBuild.Labels = map[string]string{buildapi.BuildNumberLabel: "1"}
+					firstBuild.Annotations = map[string]string{buildapi.BuildReasonAnnotation: buildapi.BuildReasonConfig}
+					firstBuild.Status.Conditions = corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionUnknown,
+							Reason: buildapi.BuildQuotaExceededReason,
+						},
+					}
+				})
+
+				it.After(func() {
+					prioritizeFirstBuilds = false
+				})
+
+				it("queues a rebuild behind a queued first build", func() {
+					prioritizeFirstBuilds = true
+
+					expected := rebuild.DeepCopy()
+					expected.Status = buildapi.BuildStatus{
+						Status: corev1alpha1.Status{
+							ObservedGeneration: originalGeneration,
+							Conditions: corev1alpha1.Conditions{
+								{
+									Type:               corev1alpha1.ConditionSucceeded,
+									Status:             corev1.ConditionUnknown,
+									Reason:             buildapi.BuildQuotaExceededReason,
+									Message:            "build queued behind higher priority build first-build",
+									LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+								},
+							},
+						},
+						SequenceNumber: 2,
+					}
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							rebuild,
+							firstBuild,
+							otherBuild,
+							otherPod,
+							quota,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expected},
+						},
+					})
+
+					assert.Equal(t, []time.Duration{30 * time.Second}, enqueuedAfter)
+				})
+
+				it("does not wait for a queued first build when first builds are not prioritized", func() {
+					firstBuild.Annotations[buildapi.BuildReasonAnnotation] = buildapi.BuildReasonStack
+
+					buildPod, err := podGenerator.Generate(ctx, rebuild)
+					require.NoError(t, err)
+
+					expected := rebuild.DeepCopy()
+					expected.Status = buildapi.BuildStatus{
+						Status: corev1alpha1.Status{
+							ObservedGeneration: originalGeneration,
+							Conditions: corev1alpha1.Conditions{
+								{
+									Type:               corev1alpha1.ConditionSucceeded,
+									Status:             corev1.ConditionUnknown,
+									LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+								},
+							},
+						},
+						PodName:        "build-name-build-pod",
+						SequenceNumber: 2,
+					}
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							rebuild,
+							firstBuild,
+							otherBuild,
+							otherPod,
+							quota,
+						},
+						WantErr: false,
+						WantCreates: []runtime.Object{
+							buildPod,
+						},
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expected},
+						},
+					})
+
+					assert.Empty(t, enqueuedAfter)
+				})
 			})
 		})
 	})
@@ -1305,6 +2693,7 @@ func (tpg testPodGenerator) Generate(ctx context.Context, build buildpod.BuildPo
 				},
 			},
 			ImagePullSecrets: build.BuilderSpec().ImagePullSecrets,
+			Volumes:          retainedWorkspaceVolumes(build),
 		},
 	}, nil
 }
@@ -1314,3 +2703,18 @@ type reactor struct {
 	resource     string
 	reactionFunc clientgotesting.ReactionFunc
 }
+
+func retainedWorkspaceVolumes(podable buildpod.BuildPodable) []corev1.Volume {
+	b, ok := podable.(*buildapi.Build)
+	if !ok || !b.RetainsFailures() {
+		return nil
+	}
+	return []corev1.Volume{
+		{
+			Name: "retained-workspace-dir",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: b.RetainedWorkspaceName()},
+			},
+		},
+	}
+}
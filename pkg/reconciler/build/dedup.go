@@ -0,0 +1,110 @@
+package build
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/logging"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const ReasonDeduplicated = "Deduplicated"
+
+//go:generate counterfeiter . ImageCopier
+type ImageCopier interface {
+	Copy(keychain authn.Keychain, src string, dst string) (string, error)
+}
+
+// reuseEquivalentBuild completes a build that has not started by copying the
+// image of a successful build with the same inputs to the build's tags. When
+// no equivalent build exists or the image cannot be copied the build runs as
+// usual.
+func (c *Reconciler) reuseEquivalentBuild(ctx context.Context, build *buildapi.Build) (bool, error) {
+	if !build.Reproducible() || build.Spec.RelocateRunImage || build.Status.PodName != "" {
+		return false, nil
+	}
+
+	_, err := c.PodLister.Pods(build.Namespace).Get(build.PodName())
+	if err == nil {
+		return false, nil
+	} else if !k8s_errors.IsNotFound(err) {
+		return false, err
+	}
+
+	equivalent, err := c.equivalentBuild(build)
+	if err != nil || equivalent == nil {
+		return false, err
+	}
+
+	logger := logging.FromContext(ctx)
+
+	keychain, err := c.KeychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{
+		ServiceAccount: build.Spec.ServiceAccountName,
+		Namespace:      build.Namespace,
+	})
+	if err != nil {
+		logger.Infof("unable to reuse build %s: %s", equivalent.Name, err)
+		return false, nil
+	}
+
+	var latestImage string
+	for _, tag := range build.Spec.Tags {
+		identifier, err := c.ImageCopier.Copy(keychain, equivalent.Status.LatestImage, tag)
+		if err != nil {
+			logger.Infof("unable to reuse build %s: %s", equivalent.Name, err)
+			return false, nil
+		}
+
+		if latestImage == "" {
+			latestImage = identifier
+		}
+	}
+
+	build.Status.BuildMetadata = equivalent.Status.BuildMetadata
+	build.Status.LatestImage = latestImage
+	build.Status.PushedImages = build.PushedImages(latestImage)
+	build.Status.Stack = equivalent.Status.Stack
+	build.Status.Conditions = corev1alpha1.Conditions{
+		{
+			Type:               corev1alpha1.ConditionSucceeded,
+			Status:             corev1.ConditionTrue,
+			Reason:             ReasonDeduplicated,
+			Message:            fmt.Sprintf("reused image from build %s", equivalent.Name),
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+	return true, nil
+}
+
+func (c *Reconciler) equivalentBuild(build *buildapi.Build) (*buildapi.Build, error) {
+	builds, err := c.Lister.Builds(build.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	inputHash := build.InputHash()
+
+	var equivalent *buildapi.Build
+	for _, b := range builds {
+		if b.Name == build.Name || !b.IsSuccess() || b.Status.LatestImage == "" {
+			continue
+		}
+
+		if equivalent != nil && !equivalent.CreationTimestamp.Before(&b.CreationTimestamp) {
+			continue
+		}
+
+		if b.InputHash() == inputHash {
+			equivalent = b
+		}
+	}
+	return equivalent, nil
+}
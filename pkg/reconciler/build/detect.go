@@ -0,0 +1,67 @@
+package build
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	ReasonNoBuildpackDetected = "NoBuildpackDetected"
+
+	// exit codes of the lifecycle detector when no buildpack group passes
+	// detection, without and with buildpack errors
+	detectFailedExitCode           = 20
+	detectFailedWithErrorsExitCode = 21
+
+	maxDetectOutputBytes = 2048
+	truncatedPrefix      = "...\n"
+)
+
+// failedDetect returns the terminated state of the detect step if no
+// buildpack group passed detection. The detect container falls back to its
+// logs for the termination message, so the message holds the end of the
+// detect output.
+func failedDetect(pod *corev1.Pod) *corev1.ContainerStateTerminated {
+	for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if s.Name != buildapi.DetectContainerName || s.State.Terminated == nil {
+			continue
+		}
+
+		switch s.State.Terminated.ExitCode {
+		case detectFailedExitCode, detectFailedWithErrorsExitCode:
+			return s.State.Terminated
+		}
+	}
+	return nil
+}
+
+func detectOutput(pod *corev1.Pod) string {
+	terminated := failedDetect(pod)
+	if terminated == nil {
+		return ""
+	}
+
+	output := strings.TrimSpace(terminated.Message)
+	if len(output) <= maxDetectOutputBytes {
+		return output
+	}
+	return truncatedPrefix + strings.ToValidUTF8(output[len(output)-maxDetectOutputBytes+len(truncatedPrefix):], "")
+}
+
+func noBuildpackDetectedCondition(pod *corev1.Pod) corev1alpha1.Conditions {
+	return corev1alpha1.Conditions{
+		{
+			Type:               corev1alpha1.ConditionSucceeded,
+			Status:             corev1.ConditionFalse,
+			Reason:             ReasonNoBuildpackDetected,
+			Message:            fmt.Sprintf("no buildpack group passed detection, run 'kubectl logs -n %s %s -c %s' for the full detect output", pod.Namespace, pod.Name, buildapi.DetectContainerName),
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
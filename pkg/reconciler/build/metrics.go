@@ -0,0 +1,113 @@
+package build
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"knative.dev/pkg/metrics"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+var (
+	layersM = stats.Int64(
+		"build_layers",
+		"Number of app layers produced by a build",
+		stats.UnitDimensionless)
+	reusedLayersM = stats.Int64(
+		"build_reused_layers",
+		"Number of app layers reused from the previous build",
+		stats.UnitDimensionless)
+	cacheLayersM = stats.Int64(
+		"build_cache_layers",
+		"Number of layers in the cache image of a build",
+		stats.UnitDimensionless)
+	restoredCacheLayersM = stats.Int64(
+		"build_restored_cache_layers",
+		"Number of cache layers restored from the previous build",
+		stats.UnitDimensionless)
+	cacheHitRatioM = stats.Int64(
+		"build_cache_hit_ratio_percent",
+		"Percentage of app and cache layers reused from the previous build",
+		stats.UnitDimensionless)
+	cpuMillicoreSecondsM = stats.Int64(
+		"build_cpu_millicore_seconds",
+		"CPU reserved by finished builds in millicores multiplied by the seconds it was reserved",
+		stats.UnitDimensionless)
+	memoryByteSecondsM = stats.Int64(
+		"build_memory_byte_seconds",
+		"Memory reserved by finished builds in bytes multiplied by the seconds it was reserved",
+		stats.UnitDimensionless)
+
+	namespaceKey = tag.MustNewKey("namespace")
+	imageKey     = tag.MustNewKey("image")
+)
+
+func init() {
+	tagKeys := []tag.Key{namespaceKey, imageKey}
+
+	var views []*view.View
+	for _, m := range []*stats.Int64Measure{layersM, reusedLayersM, cacheLayersM, restoredCacheLayersM, cpuMillicoreSecondsM, memoryByteSecondsM} {
+		views = append(views, &view.View{
+			Description: m.Description(),
+			Measure:     m,
+			Aggregation: view.Sum(),
+			TagKeys:     tagKeys,
+		})
+	}
+	views = append(views, &view.View{
+		Description: cacheHitRatioM.Description(),
+		Measure:     cacheHitRatioM,
+		Aggregation: view.LastValue(),
+		TagKeys:     tagKeys,
+	})
+
+	if err := view.Register(views...); err != nil {
+		panic(err)
+	}
+}
+
+func recordCacheMetrics(ctx context.Context, build *buildapi.Build) {
+	cacheMetrics := build.Status.CacheMetrics
+	if cacheMetrics == nil {
+		return
+	}
+
+	ctx, err := tag.New(ctx,
+		tag.Insert(namespaceKey, build.Namespace),
+		tag.Insert(imageKey, build.Labels[buildapi.ImageLabel]),
+	)
+	if err != nil {
+		return
+	}
+
+	metrics.RecordBatch(ctx,
+		layersM.M(cacheMetrics.Layers),
+		reusedLayersM.M(cacheMetrics.ReusedLayers),
+		cacheLayersM.M(cacheMetrics.CacheLayers),
+		restoredCacheLayersM.M(cacheMetrics.RestoredCacheLayers),
+		cacheHitRatioM.M(cacheMetrics.HitRatioPercent),
+	)
+}
+
+func recordResourceUsage(ctx context.Context, build *buildapi.Build) {
+	resourceUsage := build.Status.ResourceUsage
+	if resourceUsage == nil {
+		return
+	}
+
+	ctx, err := tag.New(ctx,
+		tag.Insert(namespaceKey, build.Namespace),
+		tag.Insert(imageKey, build.Labels[buildapi.ImageLabel]),
+	)
+	if err != nil {
+		return
+	}
+
+	metrics.RecordBatch(ctx,
+		cpuMillicoreSecondsM.M(resourceUsage.CPUMillicoreSeconds),
+		memoryByteSecondsM.M(resourceUsage.MemoryByteSeconds),
+	)
+}
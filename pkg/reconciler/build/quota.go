@@ -0,0 +1,165 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+// quotaRetryPeriod is how long a build queued on the concurrent build quota
+// waits before it is admitted again.
+const quotaRetryPeriod = 30 * time.Second
+
+type buildUsage struct {
+	running int64
+	started []time.Time
+}
+
+// admitBuild reports whether the pod of a build may be started under the
+// BuildQuotas of its namespace. Builds that exceed a quota, or that would
+// start before a queued build with a higher scheduling priority, are marked
+// as queued and retried once the quota may allow them.
+func (c *Reconciler) admitBuild(ctx context.Context, build *buildapi.Build, prioritizeFirstBuilds bool) (bool, error) {
+	if build.Status.PodName != "" {
+		return true, nil
+	}
+
+	_, err := c.PodLister.Pods(build.Namespace).Get(build.PodName())
+	if err == nil {
+		return true, nil
+	} else if !k8s_errors.IsNotFound(err) {
+		return false, err
+	}
+
+	quotas, err := c.BuildQuotaLister.BuildQuotas(build.Namespace).List(labels.Everything())
+	if err != nil || len(quotas) == 0 {
+		return err == nil, err
+	}
+
+	now := time.Now()
+	usage, err := c.buildUsage(build, now)
+	if err != nil {
+		return false, err
+	}
+
+	for _, quota := range quotas {
+		message, retryAfter, exceeded := usage.exceeds(quota, now)
+		if !exceeded {
+			continue
+		}
+
+		c.queueBuild(build, fmt.Sprintf("build queued by build quota %s: %s", quota.Name, message), retryAfter)
+		return false, nil
+	}
+
+	ahead, err := c.queuedAhead(build, prioritizeFirstBuilds)
+	if err != nil {
+		return false, err
+	}
+	if ahead != nil {
+		c.queueBuild(build, fmt.Sprintf("build queued behind higher priority build %s", ahead.Name), quotaRetryPeriod)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (c *Reconciler) queueBuild(build *buildapi.Build, message string, retryAfter time.Duration) {
+	build.Status.Conditions = corev1alpha1.Conditions{
+		{
+			Type:               corev1alpha1.ConditionSucceeded,
+			Status:             corev1.ConditionUnknown,
+			Reason:             buildapi.BuildQuotaExceededReason,
+			Message:            message,
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+	c.EnqueueAfter(build, retryAfter)
+}
+
+// queuedAhead returns a build of the namespace that is queued by a build
+// quota and has a higher scheduling priority than build.
+func (c *Reconciler) queuedAhead(build *buildapi.Build, prioritizeFirstBuilds bool) (*buildapi.Build, error) {
+	builds, err := c.Lister.Builds(build.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	priority := build.SchedulingPriority(prioritizeFirstBuilds)
+	for _, b := range builds {
+		if b.Name == build.Name || b.Status.PodName != "" || b.Finished() {
+			continue
+		}
+
+		if condition := b.Status.GetCondition(corev1alpha1.ConditionSucceeded); condition == nil || condition.Reason != buildapi.BuildQuotaExceededReason {
+			continue
+		}
+
+		if b.SchedulingPriority(prioritizeFirstBuilds) > priority {
+			return b, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Reconciler) buildUsage(build *buildapi.Build, now time.Time) (buildUsage, error) {
+	builds, err := c.Lister.Builds(build.Namespace).List(labels.Everything())
+	if err != nil {
+		return buildUsage{}, err
+	}
+
+	var usage buildUsage
+	for _, b := range builds {
+		if b.Name == build.Name || b.Status.PodName == "" {
+			continue
+		}
+
+		if !b.Finished() {
+			usage.running++
+		}
+
+		pod, err := c.PodLister.Pods(b.Namespace).Get(b.Status.PodName)
+		if k8s_errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return buildUsage{}, err
+		}
+
+		if now.Sub(pod.CreationTimestamp.Time) < time.Hour {
+			usage.started = append(usage.started, pod.CreationTimestamp.Time)
+		}
+	}
+	return usage, nil
+}
+
+func (u buildUsage) exceeds(quota *buildapi.BuildQuota, now time.Time) (string, time.Duration, bool) {
+	if max := quota.Spec.MaxConcurrentBuilds; max != nil && u.running >= *max {
+		return fmt.Sprintf("%d of %d concurrent builds running", u.running, *max), quotaRetryPeriod, true
+	}
+
+	if max := quota.Spec.MaxBuildsPerHour; max != nil && int64(len(u.started)) >= *max {
+		return fmt.Sprintf("%d of %d builds started in the last hour", len(u.started), *max), u.nextStartAllowed(*max, now), true
+	}
+	return "", 0, false
+}
+
+// nextStartAllowed returns how long it takes until fewer than max build pods
+// were started within the last hour.
+func (u buildUsage) nextStartAllowed(max int64, now time.Time) time.Duration {
+	started := append([]time.Time(nil), u.started...)
+	sort.Slice(started, func(i, j int) bool { return started[i].Before(started[j]) })
+
+	index := int64(len(started)) - max
+	if index < 0 || index >= int64(len(started)) {
+		return quotaRetryPeriod
+	}
+	return started[index].Add(time.Hour).Sub(now)
+}
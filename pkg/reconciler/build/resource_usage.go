@@ -0,0 +1,46 @@
+package build
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+// resourceUsage multiplies the cpu and memory reserved by each terminated
+// container of the build pod by the time it ran. Containers without requests
+// are charged their limits. It returns nil if no container reports when it
+// ran.
+func resourceUsage(pod *corev1.Pod) *corev1alpha1.BuildResourceUsage {
+	terminated := map[string]*corev1.ContainerStateTerminated{}
+	for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if s.State.Terminated != nil {
+			terminated[s.Name] = s.State.Terminated
+		}
+	}
+
+	var usage *corev1alpha1.BuildResourceUsage
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		state, ok := terminated[c.Name]
+		if !ok || state.StartedAt.IsZero() || state.FinishedAt.Before(&state.StartedAt) {
+			continue
+		}
+
+		if usage == nil {
+			usage = &corev1alpha1.BuildResourceUsage{}
+		}
+
+		millis := state.FinishedAt.Sub(state.StartedAt.Time).Milliseconds()
+		usage.CPUMillicoreSeconds += reserved(c.Resources, corev1.ResourceCPU).MilliValue() * millis / 1000
+		usage.MemoryByteSeconds += reserved(c.Resources, corev1.ResourceMemory).Value() * millis / 1000
+	}
+	return usage
+}
+
+func reserved(resources corev1.ResourceRequirements, name corev1.ResourceName) *resource.Quantity {
+	if quantity, ok := resources.Requests[name]; ok {
+		return &quantity
+	}
+	quantity := resources.Limits[name]
+	return &quantity
+}
@@ -0,0 +1,105 @@
+package build
+
+import (
+	"context"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+// reconcileRetainedWorkspace creates the claim the build pod keeps its
+// workspace and layers on when failures of the build are retained.
+func (c *Reconciler) reconcileRetainedWorkspace(ctx context.Context, build *buildapi.Build, pod *corev1.Pod) error {
+	if !usesClaim(pod, build.RetainedWorkspaceName()) {
+		return nil
+	}
+
+	_, err := c.PvcLister.PersistentVolumeClaims(build.Namespace).Get(build.RetainedWorkspaceName())
+	if !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	_, err = c.K8sClient.CoreV1().PersistentVolumeClaims(build.Namespace).Create(ctx, build.RetainedWorkspace(), metav1.CreateOptions{})
+	if k8s_errors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// reconcileFailureRetention keeps a debug pod running against the retained
+// workspace of a failed build until its TTL expires and releases the
+// workspace once it is no longer needed.
+func (c *Reconciler) reconcileFailureRetention(ctx context.Context, build *buildapi.Build) error {
+	if !build.RetainsFailures() {
+		return nil
+	}
+
+	workspace, err := c.PvcLister.PersistentVolumeClaims(build.Namespace).Get(build.RetainedWorkspaceName())
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	remaining := time.Until(build.RetainedUntil())
+	if build.IsFailure() && remaining > 0 && workspace != nil {
+		return c.retainFailure(ctx, build, remaining)
+	}
+
+	return c.releaseFailure(ctx, build, workspace)
+}
+
+func (c *Reconciler) retainFailure(ctx context.Context, build *buildapi.Build, remaining time.Duration) error {
+	_, err := c.PodLister.Pods(build.Namespace).Get(build.DebugPodName())
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	if k8s_errors.IsNotFound(err) {
+		activeDeadlineSeconds := int64(math.Ceil(remaining.Seconds()))
+		_, err = c.K8sClient.CoreV1().Pods(build.Namespace).Create(ctx, build.DebugPod(activeDeadlineSeconds), metav1.CreateOptions{})
+		if err != nil && !k8s_errors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	build.Status.DebugPodName = build.DebugPodName()
+	c.EnqueueAfter(build, remaining)
+	return nil
+}
+
+func (c *Reconciler) releaseFailure(ctx context.Context, build *buildapi.Build, workspace *corev1.PersistentVolumeClaim) error {
+	_, err := c.PodLister.Pods(build.Namespace).Get(build.DebugPodName())
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	if err == nil {
+		err = c.K8sClient.CoreV1().Pods(build.Namespace).Delete(ctx, build.DebugPodName(), metav1.DeleteOptions{})
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if workspace != nil {
+		err = c.K8sClient.CoreV1().PersistentVolumeClaims(build.Namespace).Delete(ctx, workspace.Name, metav1.DeleteOptions{})
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	build.Status.DebugPodName = ""
+	return nil
+}
+
+func usesClaim(pod *corev1.Pod, claimName string) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName {
+			return true
+		}
+	}
+	return false
+}
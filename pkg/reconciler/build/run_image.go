@@ -0,0 +1,110 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/config"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const ReasonRunImageNotReachable = "RunImageNotReachable"
+
+//go:generate counterfeiter . RunImageVerifier
+type RunImageVerifier interface {
+	VerifyDigest(keychain authn.Keychain, image string) error
+}
+
+// relocateRunImage copies the run image of a successful build into the
+// repository of the built image, tagged with the run image digest.
+func (c *Reconciler) relocateRunImage(ctx context.Context, build *buildapi.Build) error {
+	if !build.Spec.RelocateRunImage || build.Status.Stack.RunImage == "" || build.Status.RelocatedRunImage != "" {
+		return nil
+	}
+
+	runImage, err := name.ParseReference(build.Status.Stack.RunImage, name.WeakValidation)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse run image %s", build.Status.Stack.RunImage)
+	}
+
+	appImage, err := name.ParseReference(build.Tag(), name.WeakValidation)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse tag %s", build.Tag())
+	}
+
+	keychain, err := c.keychainForBuild(ctx, build)
+	if err != nil {
+		return errors.Wrap(err, "unable to create run image keychain")
+	}
+
+	tag := appImage.Context().Tag(relocatedRunImageTag(runImage))
+	relocated, err := c.ImageCopier.Copy(keychain, runImage.Name(), tag.Name())
+	if err != nil {
+		return errors.Wrapf(err, "unable to relocate run image to %s", tag.Name())
+	}
+
+	build.Status.RelocatedRunImage = relocated
+	return nil
+}
+
+func relocatedRunImageTag(runImage name.Reference) string {
+	identifier := runImage.Identifier()
+	if digest, ok := runImage.(name.Digest); ok {
+		identifier = digest.DigestStr()
+	}
+	return "run-" + strings.ReplaceAll(identifier, ":", "-")
+}
+
+// verifyRunImage fails a successful build when its run image cannot be found
+// in the registry namespace app clusters pull run images from. Builds of
+// images without a configured run image registry and builds with a relocated
+// run image are not verified.
+func (c *Reconciler) verifyRunImage(ctx context.Context, build *buildapi.Build, kpackConfig config.KpackConfig) error {
+	runImageRegistry, ok := kpackConfig.RunImageRegistryFor(build.Tag())
+	if !ok || build.Status.Stack.RunImage == "" || build.Status.RelocatedRunImage != "" {
+		return nil
+	}
+
+	runImage := build.Status.Stack.RunImage
+	if !strings.HasPrefix(runImage, runImageRegistry+"/") {
+		relocated, err := config.RelocateImage(runImage, runImageRegistry)
+		if err != nil {
+			return errors.Wrapf(err, "unable to relocate run image %s", runImage)
+		}
+		runImage = relocated
+	}
+
+	keychain, err := c.keychainForBuild(ctx, build)
+	if err != nil {
+		return errors.Wrap(err, "unable to create run image keychain")
+	}
+
+	if err := c.RunImageVerifier.VerifyDigest(keychain, runImage); err != nil {
+		build.Status.Conditions = corev1alpha1.Conditions{
+			{
+				Type:               corev1alpha1.ConditionSucceeded,
+				Status:             corev1.ConditionFalse,
+				Reason:             ReasonRunImageNotReachable,
+				Message:            fmt.Sprintf("run image %s is not reachable from app clusters: %s", runImage, err),
+				LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+			},
+		}
+	}
+	return nil
+}
+
+func (c *Reconciler) keychainForBuild(ctx context.Context, build *buildapi.Build) (authn.Keychain, error) {
+	return c.KeychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{
+		ServiceAccount: build.Spec.ServiceAccountName,
+		Namespace:      build.Namespace,
+	})
+}
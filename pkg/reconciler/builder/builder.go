@@ -46,6 +46,8 @@ func NewController(
 	buildpackInformer buildinformers.BuildpackInformer,
 	clusterBuildpackInformer buildinformers.ClusterBuildpackInformer,
 	clusterStackInformer buildinformers.ClusterStackInformer,
+	storeInformer buildinformers.StoreInformer,
+	stackInformer buildinformers.StackInformer,
 ) (*controller.Impl, func()) {
 	c := &Reconciler{
 		Client:                 opt.Client,
@@ -56,6 +58,8 @@ func NewController(
 		BuildpackLister:        buildpackInformer.Lister(),
 		ClusterBuildpackLister: clusterBuildpackInformer.Lister(),
 		ClusterStackLister:     clusterStackInformer.Lister(),
+		StoreLister:            storeInformer.Lister(),
+		StackLister:            stackInformer.Lister(),
 	}
 
 	logger := opt.Logger.With(
@@ -82,6 +86,16 @@ func NewController(
 			c.Tracker.OnChanged,
 			buildapi.SchemeGroupVersion.WithKind(buildapi.ClusterStackKind)),
 	))
+	storeInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(
+			c.Tracker.OnChanged,
+			buildapi.SchemeGroupVersion.WithKind(buildapi.StoreKind)),
+	))
+	stackInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(
+			c.Tracker.OnChanged,
+			buildapi.SchemeGroupVersion.WithKind(buildapi.StackKind)),
+	))
 	buildpackInformer.Informer().AddEventHandler(controller.HandleAll(
 		controller.EnsureTypeMeta(
 			c.Tracker.OnChanged,
@@ -108,6 +122,8 @@ type Reconciler struct {
 	BuildpackLister        buildlisters.BuildpackLister
 	ClusterBuildpackLister buildlisters.ClusterBuildpackLister
 	ClusterStackLister     buildlisters.ClusterStackLister
+	StoreLister            buildlisters.StoreLister
+	StackLister            buildlisters.StackLister
 }
 
 func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
@@ -141,37 +157,11 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 }
 
 func (c *Reconciler) reconcileBuilder(ctx context.Context, builder *buildapi.Builder) (buildapi.BuilderRecord, error) {
-	c.Tracker.Track(reconciler.Key{
-		NamespacedName: types.NamespacedName{
-			Name:      builder.Spec.Stack.Name,
-			Namespace: metav1.NamespaceAll,
-		},
-		GroupKind: schema.GroupKind{
-			Group: "kpack.io",
-			Kind:  buildapi.ClusterStackKind,
-		},
-	}, builder.NamespacedName())
+	c.trackStack(builder)
 
-	var (
-		clusterStore *buildapi.ClusterStore
-		err          error
-	)
-	if builder.Spec.Store.Name != "" {
-		c.Tracker.Track(reconciler.Key{
-			NamespacedName: types.NamespacedName{
-				Name:      builder.Spec.Store.Name,
-				Namespace: metav1.NamespaceAll,
-			},
-			GroupKind: schema.GroupKind{
-				Group: "kpack.io",
-				Kind:  buildapi.ClusterStoreKind,
-			},
-		}, builder.NamespacedName())
-
-		clusterStore, err = c.ClusterStoreLister.Get(builder.Spec.Store.Name)
-		if err != nil {
-			return buildapi.BuilderRecord{}, err
-		}
+	clusterStore, err := c.getStore(builder)
+	if err != nil {
+		return buildapi.BuilderRecord{}, err
 	}
 
 	c.Tracker.TrackKind(schema.GroupKind{
@@ -194,7 +184,7 @@ func (c *Reconciler) reconcileBuilder(ctx context.Context, builder *buildapi.Bui
 		return buildapi.BuilderRecord{}, err
 	}
 
-	clusterStack, err := c.ClusterStackLister.Get(builder.Spec.Stack.Name)
+	clusterStack, err := c.getStack(builder)
 	if err != nil {
 		return buildapi.BuilderRecord{}, err
 	}
@@ -221,6 +211,62 @@ func (c *Reconciler) reconcileBuilder(ctx context.Context, builder *buildapi.Bui
 	return buildRecord, nil
 }
 
+// getStore returns the store referenced by the builder. A namespaced Store is
+// returned as a ClusterStore so it can be used to resolve buildpacks.
+func (c *Reconciler) getStore(builder *buildapi.Builder) (*buildapi.ClusterStore, error) {
+	if builder.Spec.Store.Name == "" {
+		return nil, nil
+	}
+
+	if builder.Spec.Store.Kind == buildapi.StoreKind {
+		c.Tracker.Track(trackingKey(builder.Spec.Store.Name, builder.Namespace, buildapi.StoreKind), builder.NamespacedName())
+
+		store, err := c.StoreLister.Stores(builder.Namespace).Get(builder.Spec.Store.Name)
+		if err != nil {
+			return nil, err
+		}
+		return store.ClusterStore(), nil
+	}
+
+	c.Tracker.Track(trackingKey(builder.Spec.Store.Name, metav1.NamespaceAll, buildapi.ClusterStoreKind), builder.NamespacedName())
+	return c.ClusterStoreLister.Get(builder.Spec.Store.Name)
+}
+
+// getStack returns the stack referenced by the builder. A namespaced Stack is
+// returned as a ClusterStack so it can be used to create the builder.
+func (c *Reconciler) getStack(builder *buildapi.Builder) (*buildapi.ClusterStack, error) {
+	if builder.Spec.Stack.Kind == buildapi.StackKind {
+		stack, err := c.StackLister.Stacks(builder.Namespace).Get(builder.Spec.Stack.Name)
+		if err != nil {
+			return nil, err
+		}
+		return stack.ClusterStack(), nil
+	}
+
+	return c.ClusterStackLister.Get(builder.Spec.Stack.Name)
+}
+
+func (c *Reconciler) trackStack(builder *buildapi.Builder) {
+	if builder.Spec.Stack.Kind == buildapi.StackKind {
+		c.Tracker.Track(trackingKey(builder.Spec.Stack.Name, builder.Namespace, buildapi.StackKind), builder.NamespacedName())
+		return
+	}
+	c.Tracker.Track(trackingKey(builder.Spec.Stack.Name, metav1.NamespaceAll, buildapi.ClusterStackKind), builder.NamespacedName())
+}
+
+func trackingKey(name, namespace, kind string) reconciler.Key {
+	return reconciler.Key{
+		NamespacedName: types.NamespacedName{
+			Name:      name,
+			Namespace: namespace,
+		},
+		GroupKind: schema.GroupKind{
+			Group: "kpack.io",
+			Kind:  kind,
+		},
+	}
+}
+
 func (c *Reconciler) updateStatus(ctx context.Context, desired *buildapi.Builder) error {
 	desired.Status.ObservedGeneration = desired.Generation
 
@@ -61,6 +61,8 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 				BuildpackLister:        listers.GetBuildpackLister(),
 				ClusterBuildpackLister: listers.GetClusterBuildpackLister(),
 				ClusterStackLister:     listers.GetClusterStackLister(),
+				StoreLister:            listers.GetStoreLister(),
+				StackLister:            listers.GetStackLister(),
 			}
 			return &kreconciler.NetworkErrorReconciler{Reconciler: r}, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
 		})
@@ -129,7 +131,7 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 			BuilderSpec: buildapi.BuilderSpec{
 				Tag: builderTag,
 				Stack: corev1.ObjectReference{
-					Kind: "Stack",
+					Kind: "ClusterStack",
 					Name: "some-stack",
 				},
 				Store: corev1.ObjectReference{
@@ -255,6 +257,101 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 			}}, builderCreator.CreateBuilderCalls)
 		})
 
+		it("resolves a namespaced stack and store from the builder namespace", func() {
+			store := &buildapi.Store{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "some-namespaced-store",
+					Namespace: testNamespace,
+				},
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Store",
+					APIVersion: "kpack.io/v1alpha2",
+				},
+				Spec: buildapi.StoreSpec{
+					ServiceAccountName: "store-service-account",
+				},
+			}
+
+			stack := &buildapi.Stack{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "some-namespaced-stack",
+					Namespace: testNamespace,
+				},
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Stack",
+					APIVersion: "kpack.io/v1alpha2",
+				},
+				Spec: buildapi.StackSpec{
+					ServiceAccountName: "stack-service-account",
+				},
+				Status: buildapi.StackStatus{
+					Status: corev1alpha1.Status{
+						Conditions: []corev1alpha1.Condition{
+							{
+								Type:   corev1alpha1.ConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			}
+
+			builder.Spec.Stack = corev1.ObjectReference{Kind: "Stack", Name: stack.Name}
+			builder.Spec.Store = corev1.ObjectReference{Kind: "Store", Name: store.Name}
+
+			builderCreator.Record = buildapi.BuilderRecord{
+				Image: builderIdentifier,
+				Stack: corev1alpha1.BuildStack{
+					RunImage: "example.com/run-image@sha256:123456",
+					ID:       "fake.stack.id",
+				},
+				Buildpacks: corev1alpha1.BuildpackMetadataList{},
+			}
+
+			rt.Test(rtesting.TableRow{
+				Key: builderKey,
+				Objects: []runtime.Object{
+					stack,
+					store,
+					builder,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &buildapi.Builder{
+							ObjectMeta: builder.ObjectMeta,
+							Spec:       builder.Spec,
+							Status: buildapi.BuilderStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: 1,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionReady,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								BuilderMetadata: []corev1alpha1.BuildpackMetadata{},
+								Stack: corev1alpha1.BuildStack{
+									RunImage: "example.com/run-image@sha256:123456",
+									ID:       "fake.stack.id",
+								},
+								LatestImage: builderIdentifier,
+							},
+						},
+					},
+				},
+			})
+
+			require.Len(t, builderCreator.CreateBuilderCalls, 1)
+			assert.Equal(t, stack.ClusterStack(), builderCreator.CreateBuilderCalls[0].ClusterStack)
+			assert.Equal(t, &corev1.ObjectReference{Name: "stack-service-account", Namespace: testNamespace}, builderCreator.CreateBuilderCalls[0].ClusterStack.Spec.ServiceAccountRef)
+			assert.Equal(t, cnb.NewRemoteBuildpackFetcher(keychainFactory, store.ClusterStore(), nil, nil), builderCreator.CreateBuilderCalls[0].Fetcher)
+
+			require.True(t, fakeTracker.IsTracking(kreconciler.KeyForObject(stack), builder.NamespacedName()))
+			require.True(t, fakeTracker.IsTracking(kreconciler.KeyForObject(store), builder.NamespacedName()))
+		})
+
 		it("tracks the store and buildpack sources for a custom builder", func() {
 			builderCreator.Record = buildapi.BuilderRecord{
 				Image: builderIdentifier,
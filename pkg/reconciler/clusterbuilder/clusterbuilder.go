@@ -46,6 +46,7 @@ func NewController(
 	clusterStoreInformer buildinformers.ClusterStoreInformer,
 	clusterBuildpackInformer buildinformers.ClusterBuildpackInformer,
 	clusterStackInformer buildinformers.ClusterStackInformer,
+	buildInformer buildinformers.BuildInformer,
 ) (*controller.Impl, func()) {
 	c := &Reconciler{
 		Client:                 opt.Client,
@@ -55,6 +56,7 @@ func NewController(
 		ClusterStoreLister:     clusterStoreInformer.Lister(),
 		ClusterBuildpackLister: clusterBuildpackInformer.Lister(),
 		ClusterStackLister:     clusterStackInformer.Lister(),
+		BuildLister:            buildInformer.Lister(),
 	}
 
 	logger := opt.Logger.With(
@@ -70,6 +72,18 @@ func NewController(
 	)
 	clusterBuilderInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 	clusterBuildpackInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	buildInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		build, ok := obj.(*buildapi.Build)
+		if !ok || build.Annotations[buildapi.BuilderKindAnnotation] != buildapi.ClusterBuilderKind {
+			return
+		}
+
+		builder, err := c.ClusterBuilderLister.Get(build.Annotations[buildapi.BuilderNameAnnotation])
+		if err != nil || builder.Status.Rollout == nil {
+			return
+		}
+		impl.Enqueue(builder)
+	}))
 
 	c.Tracker = tracker.New(impl.EnqueueKey, opt.TrackerResyncPeriod())
 	clusterStoreInformer.Informer().AddEventHandler(controller.HandleAll(
@@ -97,6 +111,7 @@ type Reconciler struct {
 	ClusterStoreLister     buildlisters.ClusterStoreLister
 	ClusterBuildpackLister buildlisters.ClusterBuildpackLister
 	ClusterStackLister     buildlisters.ClusterStackLister
+	BuildLister            buildlisters.BuildLister
 }
 
 func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
@@ -126,7 +141,11 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 		return creationError
 	}
 
+	stable, hasStable := builder.Status.StableRevision()
 	builder.Status.BuilderRecord(builderRecord)
+	if err := c.reconcileRollout(builder, stable, hasStable); err != nil {
+		return err
+	}
 	return c.updateStatus(ctx, builder)
 }
 
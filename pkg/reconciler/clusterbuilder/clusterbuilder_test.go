@@ -59,6 +59,7 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 				ClusterStoreLister:     listers.GetClusterStoreLister(),
 				ClusterBuildpackLister: listers.GetClusterBuildpackLister(),
 				ClusterStackLister:     listers.GetClusterStackLister(),
+				BuildLister:            listers.GetBuildLister(),
 			}
 			return &kreconciler.NetworkErrorReconciler{Reconciler: r}, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
 		})
@@ -389,6 +390,48 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
+		it("reports mixin mismatches on creation error", func() {
+			mismatches := []buildapi.BuildpackMixinMismatch{{
+				BuildpackInfo: corev1alpha1.BuildpackInfo{Id: "some-buildpack", Version: "1.0.0"},
+				MissingMixins: []string{"some-mixin"},
+			}}
+			builderCreator.CreateErr = &buildapi.MixinMismatchError{Mismatches: mismatches}
+
+			expectedBuilder := &buildapi.ClusterBuilder{
+				ObjectMeta: builder.ObjectMeta,
+				TypeMeta:   builder.TypeMeta,
+				Spec:       builder.Spec,
+				Status: buildapi.BuilderStatus{
+					Status: corev1alpha1.Status{
+						ObservedGeneration: 1,
+						Conditions: corev1alpha1.Conditions{
+							{
+								Type:    corev1alpha1.ConditionReady,
+								Status:  corev1.ConditionFalse,
+								Message: "validating buildpack some-buildpack@1.0.0: stack missing mixin(s): some-mixin",
+							},
+						},
+					},
+					MixinMismatches: mismatches,
+				},
+			}
+
+			rt.Test(rtesting.TableRow{
+				Key: builderKey,
+				Objects: []runtime.Object{
+					clusterStack,
+					clusterStore,
+					builder,
+				},
+				WantErr: true,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: expectedBuilder,
+					},
+				},
+			})
+		})
+
 		it("updates status and doesn't build builder when stack not ready", func() {
 			notReadyClusterStack := &buildapi.ClusterStack{
 				ObjectMeta: metav1.ObjectMeta{
@@ -523,5 +566,217 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 				builder.NamespacedName()))
 			require.Len(t, builderCreator.CreateBuilderCalls, 0)
 		})
+
+		when("a rollout is configured", func() {
+			const (
+				stableIdentifier = "example.com/custom-builder@sha256:stable-builder-digest"
+				canaryNamespace  = "canary-namespace"
+			)
+
+			stableRevision := buildapi.BuilderRevision{
+				LatestImage: stableIdentifier,
+				BuilderMetadata: corev1alpha1.BuildpackMetadataList{
+					{
+						Id:      "buildpack.id.1",
+						Version: "0.9.0",
+					},
+				},
+				Stack: corev1alpha1.BuildStack{
+					RunImage: "example.com/run-image@sha256:stable",
+					ID:       "fake.stack.id",
+				},
+			}
+
+			canaryBuild := func(name, buildNumber string, status corev1.ConditionStatus) *buildapi.Build {
+				return &buildapi.Build{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: canaryNamespace,
+						Labels: map[string]string{
+							"canary":                  "true",
+							buildapi.ImageLabel:       "some-image",
+							buildapi.BuildNumberLabel: buildNumber,
+						},
+						Annotations: map[string]string{
+							buildapi.BuilderKindAnnotation: buildapi.ClusterBuilderKind,
+							buildapi.BuilderNameAnnotation: builderName,
+						},
+					},
+					Spec: buildapi.BuildSpec{
+						Builder: corev1alpha1.BuildBuilderSpec{
+							Image: builderIdentifier,
+						},
+					},
+					Status: buildapi.BuildStatus{
+						Status: corev1alpha1.Status{
+							Conditions: corev1alpha1.Conditions{
+								{
+									Type:   corev1alpha1.ConditionSucceeded,
+									Status: status,
+								},
+							},
+						},
+					},
+				}
+			}
+
+			expectedStatus := func(rollout *buildapi.BuilderRolloutStatus, rolloutCondition corev1alpha1.Condition) buildapi.BuilderStatus {
+				return buildapi.BuilderStatus{
+					Status: corev1alpha1.Status{
+						ObservedGeneration: 1,
+						Conditions: corev1alpha1.Conditions{
+							{
+								Type:   corev1alpha1.ConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+							rolloutCondition,
+						},
+					},
+					BuilderMetadata: corev1alpha1.BuildpackMetadataList{},
+					Stack: corev1alpha1.BuildStack{
+						RunImage: "example.com/run-image@sha256:123456",
+						ID:       "fake.stack.id",
+					},
+					LatestImage: builderIdentifier,
+					Rollout:     rollout,
+				}
+			}
+
+			it.Before(func() {
+				builderCreator.Record = buildapi.BuilderRecord{
+					Image: builderIdentifier,
+					Stack: corev1alpha1.BuildStack{
+						RunImage: "example.com/run-image@sha256:123456",
+						ID:       "fake.stack.id",
+					},
+					Buildpacks: corev1alpha1.BuildpackMetadataList{},
+				}
+
+				builder.Spec.Rollout = &buildapi.BuilderRollout{
+					CanarySelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"canary": "true"},
+					},
+				}
+				builder.Status = buildapi.BuilderStatus{
+					BuilderMetadata: stableRevision.BuilderMetadata,
+					Stack:           stableRevision.Stack,
+					LatestImage:     stableIdentifier,
+				}
+			})
+
+			it("completes immediately for a new builder", func() {
+				builder.Status = buildapi.BuilderStatus{}
+
+				rt.Test(rtesting.TableRow{
+					Key: builderKey,
+					Objects: []runtime.Object{
+						clusterStack,
+						clusterStore,
+						builder,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.ClusterBuilder{
+								ObjectMeta: builder.ObjectMeta,
+								TypeMeta:   builder.TypeMeta,
+								Spec:       builder.Spec,
+								Status: expectedStatus(nil, corev1alpha1.Condition{
+									Type:   buildapi.ConditionRolloutComplete,
+									Status: corev1.ConditionTrue,
+								}),
+							},
+						},
+					},
+				})
+			})
+
+			it("keeps the stable builder while waiting for canary builds", func() {
+				rt.Test(rtesting.TableRow{
+					Key: builderKey,
+					Objects: []runtime.Object{
+						clusterStack,
+						clusterStore,
+						builder,
+						canaryBuild("canary-build-1", "1", corev1.ConditionUnknown),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.ClusterBuilder{
+								ObjectMeta: builder.ObjectMeta,
+								TypeMeta:   builder.TypeMeta,
+								Spec:       builder.Spec,
+								Status: expectedStatus(&buildapi.BuilderRolloutStatus{Stable: stableRevision}, corev1alpha1.Condition{
+									Type:    buildapi.ConditionRolloutComplete,
+									Status:  corev1.ConditionUnknown,
+									Reason:  buildapi.CanaryInProgressReason,
+									Message: "waiting for canary builds: canary-namespace/canary-build-1",
+								}),
+							},
+						},
+					},
+				})
+			})
+
+			it("halts the rollout when a canary build fails", func() {
+				builder.Status.Rollout = &buildapi.BuilderRolloutStatus{Stable: stableRevision}
+
+				rt.Test(rtesting.TableRow{
+					Key: builderKey,
+					Objects: []runtime.Object{
+						clusterStack,
+						clusterStore,
+						builder,
+						canaryBuild("canary-build-1", "1", corev1.ConditionFalse),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.ClusterBuilder{
+								ObjectMeta: builder.ObjectMeta,
+								TypeMeta:   builder.TypeMeta,
+								Spec:       builder.Spec,
+								Status: expectedStatus(&buildapi.BuilderRolloutStatus{Stable: stableRevision}, corev1alpha1.Condition{
+									Type:    buildapi.ConditionRolloutComplete,
+									Status:  corev1.ConditionFalse,
+									Reason:  buildapi.CanaryFailedReason,
+									Message: "canary builds failed: canary-namespace/canary-build-1",
+								}),
+							},
+						},
+					},
+				})
+			})
+
+			it("completes the rollout when the latest canary builds succeed", func() {
+				builder.Status.Rollout = &buildapi.BuilderRolloutStatus{Stable: stableRevision}
+
+				rt.Test(rtesting.TableRow{
+					Key: builderKey,
+					Objects: []runtime.Object{
+						clusterStack,
+						clusterStore,
+						builder,
+						canaryBuild("canary-build-1", "1", corev1.ConditionFalse),
+						canaryBuild("canary-build-2", "2", corev1.ConditionTrue),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.ClusterBuilder{
+								ObjectMeta: builder.ObjectMeta,
+								TypeMeta:   builder.TypeMeta,
+								Spec:       builder.Spec,
+								Status: expectedStatus(nil, corev1alpha1.Condition{
+									Type:   buildapi.ConditionRolloutComplete,
+									Status: corev1.ConditionTrue,
+								}),
+							},
+						},
+					},
+				})
+			})
+		})
 	})
 }
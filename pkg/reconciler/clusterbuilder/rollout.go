@@ -0,0 +1,96 @@
+package clusterbuilder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+// reconcileRollout holds the previous builder for Images outside the canary
+// selector until every canary build with the new builder has succeeded.
+func (c *Reconciler) reconcileRollout(builder *buildapi.ClusterBuilder, stable buildapi.BuilderRevision, hasStable bool) error {
+	if builder.Spec.Rollout == nil {
+		builder.Status.Rollout = nil
+		return nil
+	}
+
+	if !hasStable || stable.LatestImage == builder.Status.LatestImage {
+		builder.Status.RolloutComplete()
+		return nil
+	}
+
+	canaryBuilds, err := c.canaryBuilds(builder)
+	if err != nil {
+		return err
+	}
+
+	var failed, running []string
+	succeeded := 0
+	for _, build := range canaryBuilds {
+		switch {
+		case build.IsSuccess():
+			succeeded++
+		case build.IsFailure():
+			failed = append(failed, build.Namespace+"/"+build.Name)
+		default:
+			running = append(running, build.Namespace+"/"+build.Name)
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		builder.Status.CanaryFailed(stable, fmt.Sprintf("canary builds failed: %s", strings.Join(failed, ", ")))
+	case len(running) > 0:
+		builder.Status.CanaryInProgress(stable, fmt.Sprintf("waiting for canary builds: %s", strings.Join(running, ", ")))
+	case succeeded == 0:
+		builder.Status.CanaryInProgress(stable, "waiting for canary builds")
+	default:
+		builder.Status.RolloutComplete()
+	}
+	return nil
+}
+
+// canaryBuilds returns the most recent build of each canary Image that used
+// the latest builder image.
+func (c *Reconciler) canaryBuilds(builder *buildapi.ClusterBuilder) ([]*buildapi.Build, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&builder.Spec.Rollout.CanarySelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid canary selector")
+	}
+
+	builds, err := c.BuildLister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := map[string]*buildapi.Build{}
+	for _, build := range builds {
+		if build.Annotations[buildapi.BuilderKindAnnotation] != buildapi.ClusterBuilderKind ||
+			build.Annotations[buildapi.BuilderNameAnnotation] != builder.Name ||
+			build.Spec.Builder.Image != builder.Status.LatestImage {
+			continue
+		}
+
+		key := build.Namespace + "/" + build.Labels[buildapi.ImageLabel]
+		if existing, ok := latest[key]; !ok || build.SequenceNumber() > existing.SequenceNumber() {
+			latest[key] = build
+		}
+	}
+
+	keys := make([]string, 0, len(latest))
+	for key := range latest {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	canaryBuilds := make([]*buildapi.Build, 0, len(keys))
+	for _, key := range keys {
+		canaryBuilds = append(canaryBuilds, latest[key])
+	}
+	return canaryBuilds, nil
+}
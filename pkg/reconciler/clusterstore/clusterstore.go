@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -120,6 +121,11 @@ func (c *Reconciler) reconcileClusterStoreStatus(ctx context.Context, clusterSto
 		}
 	}
 
+	if clusterStore.Spec.SecretRef != nil {
+		secretRef.Namespace = clusterStore.Spec.SecretRef.Namespace
+		secretRef.ImagePullSecrets = []corev1.LocalObjectReference{{Name: clusterStore.Spec.SecretRef.Name}}
+	}
+
 	keychain, err := c.KeychainFactory.KeychainForSecretRef(ctx, secretRef)
 	if err != nil {
 		clusterStore.Status = buildapi.ClusterStoreStatus{
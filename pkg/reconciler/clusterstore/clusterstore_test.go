@@ -183,6 +183,50 @@ func testClusterStoreReconciler(t *testing.T, when spec.G, it spec.S) {
 			assert.Equal(t, expectedKeyChain, actualKeyChain)
 		})
 
+		it("uses the keychain of the referenced secret", func() {
+			fakeStoreReader.ReadReturns(readBuildpacks, nil)
+
+			store.Spec.SecretRef = &corev1.SecretReference{Name: "private-registry-credentials", Namespace: "my-namespace"}
+			secretRef := registry.SecretRef{
+				Namespace:        "my-namespace",
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "private-registry-credentials"}},
+			}
+			expectedKeyChain := &registryfakes.FakeKeychain{Name: "secret"}
+			fakeKeyChainFactory.AddKeychainForSecretRef(t, secretRef, expectedKeyChain)
+
+			rt.Test(rtesting.TableRow{
+				Key: storeKey,
+				Objects: []runtime.Object{
+					store,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &buildapi.ClusterStore{
+							ObjectMeta: store.ObjectMeta,
+							Spec:       store.Spec,
+							Status: buildapi.ClusterStoreStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: 1,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionReady,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								Buildpacks: readBuildpacks,
+							},
+						},
+					},
+				},
+			})
+
+			assert.Equal(t, 1, fakeStoreReader.ReadCallCount())
+			actualKeyChain, _ := fakeStoreReader.ReadArgsForCall(0)
+			assert.Equal(t, expectedKeyChain, actualKeyChain)
+		})
+
 		it("does not update the status with no status change", func() {
 			fakeStoreReader.ReadReturns(readBuildpacks, nil)
 
@@ -1,9 +1,7 @@
 package image
 
 import (
-	"sort"
-
-	v1alpha1build "github.com/pivotal/kpack/pkg/reconciler/build"
+	"time"
 
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 )
@@ -15,8 +13,6 @@ type buildList struct {
 }
 
 func newBuildList(builds []*buildapi.Build) (buildList, error) {
-	sort.Sort(v1alpha1build.ByCreationTimestamp(builds)) //nobody enforcing this
-
 	buildList := buildList{}
 
 	for _, build := range builds {
@@ -49,3 +45,38 @@ func (l buildList) NumberSuccessfulBuilds() int64 {
 func (l buildList) OldestSuccess() *buildapi.Build {
 	return l.successfulBuilds[0]
 }
+
+// registryGCCandidates returns the successful builds, newest first, whose
+// images fall outside the registry gc policy along with the digests that
+// must be retained.
+func (l buildList) registryGCCandidates(policy *buildapi.ImageRegistryGC, latestImage string, now time.Time) ([]*buildapi.Build, map[string]struct{}) {
+	retained := map[string]struct{}{}
+	if digest := imageDigest(latestImage); digest != "" {
+		retained[digest] = struct{}{}
+	}
+
+	var candidates []*buildapi.Build
+	for i := len(l.successfulBuilds) - 1; i >= 0; i-- {
+		build := l.successfulBuilds[i]
+		newer := int64(len(l.successfulBuilds) - 1 - i)
+
+		keep := (policy.KeepLast != nil && newer < *policy.KeepLast) ||
+			(policy.TTLHours != nil && now.Sub(build.CreationTimestamp.Time) < time.Duration(*policy.TTLHours)*time.Hour)
+		if keep || build.Status.LatestImage == latestImage {
+			for _, image := range append([]string{build.Status.LatestImage}, build.Status.PushedImages...) {
+				if digest := imageDigest(image); digest != "" {
+					retained[digest] = struct{}{}
+				}
+			}
+			continue
+		}
+
+		if _, pruned := build.Annotations[buildapi.RegistryPrunedAnnotation]; pruned {
+			continue
+		}
+
+		candidates = append(candidates, build)
+	}
+
+	return candidates, retained
+}
@@ -0,0 +1,60 @@
+package image
+
+import (
+	"context"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+// buildTemplate returns the spec of the template referenced by the image or
+// nil if the image does not reference one.
+func (c *Reconciler) buildTemplate(image *buildapi.Image) (*buildapi.BuildTemplateSpec, error) {
+	ref := image.Spec.BuildTemplate
+	if ref == nil {
+		return nil, nil
+	}
+
+	if ref.Kind == buildapi.ClusterBuildTemplateKind {
+		template, err := c.ClusterBuildTemplateLister.Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &template.Spec, nil
+	}
+
+	template, err := c.BuildTemplateLister.BuildTemplates(image.Namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &template.Spec, nil
+}
+
+func (c *Reconciler) reconcileImageWithTemplate(ctx context.Context, image *buildapi.Image) (*buildapi.Image, error) {
+	template, err := c.buildTemplate(image)
+	if k8serrors.IsNotFound(err) {
+		image.Status.Conditions = image.BuildTemplateNotFound()
+		return image, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if template == nil {
+		return c.reconcileImage(ctx, image)
+	}
+
+	reconciled, err := c.reconcileImage(ctx, image.WithBuildTemplate(ctx, template))
+	if err != nil {
+		return nil, err
+	}
+
+	image.Status = reconciled.Status
+	return image, nil
+}
+
+func referencesBuildTemplate(image *buildapi.Image, kind, name string) bool {
+	return image.Spec.BuildTemplate != nil &&
+		image.Spec.BuildTemplate.Kind == kind &&
+		image.Spec.BuildTemplate.Name == name
+}
@@ -0,0 +1,87 @@
+package image
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/reconciler"
+)
+
+// BuilderIndex indexes Images by the Builder or ClusterBuilder they reference
+// so that a builder change only enqueues the Images that use it.
+const BuilderIndex = "builder"
+
+func BuilderIndexFunc(obj interface{}) ([]string, error) {
+	image, ok := obj.(*buildapi.Image)
+	if !ok {
+		return nil, nil
+	}
+	return []string{reconcilerKeyForBuilderKind(image).String()}, nil
+}
+
+// BuilderEventHandler enqueues the Images indexed under a Builder or
+// ClusterBuilder of the given kind. Updates that leave the builder spec and
+// status unchanged, such as informer resyncs, are ignored.
+func BuilderEventHandler(indexer cache.Indexer, enqueue func(interface{}), kind string) cache.ResourceEventHandler {
+	enqueueImages := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+
+		builder, ok := obj.(metav1.Object)
+		if !ok {
+			return
+		}
+
+		key := reconciler.Key{
+			NamespacedName: types.NamespacedName{
+				Name:      builder.GetName(),
+				Namespace: builder.GetNamespace(),
+			},
+			GroupKind: schema.GroupKind{
+				Group: "kpack.io",
+				Kind:  kind,
+			},
+		}
+
+		images, err := indexer.ByIndex(BuilderIndex, key.String())
+		if err != nil {
+			return
+		}
+
+		for _, image := range images {
+			enqueue(image)
+		}
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueImages,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if builderChanged(oldObj, newObj) {
+				enqueueImages(newObj)
+			}
+		},
+		DeleteFunc: enqueueImages,
+	}
+}
+
+func builderChanged(oldObj, newObj interface{}) bool {
+	switch newBuilder := newObj.(type) {
+	case *buildapi.Builder:
+		oldBuilder, ok := oldObj.(*buildapi.Builder)
+		return !ok ||
+			oldBuilder.Generation != newBuilder.Generation ||
+			!equality.Semantic.DeepEqual(oldBuilder.Status, newBuilder.Status)
+	case *buildapi.ClusterBuilder:
+		oldBuilder, ok := oldObj.(*buildapi.ClusterBuilder)
+		return !ok ||
+			oldBuilder.Generation != newBuilder.Generation ||
+			!equality.Semantic.DeepEqual(oldBuilder.Status, newBuilder.Status)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,134 @@
+package image_test
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/reconciler/image"
+)
+
+func TestBuilderIndex(t *testing.T) {
+	spec.Run(t, "Builder Index", testBuilderIndex)
+}
+
+func testBuilderIndex(t *testing.T, when spec.G, it spec.S) {
+	const namespace = "some-namespace"
+
+	newImage := func(name string, builder corev1.ObjectReference) *buildapi.Image {
+		return &buildapi.Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: buildapi.ImageSpec{
+				Builder: builder,
+			},
+		}
+	}
+
+	var (
+		indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{image.BuilderIndex: image.BuilderIndexFunc})
+
+		imageWithBuilder = newImage("image-with-builder", corev1.ObjectReference{
+			Kind: buildapi.BuilderKind,
+			Name: "some-builder",
+		})
+		imageWithPlatformBuilder = newImage("image-with-platform-builder", corev1.ObjectReference{
+			Kind:      buildapi.BuilderKind,
+			Namespace: "platform-namespace",
+			Name:      "some-builder",
+		})
+		imageWithClusterBuilder = newImage("image-with-cluster-builder", corev1.ObjectReference{
+			Kind: buildapi.ClusterBuilderKind,
+			Name: "some-builder",
+		})
+
+		builder = &buildapi.Builder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "some-builder",
+				Namespace:  namespace,
+				Generation: 1,
+			},
+			Status: buildapi.BuilderStatus{
+				LatestImage: "some-registry.io/builder@sha256:123",
+			},
+		}
+		clusterBuilder = &buildapi.ClusterBuilder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "some-builder",
+				Generation: 1,
+			},
+			Status: buildapi.BuilderStatus{
+				LatestImage: "some-registry.io/builder@sha256:123",
+			},
+		}
+
+		enqueued []string
+		enqueue  = func(obj interface{}) {
+			enqueued = append(enqueued, obj.(*buildapi.Image).Name)
+		}
+	)
+
+	it.Before(func() {
+		for _, i := range []*buildapi.Image{imageWithBuilder, imageWithPlatformBuilder, imageWithClusterBuilder} {
+			require.NoError(t, indexer.Add(i))
+		}
+		enqueued = nil
+	})
+
+	it("enqueues only the images that use a changed builder", func() {
+		handler := image.BuilderEventHandler(indexer, enqueue, buildapi.BuilderKind)
+
+		handler.OnAdd(builder)
+		assert.Equal(t, []string{"image-with-builder"}, enqueued)
+
+		platformBuilder := builder.DeepCopy()
+		platformBuilder.Namespace = "platform-namespace"
+		handler.OnDelete(cache.DeletedFinalStateUnknown{Obj: platformBuilder})
+		assert.Equal(t, []string{"image-with-builder", "image-with-platform-builder"}, enqueued)
+	})
+
+	it("enqueues only the images that use a changed cluster builder", func() {
+		handler := image.BuilderEventHandler(indexer, enqueue, buildapi.ClusterBuilderKind)
+
+		handler.OnAdd(clusterBuilder)
+		assert.Equal(t, []string{"image-with-cluster-builder"}, enqueued)
+	})
+
+	it("enqueues images when the builder status or spec changes", func() {
+		handler := image.BuilderEventHandler(indexer, enqueue, buildapi.BuilderKind)
+
+		updatedStatus := builder.DeepCopy()
+		updatedStatus.Status.LatestImage = "some-registry.io/builder@sha256:456"
+		handler.OnUpdate(builder, updatedStatus)
+		assert.Equal(t, []string{"image-with-builder"}, enqueued)
+
+		updatedSpec := builder.DeepCopy()
+		updatedSpec.Generation = 2
+		handler.OnUpdate(builder, updatedSpec)
+		assert.Equal(t, []string{"image-with-builder", "image-with-builder"}, enqueued)
+	})
+
+	it("ignores updates that do not change the builder", func() {
+		image.BuilderEventHandler(indexer, enqueue, buildapi.BuilderKind).OnUpdate(builder, builder.DeepCopy())
+		image.BuilderEventHandler(indexer, enqueue, buildapi.ClusterBuilderKind).OnUpdate(clusterBuilder, clusterBuilder.DeepCopy())
+
+		assert.Empty(t, enqueued)
+	})
+
+	it("indexes images after their builder reference changes", func() {
+		updated := imageWithBuilder.DeepCopy()
+		updated.Spec.Builder.Name = "other-builder"
+		require.NoError(t, indexer.Update(updated))
+
+		image.BuilderEventHandler(indexer, enqueue, buildapi.BuilderKind).OnAdd(builder)
+		assert.Empty(t, enqueued)
+	})
+}
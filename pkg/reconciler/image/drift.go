@@ -0,0 +1,112 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const (
+	TagDriftedReason     = "TagDrifted"
+	TagRepushedReason    = "TagRepushed"
+	DriftRebuildReason   = "DriftRebuildTriggered"
+	DriftCheckFailReason = "DriftCheckFailed"
+)
+
+//go:generate counterfeiter . TagClient
+type TagClient interface {
+	Digest(keychain authn.Keychain, image string) (string, error)
+	Copy(keychain authn.Keychain, src string, dst string) (string, error)
+}
+
+// reconcileDrift checks that the tag of the image still points at the
+// latest image of its last successful build and takes the configured action
+// if it was overwritten. Registry errors are reported on the Drifted
+// condition and do not fail the reconcile.
+func (c *Reconciler) reconcileDrift(ctx context.Context, image *buildapi.Image, lastBuild *buildapi.Build) (corev1alpha1.Conditions, error) {
+	drift := image.Spec.DriftDetection
+	if drift == nil {
+		return nil, nil
+	}
+	c.EnqueueAfter(image, time.Duration(drift.IntervalSeconds)*time.Second)
+
+	if !lastBuild.IsSuccess() || image.Status.LatestBuildRef != lastBuild.Name {
+		return nil, nil
+	}
+
+	latestImage := lastBuild.Status.LatestImage
+	expectedDigest := latestImage[strings.LastIndex(latestImage, "@")+1:]
+
+	keychain, err := c.KeychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{
+		ServiceAccount: image.Spec.ServiceAccountName,
+		Namespace:      image.Namespace,
+	})
+	if err != nil {
+		return driftCondition(corev1.ConditionUnknown, DriftCheckFailReason, err.Error()), nil
+	}
+
+	digest, err := c.TagClient.Digest(keychain, image.Spec.Tag)
+	if err != nil {
+		return driftCondition(corev1.ConditionUnknown, DriftCheckFailReason, err.Error()), nil
+	}
+
+	if digest == expectedDigest {
+		return driftCondition(corev1.ConditionFalse, "", ""), nil
+	}
+
+	message := fmt.Sprintf("tag %s points at %s instead of %s", image.Spec.Tag, digest, expectedDigest)
+	switch drift.Action {
+	case buildapi.DriftActionRepush:
+		if _, err := c.TagClient.Copy(keychain, latestImage, image.Spec.Tag); err != nil {
+			return driftCondition(corev1.ConditionTrue, TagDriftedReason, fmt.Sprintf("%s, re-push failed: %s", message, err)), nil
+		}
+		return driftCondition(corev1.ConditionFalse, TagRepushedReason, fmt.Sprintf("%s, re-pushed %s", message, latestImage)), nil
+	case buildapi.DriftActionRebuild:
+		if err := c.triggerBuild(ctx, lastBuild); err != nil {
+			return nil, err
+		}
+		return driftCondition(corev1.ConditionTrue, DriftRebuildReason, message), nil
+	default:
+		return driftCondition(corev1.ConditionTrue, TagDriftedReason, message), nil
+	}
+}
+
+// triggerBuild requests a new build by annotating the last build, the same
+// way a build is triggered manually.
+func (c *Reconciler) triggerBuild(ctx context.Context, lastBuild *buildapi.Build) error {
+	if _, ok := lastBuild.Annotations[buildapi.BuildNeededAnnotation]; ok {
+		return nil
+	}
+
+	build := lastBuild.DeepCopy()
+	if build.Annotations == nil {
+		build.Annotations = map[string]string{}
+	}
+	build.Annotations[buildapi.BuildNeededAnnotation] = time.Now().String()
+
+	_, err := c.Client.KpackV1alpha2().Builds(build.Namespace).Update(ctx, build, metav1.UpdateOptions{})
+	return errors.Wrap(err, "cannot trigger build")
+}
+
+func driftCondition(status corev1.ConditionStatus, reason, message string) corev1alpha1.Conditions {
+	return corev1alpha1.Conditions{
+		{
+			Type:               buildapi.ConditionDrifted,
+			Status:             status,
+			Severity:           corev1alpha1.ConditionSeverityWarning,
+			Reason:             reason,
+			Message:            message,
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
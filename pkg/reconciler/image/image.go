@@ -3,6 +3,7 @@ package image
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -12,11 +13,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	coreinformers "k8s.io/client-go/informers/core/v1"
+	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	k8sclient "k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	networkinglisters "k8s.io/client-go/listers/networking/v1"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging/logkey"
@@ -25,9 +27,10 @@ import (
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
 	buildinformers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha2"
 	buildlisters "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/config"
 	"github.com/pivotal/kpack/pkg/duckbuilder"
 	"github.com/pivotal/kpack/pkg/reconciler"
-	"github.com/pivotal/kpack/pkg/tracker"
+	"github.com/pivotal/kpack/pkg/registry"
 )
 
 const (
@@ -43,18 +46,36 @@ func NewController(
 	buildInformer buildinformers.BuildInformer,
 	duckbuilderInformer *duckbuilder.DuckBuilderInformer,
 	sourceResolverInformer buildinformers.SourceResolverInformer,
+	builderGrantInformer buildinformers.BuilderGrantInformer,
+	buildQuotaInformer buildinformers.BuildQuotaInformer,
+	buildTemplateInformer buildinformers.BuildTemplateInformer,
+	clusterBuildTemplateInformer buildinformers.ClusterBuildTemplateInformer,
 	pvcInformer coreinformers.PersistentVolumeClaimInformer,
-	enablePriorityClasses bool,
+	networkPolicyInformer networkinginformers.NetworkPolicyInformer,
+	configMapInformer coreinformers.ConfigMapInformer,
+	keychainFactory registry.KeychainFactory,
+	registryDeleter RegistryDeleter,
+	tagClient TagClient,
+	kpackConfig *config.KpackConfigStore,
 ) *controller.Impl {
 	c := &Reconciler{
-		Client:                opt.Client,
-		K8sClient:             k8sClient,
-		ImageLister:           imageInformer.Lister(),
-		BuildLister:           buildInformer.Lister(),
-		DuckBuilderLister:     duckbuilderInformer.Lister(),
-		SourceResolverLister:  sourceResolverInformer.Lister(),
-		PvcLister:             pvcInformer.Lister(),
-		EnablePriorityClasses: enablePriorityClasses,
+		Client:                     opt.Client,
+		K8sClient:                  k8sClient,
+		ImageLister:                imageInformer.Lister(),
+		BuildLister:                buildInformer.Lister(),
+		DuckBuilderLister:          duckbuilderInformer.Lister(),
+		SourceResolverLister:       sourceResolverInformer.Lister(),
+		BuilderGrantLister:         builderGrantInformer.Lister(),
+		BuildQuotaLister:           buildQuotaInformer.Lister(),
+		BuildTemplateLister:        buildTemplateInformer.Lister(),
+		ClusterBuildTemplateLister: clusterBuildTemplateInformer.Lister(),
+		PvcLister:                  pvcInformer.Lister(),
+		NetworkPolicyLister:        networkPolicyInformer.Lister(),
+		ConfigMapLister:            configMapInformer.Lister(),
+		KeychainFactory:            keychainFactory,
+		RegistryDeleter:            registryDeleter,
+		TagClient:                  tagClient,
+		KpackConfig:                kpackConfig,
 	}
 
 	logger := opt.Logger.With(
@@ -63,6 +84,8 @@ func NewController(
 
 	impl := controller.NewContext(ctx, c, controller.ControllerOptions{WorkQueueName: ReconcilerName, Logger: logger})
 
+	c.EnqueueAfter = impl.EnqueueAfter
+
 	imageInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
 	buildInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
@@ -80,32 +103,136 @@ func NewController(
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
-	c.Tracker = tracker.New(impl.EnqueueKey, opt.TrackerResyncPeriod())
+	networkPolicyInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterControllerGK(buildapi.SchemeGroupVersion.WithKind(Kind).GroupKind()),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
+	configMapInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok || cm.Name != config.BuildNetworkPolicyConfigName {
+			return
+		}
+
+		images, err := c.ImageLister.Images(cm.Namespace).List(labels.Everything())
+		if err != nil {
+			return
+		}
+
+		for _, image := range images {
+			impl.Enqueue(image)
+		}
+	}))
+
+	buildQuotaInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		quota, ok := obj.(*buildapi.BuildQuota)
+		if !ok {
+			return
+		}
+
+		images, err := c.ImageLister.Images(quota.Namespace).List(labels.Everything())
+		if err != nil {
+			return
+		}
+
+		for _, image := range images {
+			impl.Enqueue(image)
+		}
+	}))
+
+	buildTemplateInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		template, ok := obj.(*buildapi.BuildTemplate)
+		if !ok {
+			return
+		}
+
+		images, err := c.ImageLister.Images(template.Namespace).List(labels.Everything())
+		if err != nil {
+			return
+		}
+
+		for _, image := range images {
+			if referencesBuildTemplate(image, buildapi.BuildTemplateKind, template.Name) {
+				impl.Enqueue(image)
+			}
+		}
+	}))
+
+	clusterBuildTemplateInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		template, ok := obj.(*buildapi.ClusterBuildTemplate)
+		if !ok {
+			return
+		}
+
+		images, err := c.ImageLister.List(labels.Everything())
+		if err != nil {
+			return
+		}
+
+		for _, image := range images {
+			if referencesBuildTemplate(image, buildapi.ClusterBuildTemplateKind, template.Name) {
+				impl.Enqueue(image)
+			}
+		}
+	}))
+
+	builderGrantInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		grant, ok := obj.(*buildapi.BuilderGrant)
+		if !ok {
+			return
+		}
+
+		for _, from := range grant.Spec.From {
+			images, err := c.ImageLister.Images(from.Namespace).List(labels.Everything())
+			if err != nil {
+				continue
+			}
+
+			for _, image := range images {
+				if image.Spec.Builder.Namespace == grant.Namespace {
+					impl.Enqueue(image)
+				}
+			}
+		}
+	}))
+
+	if err := imageInformer.Informer().AddIndexers(cache.Indexers{BuilderIndex: BuilderIndexFunc}); err != nil {
+		logger.Fatalw("Error adding image builder index", zap.Error(err))
+	}
+
+	if err := buildInformer.Informer().AddIndexers(cache.Indexers{buildlisters.ImageIndex: buildlisters.ImageIndexFunc}); err != nil {
+		logger.Fatalw("Error adding build image index", zap.Error(err))
+	}
 
-	duckbuilderInformer.AddBuilderEventHandler(controller.HandleAll(
-		controller.EnsureTypeMeta(
-			c.Tracker.OnChanged,
-			buildapi.SchemeGroupVersion.WithKind(buildapi.BuilderKind)),
-	))
-	duckbuilderInformer.AddClusterBuilderEventHandler(controller.HandleAll(
-		controller.EnsureTypeMeta(
-			c.Tracker.OnChanged,
-			buildapi.SchemeGroupVersion.WithKind(buildapi.ClusterBuilderKind)),
-	))
+	duckbuilderInformer.AddBuilderEventHandler(
+		BuilderEventHandler(imageInformer.Informer().GetIndexer(), impl.Enqueue, buildapi.BuilderKind),
+	)
+	duckbuilderInformer.AddClusterBuilderEventHandler(
+		BuilderEventHandler(imageInformer.Informer().GetIndexer(), impl.Enqueue, buildapi.ClusterBuilderKind),
+	)
 
 	return impl
 }
 
 type Reconciler struct {
-	Client                versioned.Interface
-	DuckBuilderLister     *duckbuilder.DuckBuilderLister
-	ImageLister           buildlisters.ImageLister
-	BuildLister           buildlisters.BuildLister
-	SourceResolverLister  buildlisters.SourceResolverLister
-	PvcLister             corelisters.PersistentVolumeClaimLister
-	Tracker               reconciler.Tracker
-	K8sClient             k8sclient.Interface
-	EnablePriorityClasses bool
+	Client                     versioned.Interface
+	DuckBuilderLister          *duckbuilder.DuckBuilderLister
+	ImageLister                buildlisters.ImageLister
+	BuildLister                buildlisters.BuildLister
+	SourceResolverLister       buildlisters.SourceResolverLister
+	BuilderGrantLister         buildlisters.BuilderGrantLister
+	BuildQuotaLister           buildlisters.BuildQuotaLister
+	BuildTemplateLister        buildlisters.BuildTemplateLister
+	ClusterBuildTemplateLister buildlisters.ClusterBuildTemplateLister
+	PvcLister                  corelisters.PersistentVolumeClaimLister
+	NetworkPolicyLister        networkinglisters.NetworkPolicyLister
+	ConfigMapLister            corelisters.ConfigMapLister
+	K8sClient                  k8sclient.Interface
+	KeychainFactory            registry.KeychainFactory
+	RegistryDeleter            RegistryDeleter
+	TagClient                  TagClient
+	EnqueueAfter               func(obj interface{}, after time.Duration)
+	KpackConfig                *config.KpackConfigStore
 }
 
 func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
@@ -124,7 +251,7 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 	image = image.DeepCopy()
 	image.SetDefaults(ctx)
 
-	image, err = c.reconcileImage(ctx, image)
+	image, err = c.reconcileImageWithTemplate(ctx, image)
 	if err != nil {
 		return err
 	}
@@ -133,7 +260,13 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 }
 
 func (c *Reconciler) reconcileImage(ctx context.Context, image *buildapi.Image) (*buildapi.Image, error) {
-	c.Tracker.Track(reconcilerKeyForBuilderKind(image), image.NamespacedName())
+	granted, err := c.builderGranted(image)
+	if err != nil {
+		return nil, err
+	} else if !granted {
+		image.Status.Conditions = image.BuilderNotGranted()
+		return image, nil
+	}
 
 	builder, err := c.DuckBuilderLister.Namespace(image.Namespace).Get(image.Spec.Builder)
 	if err != nil && !k8serrors.IsNotFound(err) {
@@ -142,35 +275,80 @@ func (c *Reconciler) reconcileImage(ctx context.Context, image *buildapi.Image)
 		image.Status.Conditions = image.BuilderNotFound()
 		return image, nil
 	}
+	builder = builder.ForImage(image)
 
 	lastBuild, err := c.fetchLastBuild(image)
 	if err != nil {
 		return nil, err
 	}
 
+	recordStackDeprecation(ctx, image, builder)
+
 	if lastBuild.IsRunning() {
-		image.Status.Conditions = buildRunningCondition(lastBuild, builder)
+		image.Status.Conditions = append(buildRunningCondition(lastBuild, builder), stackDeprecatedConditions(builder)...)
 		return image, nil
 	}
 
-	buildCacheName, err := c.reconcileBuildCache(ctx, image)
+	cacheQuota, err := c.buildCacheQuotaExceeded(image)
 	if err != nil {
 		return nil, err
 	}
 
+	buildCacheName, err := c.reconcileBuildCache(ctx, image, cacheQuota)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.reconcileBuildNetworkPolicy(ctx, image); err != nil {
+		return nil, err
+	}
+
 	sourceResolver, err := c.reconcileSourceResolver(ctx, image)
 	if err != nil {
 		return nil, err
 	}
 
+	previousRegistryGC := image.Status.RegistryGC
 	image.Status, err = c.reconcileBuild(ctx, image, lastBuild, sourceResolver, builder, buildCacheName)
 	if err != nil {
 		return nil, err
 	}
+	image.Status.Conditions = append(image.Status.Conditions, stackDeprecatedConditions(builder)...)
+	image.Status.Conditions = append(image.Status.Conditions, buildCacheQuotaConditions(image, cacheQuota)...)
+
+	driftConditions, err := c.reconcileDrift(ctx, image, lastBuild)
+	if err != nil {
+		return nil, err
+	}
+	image.Status.Conditions = append(image.Status.Conditions, driftConditions...)
+
+	image.Status.RegistryGC, err = c.reconcileRegistryGC(ctx, image, previousRegistryGC)
+	if err != nil {
+		return nil, err
+	}
 
 	return image, c.deleteOldBuilds(ctx, image)
 }
 
+func (c *Reconciler) builderGranted(image *buildapi.Image) (bool, error) {
+	builderNamespace := image.Spec.Builder.Namespace
+	if image.Spec.Builder.Kind != buildapi.BuilderKind || builderNamespace == "" || builderNamespace == image.Namespace {
+		return true, nil
+	}
+
+	grants, err := c.BuilderGrantLister.BuilderGrants(builderNamespace).List(labels.Everything())
+	if err != nil {
+		return false, errors.Wrap(err, "cannot list builder grants")
+	}
+
+	for _, grant := range grants {
+		if grant.Allows(image.Namespace, image.Spec.Builder.Name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Reconciler) reconcileSourceResolver(ctx context.Context, image *buildapi.Image) (*buildapi.SourceResolver, error) {
 	desiredSourceResolver := image.SourceResolver()
 
@@ -194,7 +372,9 @@ func (c *Reconciler) reconcileSourceResolver(ctx context.Context, image *buildap
 	return c.Client.KpackV1alpha2().SourceResolvers(image.Namespace).Update(ctx, sourceResolver, metav1.UpdateOptions{})
 }
 
-func (c *Reconciler) reconcileBuildCache(ctx context.Context, image *buildapi.Image) (string, error) {
+// reconcileBuildCache neither creates nor resizes the build cache while it
+// exceeds the max cache size of a BuildQuota.
+func (c *Reconciler) reconcileBuildCache(ctx context.Context, image *buildapi.Image, quota *buildapi.BuildQuota) (string, error) {
 	if !image.Spec.NeedVolumeCache() {
 		buildCache, err := c.PvcLister.PersistentVolumeClaims(image.Namespace).Get(image.CacheName())
 		if err != nil && !k8serrors.IsNotFound(err) {
@@ -213,6 +393,8 @@ func (c *Reconciler) reconcileBuildCache(ctx context.Context, image *buildapi.Im
 	buildCache, err := c.PvcLister.PersistentVolumeClaims(image.Namespace).Get(image.CacheName())
 	if err != nil && !k8serrors.IsNotFound(err) {
 		return "", fmt.Errorf("failed to get image cache: %s", err)
+	} else if k8serrors.IsNotFound(err) && quota != nil {
+		return "", nil
 	} else if k8serrors.IsNotFound(err) {
 		buildCache, err = c.K8sClient.CoreV1().PersistentVolumeClaims(image.Namespace).Create(ctx, desiredBuildCache, metav1.CreateOptions{})
 		if err != nil {
@@ -220,7 +402,7 @@ func (c *Reconciler) reconcileBuildCache(ctx context.Context, image *buildapi.Im
 		}
 	}
 
-	if buildCacheEqual(desiredBuildCache, buildCache) {
+	if quota != nil || buildCacheEqual(desiredBuildCache, buildCache) {
 		return buildCache.Name, nil
 	}
 
@@ -237,7 +419,8 @@ func (c *Reconciler) deleteOldBuilds(ctx context.Context, image *buildapi.Image)
 		return fmt.Errorf("failed fetching all builds for image: %s", err)
 	}
 
-	if builds.NumberFailedBuilds() > *image.Spec.FailedBuildHistoryLimit {
+	// failed builds retained for debugging are kept until their ttl expires
+	if builds.NumberFailedBuilds() > *image.Spec.FailedBuildHistoryLimit && builds.OldestFailure().Status.DebugPodName == "" {
 		oldestFailedBuild := builds.OldestFailure()
 
 		err := c.Client.KpackV1alpha2().Builds(image.Namespace).Delete(ctx, oldestFailedBuild.Name, metav1.DeleteOptions{})
@@ -259,13 +442,7 @@ func (c *Reconciler) deleteOldBuilds(ctx context.Context, image *buildapi.Image)
 }
 
 func (c *Reconciler) fetchAllBuilds(image *buildapi.Image) (buildList, error) {
-	imageNameReq, err := labels.NewRequirement(buildapi.ImageLabel, selection.DoubleEquals, []string{image.Name})
-	if err != nil {
-		return buildList{}, fmt.Errorf("image name requirement: %s", err)
-	}
-
-	add := labels.NewSelector().Add(*imageNameReq)
-	builds, err := c.BuildLister.Builds(image.Namespace).List(add)
+	builds, err := c.BuildLister.Builds(image.Namespace).ListForImage(image)
 	if err != nil {
 		return buildList{}, fmt.Errorf("list builds: %s", err)
 	}
@@ -319,10 +496,15 @@ func reconcilerKeyForBuilderKind(image *buildapi.Image) reconciler.Key {
 			},
 		}
 	case buildapi.BuilderKind:
+		namespace := image.Namespace
+		if image.Spec.Builder.Namespace != "" {
+			namespace = image.Spec.Builder.Namespace
+		}
+
 		return reconciler.Key{
 			NamespacedName: types.NamespacedName{
 				Name:      image.Spec.Builder.Name,
-				Namespace: image.Namespace,
+				Namespace: namespace,
 			},
 			GroupKind: schema.GroupKind{
 				Group: "kpack.io",
@@ -1,6 +1,7 @@
 package image_test
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -25,9 +26,13 @@ import (
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
-	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/config"
+	"github.com/pivotal/kpack/pkg/duckbuilder"
 	"github.com/pivotal/kpack/pkg/reconciler/image"
+	"github.com/pivotal/kpack/pkg/reconciler/image/imagefakes"
 	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
 )
 
 func TestImageReconciler(t *testing.T) {
@@ -46,7 +51,23 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 		someValueToPassThrough       = "to-pass-through"
 		originalGeneration     int64 = 1
 	)
-	fakeTracker := &testhelpers.FakeTracker{}
+	var (
+		fakeKeychainFactory = &registryfakes.FakeKeychainFactory{}
+		fakeRegistryDeleter = &imagefakes.FakeRegistryDeleter{}
+		fakeTagClient       = &imagefakes.FakeTagClient{}
+		keychain            = &registryfakes.FakeKeychain{Name: "image"}
+		enqueuedAfter       []time.Duration
+	)
+
+	it.Before(func() {
+		fakeTagClient = &imagefakes.FakeTagClient{}
+		enqueuedAfter = nil
+		fakeKeychainFactory = &registryfakes.FakeKeychainFactory{}
+		fakeKeychainFactory.AddKeychainForSecretRef(t, registry.SecretRef{
+			ServiceAccount: serviceAccount,
+			Namespace:      namespace,
+		}, keychain)
+	})
 
 	rt := testhelpers.ReconcilerTester(t,
 		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
@@ -60,14 +81,26 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 			eventList := rtesting.EventList{Recorder: eventRecorder}
 
 			r := &image.Reconciler{
-				Client:               fakeClient,
-				ImageLister:          listers.GetImageLister(),
-				BuildLister:          listers.GetBuildLister(),
-				DuckBuilderLister:    listers.GetDuckBuilderLister(),
-				SourceResolverLister: listers.GetSourceResolverLister(),
-				PvcLister:            listers.GetPersistentVolumeClaimLister(),
-				Tracker:              fakeTracker,
-				K8sClient:            k8sfakeClient,
+				Client:                     fakeClient,
+				ImageLister:                listers.GetImageLister(),
+				BuildLister:                listers.GetBuildLister(),
+				DuckBuilderLister:          listers.GetDuckBuilderLister(),
+				SourceResolverLister:       listers.GetSourceResolverLister(),
+				BuilderGrantLister:         listers.GetBuilderGrantLister(),
+				BuildQuotaLister:           listers.GetBuildQuotaLister(),
+				BuildTemplateLister:        listers.GetBuildTemplateLister(),
+				ClusterBuildTemplateLister: listers.GetClusterBuildTemplateLister(),
+				PvcLister:                  listers.GetPersistentVolumeClaimLister(),
+				NetworkPolicyLister:        listers.GetNetworkPolicyLister(),
+				ConfigMapLister:            listers.GetConfigMapLister(),
+				K8sClient:                  k8sfakeClient,
+				KeychainFactory:            fakeKeychainFactory,
+				RegistryDeleter:            fakeRegistryDeleter,
+				TagClient:                  fakeTagClient,
+				EnqueueAfter: func(obj interface{}, after time.Duration) {
+					enqueuedAfter = append(enqueuedAfter, after)
+				},
+				KpackConfig:                config.NewKpackConfigStore(config.KpackConfig{}),
 			}
 
 			rtesting.PrependGenerateNameReactor(&fakeClient.Fake)
@@ -110,41 +143,6 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 		},
 	}
 
-	imageWithClusterBuilder := &buildapi.Image{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:       imageName,
-			Namespace:  namespace,
-			Generation: originalGeneration,
-			Labels: map[string]string{
-				someLabelKey: someValueToPassThrough,
-			},
-		},
-		Spec: buildapi.ImageSpec{
-			Tag: "some/image",
-			Builder: corev1.ObjectReference{
-				Kind: "ClusterBuilder",
-				Name: clusterBuilderName,
-			},
-			ServiceAccountName: serviceAccount,
-			Source: corev1alpha1.SourceConfig{
-				Git: &corev1alpha1.Git{
-					URL:      "https://some.git/url",
-					Revision: "1234567",
-				},
-			},
-			FailedBuildHistoryLimit:  limit(10),
-			SuccessBuildHistoryLimit: limit(10),
-			ImageTaggingStrategy:     corev1alpha1.None,
-			Build:                    &buildapi.ImageBuild{},
-		},
-		Status: buildapi.ImageStatus{
-			Status: corev1alpha1.Status{
-				ObservedGeneration: originalGeneration,
-				Conditions:         conditionReadyUnknown(),
-			},
-		},
-	}
-
 	builder := &buildapi.Builder{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      builderName,
@@ -257,40 +255,6 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
-		it("tracks builder for image", func() {
-			rt.Test(rtesting.TableRow{
-				Key: key,
-				Objects: []runtime.Object{
-					imageWithBuilder,
-					builder,
-					clusterBuilder,
-					unresolvedSourceResolver(imageWithBuilder),
-				},
-				WantErr: false,
-			})
-
-			require.True(t, fakeTracker.IsTracking(
-				reconciler.KeyForObject(builder).WithNamespace(namespace),
-				imageWithBuilder.NamespacedName()))
-		})
-
-		it("tracks clusterbuilder for image", func() {
-			rt.Test(rtesting.TableRow{
-				Key: key,
-				Objects: []runtime.Object{
-					imageWithClusterBuilder,
-					builder,
-					clusterBuilder,
-					unresolvedSourceResolver(imageWithClusterBuilder),
-				},
-				WantErr: false,
-			})
-
-			require.True(t, fakeTracker.IsTracking(
-				reconciler.KeyForObject(clusterBuilder),
-				imageWithClusterBuilder.NamespacedName()))
-		})
-
 		it("sets condition not ready for non-existent builder", func() {
 			rt.Test(rtesting.TableRow{
 				Key: key,
@@ -320,11 +284,230 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 				},
 			})
+		})
+
+		when("the builder is in another namespace", func() {
+			const platformNamespace = "platform-namespace"
+
+			platformBuilder := builder.DeepCopy()
+			platformBuilder.Namespace = platformNamespace
+
+			imageWithPlatformBuilder := imageWithBuilder.DeepCopy()
+			imageWithPlatformBuilder.Spec.Builder.Namespace = platformNamespace
+
+			grant := &buildapi.BuilderGrant{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "some-grant",
+					Namespace: platformNamespace,
+				},
+				Spec: buildapi.BuilderGrantSpec{
+					From: []buildapi.BuilderGrantFrom{{Namespace: namespace}},
+					To:   []buildapi.BuilderGrantTo{{Name: builderName}},
+				},
+			}
+
+			it("sets condition not ready without a builder grant", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithPlatformBuilder,
+						platformBuilder,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Image{
+								ObjectMeta: imageWithPlatformBuilder.ObjectMeta,
+								Spec:       imageWithPlatformBuilder.Spec,
+								Status: buildapi.ImageStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  "BuilderNotGranted",
+												Message: "No BuilderGrant in namespace platform-namespace allows namespace some-namespace to use builder builder-name.",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("sets condition not ready when the grant does not include the builder", func() {
+				otherGrant := grant.DeepCopy()
+				otherGrant.Spec.To = []buildapi.BuilderGrantTo{{Name: "some-other-builder"}}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithPlatformBuilder,
+						platformBuilder,
+						otherGrant,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Image{
+								ObjectMeta: imageWithPlatformBuilder.ObjectMeta,
+								Spec:       imageWithPlatformBuilder.Spec,
+								Status: buildapi.ImageStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  "BuilderNotGranted",
+												Message: "No BuilderGrant in namespace platform-namespace allows namespace some-namespace to use builder builder-name.",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("uses the builder when a grant allows it", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithPlatformBuilder,
+						platformBuilder,
+						grant,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						&buildapi.SourceResolver{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      imageWithPlatformBuilder.SourceResolverName(),
+								Namespace: namespace,
+								OwnerReferences: []metav1.OwnerReference{
+									*kmeta.NewControllerRef(imageWithPlatformBuilder),
+								},
+								Labels: map[string]string{
+									someLabelKey: someValueToPassThrough,
+								},
+							},
+							Spec: buildapi.SourceResolverSpec{
+								ServiceAccountName: imageWithPlatformBuilder.Spec.ServiceAccountName,
+								Source:             imageWithPlatformBuilder.Spec.Source,
+							},
+						},
+					},
+				})
+			})
+		})
+
+		when("the image references a build template", func() {
+			cacheSize := resource.MustParse("1.5")
+
+			template := &buildapi.ClusterBuildTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "some-template",
+				},
+				Spec: buildapi.BuildTemplateSpec{
+					Cache: &buildapi.ImageCacheConfig{
+						Volume: &buildapi.ImagePersistentVolumeCache{
+							Size: &cacheSize,
+						},
+					},
+				},
+			}
+
+			imageWithTemplate := imageWithBuilder.DeepCopy()
+			imageWithTemplate.Spec.BuildTemplate = &corev1.ObjectReference{
+				Kind: buildapi.ClusterBuildTemplateKind,
+				Name: "some-template",
+			}
+
+			it("sets condition not ready for a non-existent template", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithTemplate,
+						builder,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Image{
+								ObjectMeta: imageWithTemplate.ObjectMeta,
+								Spec:       imageWithTemplate.Spec,
+								Status: buildapi.ImageStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  "BuildTemplateNotFound",
+												Message: "Unable to find ClusterBuildTemplate some-template.",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
 
-			// still track resource
-			require.True(t, fakeTracker.IsTracking(
-				reconciler.KeyForObject(builder).WithNamespace(namespace),
-				imageWithBuilder.NamespacedName()))
+			it("reconciles the image with the template configuration", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithTemplate,
+						imageWithTemplate.SourceResolver(),
+						builder,
+						template,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						&corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      imageWithTemplate.CacheName(),
+								Namespace: namespace,
+								OwnerReferences: []metav1.OwnerReference{
+									*kmeta.NewControllerRef(imageWithTemplate),
+								},
+								Labels: map[string]string{
+									someLabelKey: someValueToPassThrough,
+								},
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceStorage: cacheSize,
+									},
+								},
+							},
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Image{
+								ObjectMeta: imageWithTemplate.ObjectMeta,
+								Spec:       imageWithTemplate.Spec,
+								Status: buildapi.ImageStatus{
+									BuildCacheName: imageWithTemplate.CacheName(),
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+								},
+							},
+						},
+					},
+				})
+			})
 		})
 
 		when("reconciling source resolvers", func() {
@@ -516,6 +699,54 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 
+			it("does not create a cache that exceeds the build quota", func() {
+				maxCacheSize := resource.MustParse("2")
+				quota := &buildapi.BuildQuota{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-quota",
+						Namespace: namespace,
+					},
+					Spec: buildapi.BuildQuotaSpec{
+						MaxCacheSize: &maxCacheSize,
+					},
+				}
+				otherImage := imageWithBuilder.DeepCopy()
+				otherImage.Name = "other-image"
+				otherImage.UID = "other-image-uid"
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithBuilder,
+						imageWithBuilder.SourceResolver(),
+						builder,
+						quota,
+						otherImage.BuildCache(),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Image{
+								ObjectMeta: imageWithBuilder.ObjectMeta,
+								Spec:       imageWithBuilder.Spec,
+								Status: buildapi.ImageStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: append(conditionReadyUnknown(), corev1alpha1.Condition{
+											Type:     buildapi.ConditionBuildCacheQuotaExceeded,
+											Status:   corev1.ConditionTrue,
+											Severity: corev1alpha1.ConditionSeverityWarning,
+											Reason:   buildapi.BuildQuotaExceededReason,
+											Message:  "build cache of 1500m exceeds the max cache size 2 of build quota some-quota",
+										}),
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
 			it("does not create a cache if a cache already exists", func() {
 				imageWithBuilder.Spec.Cache.Volume.Size = &cacheSize
 				imageWithBuilder.Status.BuildCacheName = imageWithBuilder.CacheName()
@@ -755,17 +986,96 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
-		when("reconciling builds", func() {
-			it("does not schedule a build if the source resolver is not ready", func() {
+		when("reconciling build network policies", func() {
+			networkPolicyConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      config.BuildNetworkPolicyConfigName,
+					Namespace: namespace,
+				},
+				Data: map[string]string{
+					config.BuildNetworkPolicyEgressKey: "- cidr: 10.0.0.0/8\n  ports: [443]\n",
+				},
+			}
+
+			it("creates a network policy from the namespace configmap", func() {
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
 						imageWithBuilder,
+						imageWithBuilder.SourceResolver(),
+						networkPolicyConfigMap,
 						builder,
-						unresolvedSourceResolver(imageWithBuilder),
 					},
 					WantErr: false,
-					//no builds are created
+					WantCreates: []runtime.Object{
+						imageWithBuilder.BuildNetworkPolicy(&buildapi.BuildNetworkPolicy{
+							Egress: []buildapi.BuildNetworkEgress{
+								{CIDR: "10.0.0.0/8", Ports: []int32{443}},
+							},
+						}),
+					},
+				})
+			})
+
+			it("prefers the network policy of the image", func() {
+				imagePolicy := &buildapi.BuildNetworkPolicy{
+					Egress: []buildapi.BuildNetworkEgress{
+						{CIDR: "192.168.0.0/16"},
+					},
+				}
+				imageWithBuilder.Spec.Build.NetworkPolicy = imagePolicy
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithBuilder,
+						imageWithBuilder.SourceResolver(),
+						networkPolicyConfigMap,
+						builder,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						imageWithBuilder.BuildNetworkPolicy(imagePolicy),
+					},
+				})
+			})
+
+			it("deletes the network policy when no policy is configured", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithBuilder,
+						imageWithBuilder.SourceResolver(),
+						imageWithBuilder.BuildNetworkPolicy(&buildapi.BuildNetworkPolicy{}),
+						builder,
+					},
+					WantErr: false,
+					WantDeletes: []clientgotesting.DeleteActionImpl{
+						{
+							ActionImpl: clientgotesting.ActionImpl{
+								Namespace: namespace,
+								Resource: schema.GroupVersionResource{
+									Resource: "networkpolicies",
+								},
+							},
+							Name: imageWithBuilder.NetworkPolicyName(),
+						},
+					},
+				})
+			})
+		})
+
+		when("reconciling builds", func() {
+			it("does not schedule a build if the source resolver is not ready", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithBuilder,
+						builder,
+						unresolvedSourceResolver(imageWithBuilder),
+					},
+					WantErr: false,
+					//no builds are created
 					WantCreates: nil,
 				})
 
@@ -825,7 +1135,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-1",
 								Namespace: namespace,
@@ -878,7 +1188,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									},
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -894,6 +1204,99 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									BuildNumber:                1,
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("does not reuse build numbers after builds are deleted", func() {
+				imageWithBuilder.Status.BuildCounter = 7
+				imageWithBuilder.Status.BuildNumber = 7
+				imageWithBuilder.Status.LatestBuildRef = "image-name-build-7"
+
+				sourceResolver := resolvedSourceResolver(imageWithBuilder)
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithBuilder,
+						builder,
+						sourceResolver,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						withInputHash(&buildapi.Build{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      imageName + "-build-8",
+								Namespace: namespace,
+								OwnerReferences: []metav1.OwnerReference{
+									*kmeta.NewControllerRef(imageWithBuilder),
+								},
+								Labels: map[string]string{
+									buildapi.BuildNumberLabel:     "8",
+									buildapi.ImageLabel:           imageName,
+									buildapi.ImageGenerationLabel: generation(imageWithBuilder),
+									someLabelKey:                  someValueToPassThrough,
+								},
+								Annotations: map[string]string{
+									buildapi.BuilderNameAnnotation: builderName,
+									buildapi.BuilderKindAnnotation: buildapi.BuilderKind,
+									buildapi.BuildReasonAnnotation: buildapi.BuildReasonConfig,
+									buildapi.BuildChangesAnnotation: testhelpers.CompactJSON(`
+[
+  {
+    "reason": "CONFIG",
+    "old": {
+      "resources": {},
+      "source": {}
+    },
+    "new": {
+      "resources": {},
+      "source": {
+        "git": {
+          "url": "https://some.git/url-resolved",
+          "revision": "1234567-resolved"
+        }
+      }
+    }
+  }
+]`),
+								},
+							},
+							Spec: buildapi.BuildSpec{
+								Tags: []string{imageWithBuilder.Spec.Tag},
+								Builder: corev1alpha1.BuildBuilderSpec{
+									Image: builder.Status.LatestImage,
+								},
+								ServiceAccountName: imageWithBuilder.Spec.ServiceAccountName,
+								Cache:              &buildapi.BuildCacheConfig{},
+								RunImage:           builderRunImage,
+								Source: corev1alpha1.SourceConfig{
+									Git: &corev1alpha1.Git{
+										URL:      sourceResolver.Status.Source.Git.URL,
+										Revision: sourceResolver.Status.Source.Git.Revision,
+									},
+								},
+							},
+						}),
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Image{
+								ObjectMeta: imageWithBuilder.ObjectMeta,
+								Spec:       imageWithBuilder.Spec,
+								Status: buildapi.ImageStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionBuildExecuting("image-name-build-8"),
+									},
+									LatestBuildRef:             "image-name-build-8",
+									LatestBuildReason:          "CONFIG",
+									LatestBuildImageGeneration: originalGeneration,
+									BuildCounter:               8,
+									BuildNumber:                8,
 								},
 							},
 						},
@@ -918,7 +1321,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-1",
 								Namespace: namespace,
@@ -971,7 +1374,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									},
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -987,6 +1390,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									BuildNumber:                1,
 								},
 							},
 						},
@@ -1011,7 +1415,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-1",
 								Namespace: namespace,
@@ -1064,7 +1468,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									},
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -1080,6 +1484,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									BuildNumber:                1,
 								},
 							},
 						},
@@ -1105,7 +1510,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-1",
 								Namespace: namespace,
@@ -1158,7 +1563,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									},
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -1174,6 +1579,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									BuildNumber:                1,
 								},
 							},
 						},
@@ -1201,7 +1607,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-1",
 								Namespace: namespace,
@@ -1258,7 +1664,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 								},
 								RunImage: builderRunImage,
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -1274,6 +1680,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									BuildNumber:                1,
 									BuildCacheName:             imageWithBuilder.CacheName(),
 								},
 							},
@@ -1284,6 +1691,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("schedules a build if the previous build does not match source", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-100001"
 
 				sourceResolver := resolvedSourceResolver(imageWithBuilder)
@@ -1337,7 +1745,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-2",
 								Namespace: namespace,
@@ -1407,7 +1815,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									StackId: "io.buildpacks.stacks.bionic",
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -1424,6 +1832,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildImageGeneration: originalGeneration,
 									LatestImage:                imageWithBuilder.Spec.Tag + "@sha256:just-built",
 									BuildCounter:               2,
+									BuildNumber:                2,
 								},
 							},
 						},
@@ -1433,6 +1842,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("schedules a build when source resolver is updated", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
 
 				sourceResolver := imageWithBuilder.SourceResolver()
@@ -1497,7 +1907,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-2",
 								Namespace: namespace,
@@ -1536,7 +1946,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									StackId: "io.buildpacks.stacks.bionic",
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -1553,6 +1963,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildImageGeneration: originalGeneration,
 									LatestImage:                imageWithBuilder.Spec.Tag + "@sha256:just-built",
 									BuildCounter:               2,
+									BuildNumber:                2,
 								},
 							},
 						},
@@ -1562,6 +1973,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("schedules a build when the builder buildpacks are updated", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
 				const updatedBuilderImage = "some/builder@sha256:updated"
 
@@ -1651,7 +2063,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-2",
 								Namespace: namespace,
@@ -1702,7 +2114,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									StackId: "io.buildpacks.stacks.bionic",
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -1719,6 +2131,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildImageGeneration: originalGeneration,
 									LatestImage:                imageWithBuilder.Spec.Tag + "@sha256:just-built",
 									BuildCounter:               2,
+									BuildNumber:                2,
 								},
 							},
 						},
@@ -1728,6 +2141,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("schedules a build when the builder stack is updated", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
 				const updatedBuilderImage = "some/builder@sha256:updated"
 				const updatedBuilderRunImage = "gcr.io/test-project/install/run@sha256:01ea3600f15a73f0ad445351c681eb0377738f5964cbcd2bab0cfec9ca891a08"
@@ -1821,7 +2235,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-2",
 								Namespace: namespace,
@@ -1867,7 +2281,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									StackId: "io.buildpacks.stacks.bionic",
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -1884,6 +2298,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          buildapi.BuildReasonStack,
 									LatestImage:                imageWithBuilder.Spec.Tag + "@sha256:just-built",
 									BuildCounter:               2,
+									BuildNumber:                2,
 								},
 							},
 						},
@@ -1893,6 +2308,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("schedules a build with previous build's LastBuild if the last build failed", func() {
 				imageWithBuilder.Status.BuildCounter = 2
+				imageWithBuilder.Status.BuildNumber = 2
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build200001"
 
 				sourceResolver := resolvedSourceResolver(imageWithBuilder)
@@ -1945,7 +2361,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					},
 					WantErr: false,
 					WantCreates: []runtime.Object{
-						&buildapi.Build{
+						withInputHash(&buildapi.Build{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      imageName + "-build-3",
 								Namespace: namespace,
@@ -2015,7 +2431,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									StackId: "io.buildpacks.stacks.bionic",
 								},
 							},
-						},
+						}),
 					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
@@ -2031,6 +2447,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "COMMIT,CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               3,
+									BuildNumber:                3,
 								},
 							},
 						},
@@ -2040,6 +2457,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("does not schedule a build if the previous build is running and updates image status with build status", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
 
 				sourceResolver := resolvedSourceResolver(imageWithBuilder)
@@ -2111,6 +2529,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									},
 									LatestBuildRef: "image-name-build-1",
 									BuildCounter:   1,
+									BuildNumber:    1,
 								},
 							},
 						},
@@ -2120,6 +2539,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("does not schedule a build if the previous build spec matches the current desired spec", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
 				imageWithBuilder.Status.LatestImage = "some/image@sha256:ad3f454c"
 				imageWithBuilder.Status.Conditions = conditionReady()
@@ -2182,8 +2602,59 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 
+			it("does not schedule a build if only cosmetic config changed since the last successful build", func() {
+				sourceResolver := resolvedSourceResolver(imageWithBuilder)
+
+				lastBuild := imageWithBuilder.Build(sourceResolver, &duckbuilder.DuckBuilder{
+					TypeMeta:   builder.TypeMeta,
+					ObjectMeta: builder.ObjectMeta,
+					Status:     builder.Status,
+				}, nil, buildapi.BuildReasonConfig, "", 1, "")
+				lastBuild.Status = buildapi.BuildStatus{
+					LatestImage: "some/image@sha256:ad3f454c",
+					Stack: corev1alpha1.BuildStack{
+						RunImage: "some/run@sha256:67e3de2af270bf09c02e9a644aeb7e87e6b3c049abe6766bf6b6c3728a83e7fb",
+						ID:       "io.buildpacks.stacks.bionic",
+					},
+					Status: corev1alpha1.Status{
+						Conditions: corev1alpha1.Conditions{
+							{
+								Type:   corev1alpha1.ConditionSucceeded,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				}
+
+				imageWithBuilder.Spec.Build.Resources = corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2"),
+					},
+				}
+				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
+				imageWithBuilder.Status.LatestBuildRef = lastBuild.Name
+				imageWithBuilder.Status.LatestBuildReason = buildapi.BuildReasonConfig
+				imageWithBuilder.Status.LatestBuildImageGeneration = originalGeneration
+				imageWithBuilder.Status.LatestImage = lastBuild.Status.LatestImage
+				imageWithBuilder.Status.LatestStack = "io.buildpacks.stacks.bionic"
+				imageWithBuilder.Status.Conditions = conditionReady()
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						imageWithBuilder,
+						builder,
+						sourceResolver,
+						lastBuild,
+					},
+					WantErr: false,
+				})
+			})
+
 			it("reports the last successful build on the image when the last build is successful", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
 				imageWithBuilder.Status.LatestImage = "some/image@some-old-sha"
 				imageWithBuilder.Status.LatestStack = "io.buildpacks.stacks.bionic"
@@ -2220,6 +2691,67 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildRef: "image-name-build-1",
 									LatestImage:    "some/image@sha256:build-1",
 									BuildCounter:   1,
+									BuildNumber:    1,
+									LatestStack:    "io.buildpacks.stacks.bionic",
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("warns when the builder uses a stack past its end of life", func() {
+				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
+				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
+				imageWithBuilder.Status.LatestImage = "some/image@some-old-sha"
+				imageWithBuilder.Status.LatestStack = "io.buildpacks.stacks.bionic"
+
+				builder.Status.StackDeprecation = &buildapi.StackDeprecation{
+					Message:   "migrate to io.buildpacks.stacks.jammy",
+					EndOfLife: &metav1.Time{Time: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)},
+				}
+
+				sourceResolver := resolvedSourceResolver(imageWithBuilder)
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: runtimeObjects(
+						successfulBuilds(imageWithBuilder, sourceResolver, 1),
+						imageWithBuilder,
+						builder,
+						sourceResolver,
+					),
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &buildapi.Image{
+								ObjectMeta: imageWithBuilder.ObjectMeta,
+								Spec:       imageWithBuilder.Spec,
+								Status: buildapi.ImageStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionReady,
+												Status: corev1.ConditionTrue,
+											},
+											{
+												Type:   buildapi.ConditionBuilderReady,
+												Status: corev1.ConditionTrue,
+											},
+											{
+												Type:     buildapi.ConditionStackDeprecated,
+												Status:   corev1.ConditionTrue,
+												Severity: corev1alpha1.ConditionSeverityWarning,
+												Reason:   buildapi.StackEndOfLifeReason,
+												Message:  "stack io.buildpacks.stacks.bionic reached its end of life on 2020-01-01T00:00:00Z: migrate to io.buildpacks.stacks.jammy",
+											},
+										},
+									},
+									LatestBuildRef: "image-name-build-1",
+									LatestImage:    "some/image@sha256:build-1",
+									BuildCounter:   1,
+									BuildNumber:    1,
 									LatestStack:    "io.buildpacks.stacks.bionic",
 								},
 							},
@@ -2230,6 +2762,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("reports unknown when last build was successful and source resolver is unknown", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
 				imageWithBuilder.Status.LatestImage = "some/image@some-old-sha"
 				imageWithBuilder.Status.LatestStack = "io.buildpacks.stacks.bionic"
@@ -2257,6 +2790,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildRef: "image-name-build-1",
 									LatestImage:    "some/image@sha256:build-1",
 									BuildCounter:   1,
+									BuildNumber:    1,
 									LatestStack:    "io.buildpacks.stacks.bionic",
 								},
 							},
@@ -2267,6 +2801,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("reports unknown when last build was successful and builder is not ready", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
 				imageWithBuilder.Status.LatestImage = "some/image@some-old-sha"
 				imageWithBuilder.Status.LatestStack = "io.buildpacks.stacks.bionic"
@@ -2305,6 +2840,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildRef: "image-name-build-1",
 									LatestImage:    "some/image@sha256:build-1",
 									BuildCounter:   1,
+									BuildNumber:    1,
 									LatestStack:    "io.buildpacks.stacks.bionic",
 								},
 							},
@@ -2315,6 +2851,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			it("includes failed builds status in not ready condition", func() {
 				imageWithBuilder.Status.BuildCounter = 1
+				imageWithBuilder.Status.BuildNumber = 1
 				failureMessage := "something went wrong"
 				sourceResolver := resolvedSourceResolver(imageWithBuilder)
 				failedBuild := &buildapi.Build{
@@ -2387,6 +2924,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									},
 									LatestBuildRef: "image-name-build-1",
 									BuildCounter:   1,
+									BuildNumber:    1,
 								},
 							},
 						},
@@ -2400,6 +2938,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					imageWithBuilder.Status.LatestBuildRef = "image-name-build-5"
 					imageWithBuilder.Status.Conditions = conditionNotReady()
 					imageWithBuilder.Status.BuildCounter = 5
+					imageWithBuilder.Status.BuildNumber = 5
 					sourceResolver := resolvedSourceResolver(imageWithBuilder)
 
 					rt.Test(rtesting.TableRow{
@@ -2427,6 +2966,29 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					})
 				})
 
+				it("does not delete a failed build that is retained for debugging", func() {
+					imageWithBuilder.Spec.FailedBuildHistoryLimit = limit(4)
+					imageWithBuilder.Status.LatestBuildRef = "image-name-build-5"
+					imageWithBuilder.Status.Conditions = conditionNotReady()
+					imageWithBuilder.Status.BuildCounter = 5
+					imageWithBuilder.Status.BuildNumber = 5
+					sourceResolver := resolvedSourceResolver(imageWithBuilder)
+
+					builds := failedBuilds(imageWithBuilder, sourceResolver, 5)
+					builds[0].(*buildapi.Build).Status.DebugPodName = "image-name-build-1-debug"
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							builds,
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+					})
+				})
+
 				it("deletes a successful build if more than the limit", func() {
 					imageWithBuilder.Spec.SuccessBuildHistoryLimit = limit(4)
 					imageWithBuilder.Status.LatestBuildRef = "image-name-build-5"
@@ -2434,6 +2996,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					imageWithBuilder.Status.LatestStack = "io.buildpacks.stacks.bionic"
 					imageWithBuilder.Status.Conditions = conditionReady()
 					imageWithBuilder.Status.BuildCounter = 5
+					imageWithBuilder.Status.BuildNumber = 5
 					sourceResolver := resolvedSourceResolver(imageWithBuilder)
 
 					rt.Test(rtesting.TableRow{
@@ -2461,6 +3024,335 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 					})
 				})
 			})
+
+			when("registry gc is enabled", func() {
+				var sourceResolver *buildapi.SourceResolver
+
+				it.Before(func() {
+					imageWithBuilder.Spec.RegistryGC = &buildapi.ImageRegistryGC{KeepLast: limit(2)}
+					imageWithBuilder.Status.LatestBuildRef = "image-name-build-5"
+					imageWithBuilder.Status.LatestImage = "some/image@sha256:build-5"
+					imageWithBuilder.Status.LatestStack = "io.buildpacks.stacks.bionic"
+					imageWithBuilder.Status.Conditions = conditionReady()
+					imageWithBuilder.Status.BuildCounter = 5
+					imageWithBuilder.Status.BuildNumber = 5
+					sourceResolver = resolvedSourceResolver(imageWithBuilder)
+				})
+
+				it("deletes images of builds beyond keepLast and marks the builds as pruned", func() {
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.RegistryGC = &buildapi.ImageRegistryGCStatus{
+						PrunedImages: []string{
+							"some/image@sha256:build-3",
+							"some/image@sha256:build-2",
+							"some/image@sha256:build-1",
+						},
+					}
+
+					objects := successfulBuilds(imageWithBuilder, sourceResolver, 5)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							objects,
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: prunedCopy(objects[2])},
+							{Object: prunedCopy(objects[1])},
+							{Object: prunedCopy(objects[0])},
+						},
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+
+					require.Equal(t, 3, fakeRegistryDeleter.DeleteCallCount())
+					actualKeychain, deleted := fakeRegistryDeleter.DeleteArgsForCall(0)
+					assert.Equal(t, keychain, actualKeychain)
+					assert.Equal(t, "some/image@sha256:build-3", deleted)
+				})
+
+				it("reports prunable images without deleting on dry run", func() {
+					imageWithBuilder.Spec.RegistryGC.DryRun = true
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.RegistryGC = &buildapi.ImageRegistryGCStatus{
+						PrunableImages: []string{
+							"some/image@sha256:build-3",
+							"some/image@sha256:build-2",
+							"some/image@sha256:build-1",
+						},
+					}
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							successfulBuilds(imageWithBuilder, sourceResolver, 5),
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+
+					require.Equal(t, 0, fakeRegistryDeleter.DeleteCallCount())
+				})
+
+				it("skips builds that were already pruned and keeps previously pruned images", func() {
+					imageWithBuilder.Status.RegistryGC = &buildapi.ImageRegistryGCStatus{
+						PrunedImages: []string{"some/image@sha256:build-2", "some/image@sha256:build-1"},
+					}
+
+					objects := successfulBuilds(imageWithBuilder, sourceResolver, 5)
+					markPruned(objects[0])
+					markPruned(objects[1])
+					markPruned(objects[2])
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							objects,
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+					})
+
+					require.Equal(t, 0, fakeRegistryDeleter.DeleteCallCount())
+				})
+
+				it("does not delete digests still referenced by retained builds", func() {
+					objects := successfulBuilds(imageWithBuilder, sourceResolver, 5)
+					objects[1].(*buildapi.Build).Status.LatestImage = "some/image@sha256:build-4"
+
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.RegistryGC = &buildapi.ImageRegistryGCStatus{
+						PrunedImages: []string{
+							"some/image@sha256:build-3",
+							"some/image@sha256:build-1",
+						},
+					}
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							objects,
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: prunedCopy(objects[2])},
+							{Object: prunedCopy(objects[1])},
+							{Object: prunedCopy(objects[0])},
+						},
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+
+					require.Equal(t, 2, fakeRegistryDeleter.DeleteCallCount())
+				})
+
+				it("only prunes builds older than ttlHours", func() {
+					imageWithBuilder.Spec.RegistryGC = &buildapi.ImageRegistryGC{TTLHours: limit(1)}
+
+					objects := successfulBuilds(imageWithBuilder, sourceResolver, 5)
+					objects[0].(*buildapi.Build).CreationTimestamp = metav1.NewTime(time.Now().Add(-3 * time.Hour))
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.RegistryGC = &buildapi.ImageRegistryGCStatus{
+						PrunedImages: []string{"some/image@sha256:build-1"},
+					}
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							objects,
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: prunedCopy(objects[0])},
+						},
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+				})
+
+				it("reports when the registry does not permit deletes", func() {
+					fakeRegistryDeleter.DeleteReturns(&registry.DeleteNotPermittedError{Err: errors.New("UNAUTHORIZED")})
+
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.RegistryGC = &buildapi.ImageRegistryGCStatus{
+						Message: "registry does not permit deleting images: UNAUTHORIZED",
+					}
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							successfulBuilds(imageWithBuilder, sourceResolver, 5),
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+
+					require.Equal(t, 1, fakeRegistryDeleter.DeleteCallCount())
+				})
+			})
+
+			when("drift detection is enabled", func() {
+				var sourceResolver *buildapi.SourceResolver
+
+				it.Before(func() {
+					imageWithBuilder.Spec.DriftDetection = &buildapi.ImageDriftDetection{
+						IntervalSeconds: 60,
+						Action:          buildapi.DriftActionReport,
+					}
+					imageWithBuilder.Status.LatestBuildRef = "image-name-build-1"
+					imageWithBuilder.Status.LatestImage = "some/image@sha256:build-1"
+					imageWithBuilder.Status.LatestStack = "io.buildpacks.stacks.bionic"
+					imageWithBuilder.Status.Conditions = conditionReady()
+					imageWithBuilder.Status.BuildCounter = 1
+					imageWithBuilder.Status.BuildNumber = 1
+					sourceResolver = resolvedSourceResolver(imageWithBuilder)
+				})
+
+				it("reports no drift when the tag points at the latest image", func() {
+					fakeTagClient.DigestReturns("sha256:build-1", nil)
+
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.Conditions = append(conditionReady(), corev1alpha1.Condition{
+						Type:     buildapi.ConditionDrifted,
+						Status:   corev1.ConditionFalse,
+						Severity: corev1alpha1.ConditionSeverityWarning,
+					})
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							successfulBuilds(imageWithBuilder, sourceResolver, 1),
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+
+					require.Equal(t, 1, fakeTagClient.DigestCallCount())
+					actualKeychain, tag := fakeTagClient.DigestArgsForCall(0)
+					assert.Equal(t, keychain, actualKeychain)
+					assert.Equal(t, "some/image", tag)
+					assert.Equal(t, []time.Duration{60 * time.Second}, enqueuedAfter)
+				})
+
+				it("reports drift when the tag was overwritten", func() {
+					fakeTagClient.DigestReturns("sha256:overwritten", nil)
+
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.Conditions = append(conditionReady(), corev1alpha1.Condition{
+						Type:     buildapi.ConditionDrifted,
+						Status:   corev1.ConditionTrue,
+						Severity: corev1alpha1.ConditionSeverityWarning,
+						Reason:   image.TagDriftedReason,
+						Message:  "tag some/image points at sha256:overwritten instead of sha256:build-1",
+					})
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							successfulBuilds(imageWithBuilder, sourceResolver, 1),
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+
+					require.Equal(t, 0, fakeTagClient.CopyCallCount())
+				})
+
+				it("re-pushes the latest image when the action is Repush", func() {
+					imageWithBuilder.Spec.DriftDetection.Action = buildapi.DriftActionRepush
+					fakeTagClient.DigestReturns("sha256:overwritten", nil)
+
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.Conditions = append(conditionReady(), corev1alpha1.Condition{
+						Type:     buildapi.ConditionDrifted,
+						Status:   corev1.ConditionFalse,
+						Severity: corev1alpha1.ConditionSeverityWarning,
+						Reason:   image.TagRepushedReason,
+						Message:  "tag some/image points at sha256:overwritten instead of sha256:build-1, re-pushed some/image@sha256:build-1",
+					})
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							successfulBuilds(imageWithBuilder, sourceResolver, 1),
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+
+					require.Equal(t, 1, fakeTagClient.CopyCallCount())
+					_, src, dst := fakeTagClient.CopyArgsForCall(0)
+					assert.Equal(t, "some/image@sha256:build-1", src)
+					assert.Equal(t, "some/image", dst)
+				})
+
+				it("reports registry errors without failing the reconcile", func() {
+					fakeTagClient.DigestReturns("", errors.New("UNAUTHORIZED"))
+
+					expectedImage := imageWithBuilder.DeepCopy()
+					expectedImage.Status.Conditions = append(conditionReady(), corev1alpha1.Condition{
+						Type:     buildapi.ConditionDrifted,
+						Status:   corev1.ConditionUnknown,
+						Severity: corev1alpha1.ConditionSeverityWarning,
+						Reason:   image.DriftCheckFailReason,
+						Message:  "UNAUTHORIZED",
+					})
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							successfulBuilds(imageWithBuilder, sourceResolver, 1),
+							imageWithBuilder,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{Object: expectedImage},
+						},
+					})
+				})
+			})
 		})
 
 		when("defaulting has not happened", func() {
@@ -2581,6 +3473,25 @@ func builds(image *buildapi.Image, sourceResolver *buildapi.SourceResolver, coun
 	return builds
 }
 
+func prunedCopy(object runtime.Object) runtime.Object {
+	build := object.DeepCopyObject()
+	markPruned(build)
+	return build
+}
+
+func markPruned(object runtime.Object) {
+	build := object.(*buildapi.Build)
+	if build.Annotations == nil {
+		build.Annotations = map[string]string{}
+	}
+	build.Annotations[buildapi.RegistryPrunedAnnotation] = "true"
+}
+
+func withInputHash(build *buildapi.Build) *buildapi.Build {
+	build.Annotations[buildapi.BuildInputHashAnnotation] = build.InputHash()
+	return build
+}
+
 func runtimeObjects(objects []runtime.Object, additional ...runtime.Object) []runtime.Object {
 	return append(objects, additional...)
 }
@@ -0,0 +1,69 @@
+package image
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/config"
+)
+
+func (c *Reconciler) reconcileBuildNetworkPolicy(ctx context.Context, image *buildapi.Image) error {
+	namespacePolicy, err := c.namespaceBuildNetworkPolicy(image.Namespace)
+	if err != nil {
+		return err
+	}
+
+	networkPolicy, err := c.NetworkPolicyLister.NetworkPolicies(image.Namespace).Get(image.NetworkPolicyName())
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrap(err, "cannot retrieve network policy")
+	}
+
+	policy := image.EffectiveBuildNetworkPolicy(namespacePolicy)
+	if policy == nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return c.K8sClient.NetworkingV1().NetworkPolicies(image.Namespace).Delete(ctx, image.NetworkPolicyName(), metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &networkPolicy.UID},
+		})
+	}
+
+	desiredNetworkPolicy := image.BuildNetworkPolicy(policy)
+	if k8serrors.IsNotFound(err) {
+		_, err = c.K8sClient.NetworkingV1().NetworkPolicies(image.Namespace).Create(ctx, desiredNetworkPolicy, metav1.CreateOptions{})
+		return errors.Wrap(err, "cannot create network policy")
+	}
+
+	if networkPoliciesEqual(desiredNetworkPolicy, networkPolicy) {
+		return nil
+	}
+
+	existing := networkPolicy.DeepCopy()
+	existing.Spec = desiredNetworkPolicy.Spec
+	existing.Labels = desiredNetworkPolicy.Labels
+	_, err = c.K8sClient.NetworkingV1().NetworkPolicies(image.Namespace).Update(ctx, existing, metav1.UpdateOptions{})
+	return errors.Wrap(err, "cannot update network policy")
+}
+
+func (c *Reconciler) namespaceBuildNetworkPolicy(namespace string) (*buildapi.BuildNetworkPolicy, error) {
+	cm, err := c.ConfigMapLister.ConfigMaps(namespace).Get(config.BuildNetworkPolicyConfigName)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "cannot retrieve build network policy configmap")
+	}
+
+	return config.ParseBuildNetworkPolicy(cm)
+}
+
+func networkPoliciesEqual(desired *networkingv1.NetworkPolicy, networkPolicy *networkingv1.NetworkPolicy) bool {
+	return equality.Semantic.DeepEqual(desired.Spec, networkPolicy.Spec) &&
+		equality.Semantic.DeepEqual(desired.Labels, networkPolicy.Labels)
+}
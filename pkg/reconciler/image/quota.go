@@ -0,0 +1,71 @@
+package image
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+// buildCacheQuotaExceeded returns the BuildQuota whose max cache size would be
+// exceeded by the build cache of the image together with the build caches of
+// the other Images in the namespace.
+func (c *Reconciler) buildCacheQuotaExceeded(image *buildapi.Image) (*buildapi.BuildQuota, error) {
+	if !image.Spec.NeedVolumeCache() {
+		return nil, nil
+	}
+
+	quotas, err := c.BuildQuotaLister.BuildQuotas(image.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list build quotas")
+	} else if len(quotas) == 0 {
+		return nil, nil
+	}
+
+	claims, err := c.PvcLister.PersistentVolumeClaims(image.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list persistent volume claims")
+	}
+
+	total := image.Spec.Cache.Volume.Size.DeepCopy()
+	for _, claim := range claims {
+		if claim.Name == image.CacheName() || !isBuildCache(claim) {
+			continue
+		}
+		total.Add(claim.Spec.Resources.Requests[corev1.ResourceStorage])
+	}
+
+	for _, quota := range quotas {
+		if quota.Spec.MaxCacheSize != nil && total.Cmp(*quota.Spec.MaxCacheSize) > 0 {
+			return quota, nil
+		}
+	}
+	return nil, nil
+}
+
+func isBuildCache(claim *corev1.PersistentVolumeClaim) bool {
+	owner := metav1.GetControllerOf(claim)
+	return owner != nil && owner.Kind == Kind && owner.APIVersion == buildapi.SchemeGroupVersion.String()
+}
+
+func buildCacheQuotaConditions(image *buildapi.Image, quota *buildapi.BuildQuota) corev1alpha1.Conditions {
+	if quota == nil {
+		return nil
+	}
+
+	return corev1alpha1.Conditions{
+		{
+			Type:               buildapi.ConditionBuildCacheQuotaExceeded,
+			Status:             corev1.ConditionTrue,
+			Severity:           corev1alpha1.ConditionSeverityWarning,
+			Reason:             buildapi.BuildQuotaExceededReason,
+			Message:            fmt.Sprintf("build cache of %s exceeds the max cache size %s of build quota %s", image.Spec.Cache.Volume.Size.String(), quota.Spec.MaxCacheSize.String(), quota.Name),
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
@@ -11,28 +11,33 @@ import (
 
 	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/buildchange"
 )
 
 const BuildRunningReason = "BuildRunning"
 
 func (c *Reconciler) reconcileBuild(ctx context.Context, image *buildapi.Image, latestBuild *buildapi.Build, sourceResolver *buildapi.SourceResolver, builder buildapi.BuilderResource, buildCacheName string) (buildapi.ImageStatus, error) {
-	currentBuildNumber, err := buildCounter(latestBuild)
+	currentBuildNumber, err := buildNumber(image, latestBuild)
 	if err != nil {
 		return buildapi.ImageStatus{}, err
 	}
 
-	result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+	result, err := buildchange.BuildRequired(image, latestBuild, sourceResolver, builder)
 	if err != nil {
 		return buildapi.ImageStatus{}, errors.Wrap(err, "error determining if an image build is needed")
 	}
 	priorityClass := ""
-	if c.EnablePriorityClasses {
+	if c.KpackConfig.Load().FeatureGates.EnablePriorityClasses {
 		priorityClass = result.PriorityClass
 	}
 	switch result.ConditionStatus {
 	case corev1.ConditionTrue:
 		nextBuildNumber := currentBuildNumber + 1
 		build := image.Build(sourceResolver, builder, latestBuild, result.ReasonsStr, result.ChangesStr, nextBuildNumber, priorityClass)
+		if buildInputsUnchanged(latestBuild, build) {
+			return noScheduledBuildStatus(image, corev1.ConditionFalse, builder, latestBuild, sourceResolver, currentBuildNumber, buildCacheName), nil
+		}
+
 		build, err = c.Client.KpackV1alpha2().Builds(build.Namespace).Create(ctx, build, metav1.CreateOptions{})
 		if err != nil {
 			return buildapi.ImageStatus{}, err
@@ -43,33 +48,56 @@ func (c *Reconciler) reconcileBuild(ctx context.Context, image *buildapi.Image,
 				Conditions: scheduledBuildCondition(build),
 			},
 			BuildCounter:               nextBuildNumber,
+			BuildNumber:                nextBuildNumber,
 			BuildCacheName:             buildCacheName,
 			LatestBuildRef:             build.BuildRef(),
 			LatestBuildReason:          build.BuildReason(),
 			LatestImage:                image.LatestForImage(latestBuild),
+			LatestRunImage:             image.LatestRunImageForImage(latestBuild),
 			LatestStack:                build.Stack(),
 			LatestBuildImageGeneration: build.ImageGeneration(),
 		}, nil
 	case corev1.ConditionUnknown:
 		fallthrough
 	case corev1.ConditionFalse:
-		return buildapi.ImageStatus{
-			Status: corev1alpha1.Status{
-				Conditions: noScheduledBuild(result.ConditionStatus, builder, latestBuild, sourceResolver),
-			},
-			LatestBuildRef:             latestBuild.BuildRef(),
-			LatestBuildReason:          latestBuild.BuildReason(),
-			LatestBuildImageGeneration: latestBuild.ImageGeneration(),
-			LatestImage:                image.LatestForImage(latestBuild),
-			LatestStack:                latestBuild.Stack(),
-			BuildCounter:               currentBuildNumber,
-			BuildCacheName:             buildCacheName,
-		}, nil
+		return noScheduledBuildStatus(image, result.ConditionStatus, builder, latestBuild, sourceResolver, currentBuildNumber, buildCacheName), nil
 	default:
 		return buildapi.ImageStatus{}, errors.Errorf("unexpected build needed condition %s", result.ConditionStatus)
 	}
 }
 
+func noScheduledBuildStatus(image *buildapi.Image, buildNeeded corev1.ConditionStatus, builder buildapi.BuilderResource, latestBuild *buildapi.Build, sourceResolver *buildapi.SourceResolver, currentBuildNumber int64, buildCacheName string) buildapi.ImageStatus {
+	return buildapi.ImageStatus{
+		Status: corev1alpha1.Status{
+			Conditions: noScheduledBuild(buildNeeded, builder, latestBuild, sourceResolver),
+		},
+		LatestBuildRef:             latestBuild.BuildRef(),
+		LatestBuildReason:          latestBuild.BuildReason(),
+		LatestBuildImageGeneration: latestBuild.ImageGeneration(),
+		LatestImage:                image.LatestForImage(latestBuild),
+		LatestRunImage:             image.LatestRunImageForImage(latestBuild),
+		LatestStack:                latestBuild.Stack(),
+		BuildCounter:               currentBuildNumber,
+		BuildNumber:                currentBuildNumber,
+		BuildCacheName:             buildCacheName,
+	}
+}
+
+// buildInputsUnchanged reports whether the last build succeeded with the same
+// inputs as the desired build, in which case a new build would produce the
+// same image. Explicitly triggered builds are always scheduled.
+func buildInputsUnchanged(lastBuild *buildapi.Build, build *buildapi.Build) bool {
+	if !lastBuild.IsSuccess() {
+		return false
+	}
+
+	if _, triggered := lastBuild.Annotations[buildapi.BuildNeededAnnotation]; triggered {
+		return false
+	}
+
+	return lastBuild.InputHash() == build.Annotations[buildapi.BuildInputHashAnnotation]
+}
+
 func noScheduledBuild(buildNeeded corev1.ConditionStatus, builder buildapi.BuilderResource, build *buildapi.Build, sourceResolver *buildapi.SourceResolver) corev1alpha1.Conditions {
 	if buildNeeded == corev1.ConditionUnknown {
 		message := ""
@@ -156,13 +184,28 @@ func scheduledBuildCondition(build *buildapi.Build) corev1alpha1.Conditions {
 	}
 }
 
-func buildCounter(build *buildapi.Build) (int64, error) {
-	if build == nil {
-		return 0, nil
+// buildNumber is the number of the latest build of the image. The image
+// status is authoritative so numbers are never reused after builds are
+// deleted, the latest build covers a status update lost after its creation.
+func buildNumber(image *buildapi.Image, latestBuild *buildapi.Build) (int64, error) {
+	current := image.Status.BuildNumber
+	if image.Status.BuildCounter > current {
+		current = image.Status.BuildCounter
 	}
 
-	buildNumber := build.Labels[buildapi.BuildNumberLabel]
-	return strconv.ParseInt(buildNumber, 10, 64)
+	if latestBuild == nil {
+		return current, nil
+	}
+
+	latestBuildNumber, err := strconv.ParseInt(latestBuild.Labels[buildapi.BuildNumberLabel], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if latestBuildNumber > current {
+		current = latestBuildNumber
+	}
+	return current, nil
 }
 
 func buildRunningCondition(build *buildapi.Build, builder buildapi.BuilderResource) corev1alpha1.Conditions {
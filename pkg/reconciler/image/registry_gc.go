@@ -0,0 +1,107 @@
+package image
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+//go:generate counterfeiter . RegistryDeleter
+type RegistryDeleter interface {
+	Delete(keychain authn.Keychain, image string) error
+}
+
+func (c *Reconciler) reconcileRegistryGC(ctx context.Context, image *buildapi.Image, previous *buildapi.ImageRegistryGCStatus) (*buildapi.ImageRegistryGCStatus, error) {
+	policy := image.Spec.RegistryGC
+	if policy == nil {
+		return nil, nil
+	}
+
+	builds, err := c.fetchAllBuilds(image)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, retainedDigests := builds.registryGCCandidates(policy, image.Status.LatestImage, time.Now())
+
+	status := &buildapi.ImageRegistryGCStatus{}
+	if !policy.DryRun && previous != nil {
+		status.PrunedImages = previous.PrunedImages
+	}
+
+	if len(candidates) == 0 {
+		return status, nil
+	}
+
+	if policy.DryRun {
+		for _, build := range candidates {
+			status.PrunableImages = append(status.PrunableImages, prunableImages(build, retainedDigests)...)
+		}
+		return status, nil
+	}
+
+	keychain, err := c.KeychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{
+		ServiceAccount: image.Spec.ServiceAccountName,
+		Namespace:      image.Namespace,
+	})
+	if err != nil {
+		status.Message = err.Error()
+		return status, nil
+	}
+
+	status.PrunedImages = nil
+	for _, build := range candidates {
+		for _, pruneImage := range prunableImages(build, retainedDigests) {
+			if err := c.RegistryDeleter.Delete(keychain, pruneImage); err != nil {
+				status.Message = err.Error()
+				return status, nil
+			}
+			status.PrunedImages = append(status.PrunedImages, pruneImage)
+		}
+
+		build = build.DeepCopy()
+		if build.Annotations == nil {
+			build.Annotations = map[string]string{}
+		}
+		build.Annotations[buildapi.RegistryPrunedAnnotation] = "true"
+		_, err := c.Client.KpackV1alpha2().Builds(build.Namespace).Update(ctx, build, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot mark build as pruned")
+		}
+	}
+
+	return status, nil
+}
+
+// prunableImages returns the digest references pushed by a build, skipping
+// any digest that is still referenced by a retained build.
+func prunableImages(build *buildapi.Build, retainedDigests map[string]struct{}) []string {
+	images := build.Status.PushedImages
+	if len(images) == 0 && build.Status.LatestImage != "" {
+		images = []string{build.Status.LatestImage}
+	}
+
+	var prunable []string
+	for _, image := range images {
+		if _, ok := retainedDigests[imageDigest(image)]; ok {
+			continue
+		}
+		prunable = append(prunable, image)
+	}
+	return prunable
+}
+
+func imageDigest(image string) string {
+	parts := strings.SplitN(image, "@", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[1]
+}
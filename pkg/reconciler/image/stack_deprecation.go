@@ -0,0 +1,82 @@
+package image
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"knative.dev/pkg/metrics"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/duckbuilder"
+)
+
+var (
+	deprecatedStackM = stats.Int64(
+		"image_deprecated_stack",
+		"Whether the image is built with a deprecated stack",
+		stats.UnitDimensionless)
+	stackEndOfLifeDaysM = stats.Int64(
+		"image_stack_end_of_life_days",
+		"Days until the end of life of the deprecated stack of the image",
+		stats.UnitDimensionless)
+
+	namespaceKey = tag.MustNewKey("namespace")
+	imageKey     = tag.MustNewKey("image")
+	stackKey     = tag.MustNewKey("stack")
+)
+
+func init() {
+	tagKeys := []tag.Key{namespaceKey, imageKey, stackKey}
+
+	var views []*view.View
+	for _, m := range []*stats.Int64Measure{deprecatedStackM, stackEndOfLifeDaysM} {
+		views = append(views, &view.View{
+			Description: m.Description(),
+			Measure:     m,
+			Aggregation: view.LastValue(),
+			TagKeys:     tagKeys,
+		})
+	}
+
+	if err := view.Register(views...); err != nil {
+		panic(err)
+	}
+}
+
+func stackDeprecatedConditions(builder *duckbuilder.DuckBuilder) corev1alpha1.Conditions {
+	deprecation := builder.StackDeprecation()
+	if deprecation == nil {
+		return nil
+	}
+
+	return corev1alpha1.Conditions{buildapi.StackDeprecatedCondition(builder.StackId(), deprecation, time.Now())}
+}
+
+func recordStackDeprecation(ctx context.Context, image *buildapi.Image, builder *duckbuilder.DuckBuilder) {
+	ctx, err := tag.New(ctx,
+		tag.Insert(namespaceKey, image.Namespace),
+		tag.Insert(imageKey, image.Name),
+		tag.Insert(stackKey, builder.StackId()),
+	)
+	if err != nil {
+		return
+	}
+
+	deprecation := builder.StackDeprecation()
+	if deprecation == nil {
+		metrics.Record(ctx, deprecatedStackM.M(0))
+		return
+	}
+
+	measurements := []stats.Measurement{deprecatedStackM.M(1)}
+	if deprecation.EndOfLife != nil {
+		days := math.Floor(time.Until(deprecation.EndOfLife.Time).Hours() / 24)
+		measurements = append(measurements, stackEndOfLifeDaysM.M(int64(days)))
+	}
+	metrics.RecordBatch(ctx, measurements...)
+}
@@ -0,0 +1,195 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging/logkey"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	buildinformers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha2"
+	buildlisters "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/tracker"
+)
+
+const (
+	ReconcilerName = "Promotions"
+)
+
+//go:generate counterfeiter . ImageCopier
+type ImageCopier interface {
+	Copy(keychain authn.Keychain, src string, dst string) (string, error)
+}
+
+func NewController(
+	ctx context.Context,
+	opt reconciler.Options,
+	keychainFactory registry.KeychainFactory,
+	promotionInformer buildinformers.PromotionInformer,
+	buildInformer buildinformers.BuildInformer,
+	imageCopier ImageCopier,
+) *controller.Impl {
+	c := &Reconciler{
+		Client:          opt.Client,
+		PromotionLister: promotionInformer.Lister(),
+		BuildLister:     buildInformer.Lister(),
+		ImageCopier:     imageCopier,
+		KeychainFactory: keychainFactory,
+	}
+
+	logger := opt.Logger.With(
+		zap.String(logkey.Kind, buildapi.PromotionCRName),
+	)
+
+	impl := controller.NewContext(
+		ctx,
+		&reconciler.NetworkErrorReconciler{
+			Reconciler: c,
+		},
+		controller.ControllerOptions{WorkQueueName: ReconcilerName, Logger: logger},
+	)
+	promotionInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+
+	c.Tracker = tracker.New(impl.EnqueueKey, opt.TrackerResyncPeriod())
+	buildInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(
+			c.Tracker.OnChanged,
+			buildapi.SchemeGroupVersion.WithKind(buildapi.BuildKind)),
+	))
+
+	return impl
+}
+
+type Reconciler struct {
+	Client          versioned.Interface
+	PromotionLister buildlisters.PromotionLister
+	BuildLister     buildlisters.BuildLister
+	ImageCopier     ImageCopier
+	KeychainFactory registry.KeychainFactory
+	Tracker         reconciler.Tracker
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, promotionName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	promotion, err := c.PromotionLister.Promotions(namespace).Get(promotionName)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	promotion = promotion.DeepCopy()
+
+	promotion, err = c.reconcilePromotion(ctx, promotion)
+
+	updateErr := c.updateStatus(ctx, promotion)
+	if updateErr != nil {
+		return updateErr
+	}
+
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Reconciler) reconcilePromotion(ctx context.Context, promotion *buildapi.Promotion) (*buildapi.Promotion, error) {
+	c.Tracker.Track(reconciler.Key{
+		NamespacedName: types.NamespacedName{
+			Name:      promotion.Spec.Build.Name,
+			Namespace: promotion.Namespace,
+		},
+		GroupKind: schema.GroupKind{
+			Group: "kpack.io",
+			Kind:  buildapi.BuildKind,
+		},
+	}, promotion.NamespacedName())
+
+	build, err := c.BuildLister.Builds(promotion.Namespace).Get(promotion.Spec.Build.Name)
+	if k8serrors.IsNotFound(err) {
+		promotion.Status = buildapi.PromotionStatus{
+			Status: corev1alpha1.CreateStatusWithReadyCondition(promotion.Generation, errors.Errorf("build %s not found", promotion.Spec.Build.Name)),
+		}
+		return promotion, nil
+	} else if err != nil {
+		return promotion, err
+	}
+
+	if build.IsFailure() {
+		promotion.Status = buildapi.PromotionStatus{
+			Status: corev1alpha1.CreateStatusWithReadyCondition(promotion.Generation, errors.Errorf("build %s failed", build.Name)),
+		}
+		return promotion, nil
+	}
+
+	if !build.IsSuccess() {
+		promotion.Status = buildapi.PromotionStatus{}
+		promotion.Status.Waiting(promotion.Generation, fmt.Sprintf("waiting for build %s to succeed", build.Name))
+		return promotion, nil
+	}
+
+	sourceImage := build.BuiltImage()
+	if promotion.Promoted(sourceImage) {
+		return promotion, nil
+	}
+
+	keychain, err := c.KeychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{
+		ServiceAccount: promotion.Spec.ServiceAccountName,
+		Namespace:      promotion.Namespace,
+	})
+	if err != nil {
+		promotion.Status = buildapi.PromotionStatus{
+			Status: corev1alpha1.CreateStatusWithReadyCondition(promotion.Generation, err),
+		}
+		return promotion, err
+	}
+
+	latestImage, err := c.ImageCopier.Copy(keychain, sourceImage, promotion.Spec.Tag)
+	if err != nil {
+		promotion.Status = buildapi.PromotionStatus{
+			Status: corev1alpha1.CreateStatusWithReadyCondition(promotion.Generation, err),
+		}
+		return promotion, err
+	}
+
+	promotion.Status = buildapi.PromotionStatus{
+		Status:      corev1alpha1.CreateStatusWithReadyCondition(promotion.Generation, nil),
+		SourceImage: sourceImage,
+		LatestImage: latestImage,
+	}
+	return promotion, nil
+}
+
+func (c *Reconciler) updateStatus(ctx context.Context, desired *buildapi.Promotion) error {
+	desired.Status.ObservedGeneration = desired.Generation
+
+	original, err := c.PromotionLister.Promotions(desired.Namespace).Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.KpackV1alpha2().Promotions(desired.Namespace).UpdateStatus(ctx, desired, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,387 @@
+package promotion_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	kreconciler "github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/reconciler/promotion"
+	"github.com/pivotal/kpack/pkg/reconciler/promotion/promotionfakes"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestPromotionReconciler(t *testing.T) {
+	spec.Run(t, "Promotion Reconciler", testPromotionReconciler)
+}
+
+func testPromotionReconciler(t *testing.T, when spec.G, it spec.S) {
+	const (
+		testNamespace           = "some-namespace"
+		promotionName           = "some-promotion"
+		promotionKey            = testNamespace + "/" + promotionName
+		builtImage              = "some-registry.io/staging/app@sha256:78c1b9419976227e05be9d243b7fa583bea44a5258e52018b2af4cdfe23d148d"
+		promotedImage           = "other-registry.io/production/app@sha256:78c1b9419976227e05be9d243b7fa583bea44a5258e52018b2af4cdfe23d148d"
+		initialGeneration int64 = 1
+	)
+
+	var (
+		fakeKeychainFactory = &registryfakes.FakeKeychainFactory{}
+		fakeImageCopier     = &promotionfakes.FakeImageCopier{}
+		fakeTracker         = &testhelpers.FakeTracker{}
+		keychain            = &registryfakes.FakeKeychain{Name: "promotion"}
+	)
+
+	it.Before(func() {
+		fakeKeychainFactory = &registryfakes.FakeKeychainFactory{}
+		fakeKeychainFactory.AddKeychainForSecretRef(t, registry.SecretRef{
+			ServiceAccount: "some-sa",
+			Namespace:      testNamespace,
+		}, keychain)
+	})
+
+	testPromotion := &buildapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       promotionName,
+			Namespace:  testNamespace,
+			Generation: initialGeneration,
+		},
+		Spec: buildapi.PromotionSpec{
+			Build:              corev1.LocalObjectReference{Name: "some-build"},
+			Tag:                "other-registry.io/production/app:prod",
+			ServiceAccountName: "some-sa",
+		},
+	}
+
+	build := &buildapi.Build{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Build",
+			APIVersion: "kpack.io/v1alpha2",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-build",
+			Namespace: testNamespace,
+		},
+		Spec: buildapi.BuildSpec{
+			Tags: []string{"some-registry.io/staging/app"},
+		},
+		Status: buildapi.BuildStatus{
+			Status: corev1alpha1.Status{
+				Conditions: corev1alpha1.Conditions{
+					{
+						Type:   corev1alpha1.ConditionSucceeded,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+			LatestImage: builtImage,
+		},
+	}
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
+			listers := testhelpers.NewListers(row.Objects)
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+			r := &promotion.Reconciler{
+				Client:          fakeClient,
+				PromotionLister: listers.GetPromotionLister(),
+				BuildLister:     listers.GetBuildLister(),
+				ImageCopier:     fakeImageCopier,
+				KeychainFactory: fakeKeychainFactory,
+				Tracker:         fakeTracker,
+			}
+			return &kreconciler.NetworkErrorReconciler{Reconciler: r}, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+		})
+
+	promotionWithStatus := func(status buildapi.PromotionStatus) *buildapi.Promotion {
+		p := testPromotion.DeepCopy()
+		p.Status = status
+		return p
+	}
+
+	when("#Reconcile", func() {
+		it("copies the built image to the promotion tag", func() {
+			fakeImageCopier.CopyReturns(promotedImage, nil)
+
+			rt.Test(rtesting.TableRow{
+				Key: promotionKey,
+				Objects: []runtime.Object{
+					testPromotion,
+					build,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: promotionWithStatus(buildapi.PromotionStatus{
+							Status: corev1alpha1.Status{
+								ObservedGeneration: initialGeneration,
+								Conditions: corev1alpha1.Conditions{
+									{
+										Type:   corev1alpha1.ConditionReady,
+										Status: corev1.ConditionTrue,
+									},
+								},
+							},
+							SourceImage: builtImage,
+							LatestImage: promotedImage,
+						}),
+					},
+				},
+			})
+
+			require.Equal(t, 1, fakeImageCopier.CopyCallCount())
+			actualKeychain, src, dst := fakeImageCopier.CopyArgsForCall(0)
+			assert.Equal(t, keychain, actualKeychain)
+			assert.Equal(t, builtImage, src)
+			assert.Equal(t, "other-registry.io/production/app:prod", dst)
+		})
+
+		it("tracks the referenced build", func() {
+			fakeImageCopier.CopyReturns(promotedImage, nil)
+
+			rt.Test(rtesting.TableRow{
+				Key: promotionKey,
+				Objects: []runtime.Object{
+					testPromotion,
+					build,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: promotionWithStatus(buildapi.PromotionStatus{
+							Status: corev1alpha1.Status{
+								ObservedGeneration: initialGeneration,
+								Conditions: corev1alpha1.Conditions{
+									{
+										Type:   corev1alpha1.ConditionReady,
+										Status: corev1.ConditionTrue,
+									},
+								},
+							},
+							SourceImage: builtImage,
+							LatestImage: promotedImage,
+						}),
+					},
+				},
+			})
+
+			assert.True(t, fakeTracker.IsTracking(kreconciler.KeyForObject(build), testPromotion.NamespacedName()))
+		})
+
+		it("does not copy again when the build has already been promoted", func() {
+			promoted := promotionWithStatus(buildapi.PromotionStatus{
+				Status: corev1alpha1.Status{
+					ObservedGeneration: initialGeneration,
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+				SourceImage: builtImage,
+				LatestImage: promotedImage,
+			})
+
+			rt.Test(rtesting.TableRow{
+				Key: promotionKey,
+				Objects: []runtime.Object{
+					promoted,
+					build,
+				},
+				WantErr: false,
+			})
+
+			assert.Equal(t, 0, fakeImageCopier.CopyCallCount())
+		})
+
+		it("copies again when the promotion spec has changed", func() {
+			fakeImageCopier.CopyReturns(promotedImage, nil)
+
+			promoted := promotionWithStatus(buildapi.PromotionStatus{
+				Status: corev1alpha1.Status{
+					ObservedGeneration: initialGeneration,
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+				SourceImage: builtImage,
+				LatestImage: promotedImage,
+			})
+			promoted.Generation = 2
+
+			expected := promoted.DeepCopy()
+			expected.Status.ObservedGeneration = 2
+
+			rt.Test(rtesting.TableRow{
+				Key: promotionKey,
+				Objects: []runtime.Object{
+					promoted,
+					build,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: expected,
+					},
+				},
+			})
+
+			assert.Equal(t, 1, fakeImageCopier.CopyCallCount())
+		})
+
+		it("waits for a running build", func() {
+			runningBuild := build.DeepCopy()
+			runningBuild.Status = buildapi.BuildStatus{
+				Status: corev1alpha1.Status{
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionUnknown,
+						},
+					},
+				},
+			}
+
+			rt.Test(rtesting.TableRow{
+				Key: promotionKey,
+				Objects: []runtime.Object{
+					testPromotion,
+					runningBuild,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: promotionWithStatus(buildapi.PromotionStatus{
+							Status: corev1alpha1.Status{
+								ObservedGeneration: initialGeneration,
+								Conditions: corev1alpha1.Conditions{
+									{
+										Type:    corev1alpha1.ConditionReady,
+										Status:  corev1.ConditionUnknown,
+										Reason:  buildapi.PromotionReasonBuildNotSucceeded,
+										Message: "waiting for build some-build to succeed",
+									},
+								},
+							},
+						}),
+					},
+				},
+			})
+
+			assert.Equal(t, 0, fakeImageCopier.CopyCallCount())
+		})
+
+		it("sets Ready False when the build failed", func() {
+			failedBuild := build.DeepCopy()
+			failedBuild.Status = buildapi.BuildStatus{
+				Status: corev1alpha1.Status{
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionFalse,
+						},
+					},
+				},
+			}
+
+			rt.Test(rtesting.TableRow{
+				Key: promotionKey,
+				Objects: []runtime.Object{
+					testPromotion,
+					failedBuild,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: promotionWithStatus(buildapi.PromotionStatus{
+							Status: corev1alpha1.Status{
+								ObservedGeneration: initialGeneration,
+								Conditions: corev1alpha1.Conditions{
+									{
+										Type:    corev1alpha1.ConditionReady,
+										Status:  corev1.ConditionFalse,
+										Message: "build some-build failed",
+									},
+								},
+							},
+						}),
+					},
+				},
+			})
+		})
+
+		it("sets Ready False when the build does not exist", func() {
+			rt.Test(rtesting.TableRow{
+				Key: promotionKey,
+				Objects: []runtime.Object{
+					testPromotion,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: promotionWithStatus(buildapi.PromotionStatus{
+							Status: corev1alpha1.Status{
+								ObservedGeneration: initialGeneration,
+								Conditions: corev1alpha1.Conditions{
+									{
+										Type:    corev1alpha1.ConditionReady,
+										Status:  corev1.ConditionFalse,
+										Message: "build some-build not found",
+									},
+								},
+							},
+						}),
+					},
+				},
+			})
+		})
+
+		it("sets Ready False and returns the error when the copy fails", func() {
+			fakeImageCopier.CopyReturns("", errors.New("unauthorized"))
+
+			rt.Test(rtesting.TableRow{
+				Key: promotionKey,
+				Objects: []runtime.Object{
+					testPromotion,
+					build,
+				},
+				WantErr: true,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: promotionWithStatus(buildapi.PromotionStatus{
+							Status: corev1alpha1.Status{
+								ObservedGeneration: initialGeneration,
+								Conditions: corev1alpha1.Conditions{
+									{
+										Type:    corev1alpha1.ConditionReady,
+										Status:  corev1.ConditionFalse,
+										Message: "unauthorized",
+									},
+								},
+							},
+						}),
+					},
+				},
+			})
+		})
+	})
+}
@@ -0,0 +1,142 @@
+package stack
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging/logkey"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	buildinformers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha2"
+	buildlisters "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const (
+	ReconcilerName = "NamespacedStacks"
+	Kind           = "Stack"
+)
+
+//go:generate counterfeiter . StackReader
+type StackReader interface {
+	Read(keychain authn.Keychain, clusterStackSpec buildapi.ClusterStackSpec) (buildapi.ResolvedClusterStack, error)
+}
+
+func NewController(
+	ctx context.Context,
+	opt reconciler.Options,
+	keychainFactory registry.KeychainFactory,
+	stackInformer buildinformers.StackInformer,
+	stackReader StackReader) *controller.Impl {
+	c := &Reconciler{
+		Client:          opt.Client,
+		StackLister:     stackInformer.Lister(),
+		StackReader:     stackReader,
+		KeychainFactory: keychainFactory,
+	}
+
+	logger := opt.Logger.With(
+		zap.String(logkey.Kind, buildapi.StackCRName),
+	)
+
+	impl := controller.NewContext(
+		ctx,
+		&reconciler.NetworkErrorReconciler{
+			Reconciler: c,
+		},
+		controller.ControllerOptions{WorkQueueName: ReconcilerName, Logger: logger},
+	)
+	stackInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	return impl
+}
+
+type Reconciler struct {
+	Client          versioned.Interface
+	StackLister     buildlisters.StackLister
+	StackReader     StackReader
+	KeychainFactory registry.KeychainFactory
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, stackName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	stack, err := c.StackLister.Stacks(namespace).Get(stackName)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	stack = stack.DeepCopy()
+
+	stack, err = c.reconcileStackStatus(ctx, stack)
+
+	updateErr := c.updateStackStatus(ctx, stack)
+	if updateErr != nil {
+		return updateErr
+	}
+
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Reconciler) reconcileStackStatus(ctx context.Context, stack *buildapi.Stack) (*buildapi.Stack, error) {
+	keychain, err := c.KeychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{
+		ServiceAccount: stack.Spec.ServiceAccountName,
+		Namespace:      stack.Namespace,
+	})
+	if err != nil {
+		stack.Status = buildapi.StackStatus{
+			Status: corev1alpha1.CreateStatusWithReadyCondition(stack.Generation, err),
+		}
+		return stack, err
+	}
+
+	resolvedStack, err := c.StackReader.Read(keychain, buildapi.ClusterStackSpec{
+		Id:         stack.Spec.Id,
+		BuildImage: stack.Spec.BuildImage,
+		RunImage:   stack.Spec.RunImage,
+	})
+	if err != nil {
+		stack.Status = buildapi.StackStatus{
+			Status: corev1alpha1.CreateStatusWithReadyCondition(stack.Generation, err),
+		}
+		return stack, err
+	}
+
+	stack.Status = buildapi.StackStatus{
+		Status:               corev1alpha1.CreateStatusWithReadyCondition(stack.Generation, nil),
+		ResolvedClusterStack: resolvedStack,
+	}
+	return stack, nil
+}
+
+func (c *Reconciler) updateStackStatus(ctx context.Context, desired *buildapi.Stack) error {
+	desired.Status.ObservedGeneration = desired.Generation
+
+	original, err := c.StackLister.Stacks(desired.Namespace).Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.KpackV1alpha2().Stacks(desired.Namespace).UpdateStatus(ctx, desired, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,197 @@
+package stack_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	kreconciler "github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/reconciler/stack"
+	"github.com/pivotal/kpack/pkg/reconciler/stack/stackfakes"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestStackReconciler(t *testing.T) {
+	spec.Run(t, "Stack Reconciler", testStackReconciler)
+}
+
+func testStackReconciler(t *testing.T, when spec.G, it spec.S) {
+	const (
+		testNamespace            = "some-namespace"
+		stackName                = "some-stack"
+		stackKey                 = testNamespace + "/" + stackName
+		serviceAccountName       = "some-service-account"
+		initialGeneration  int64 = 1
+	)
+
+	var (
+		fakeKeyChainFactory = &registryfakes.FakeKeychainFactory{}
+		fakeStackReader     = &stackfakes.FakeStackReader{}
+		expectedKeychain    = &registryfakes.FakeKeychain{Name: "service-account"}
+	)
+
+	testStack := &buildapi.Stack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       stackName,
+			Namespace:  testNamespace,
+			Generation: initialGeneration,
+		},
+		Spec: buildapi.StackSpec{
+			Id: "some.stack.id",
+			BuildImage: buildapi.ClusterStackSpecImage{
+				Image: "some-registry.io/build-image",
+			},
+			RunImage: buildapi.ClusterStackSpecImage{
+				Image: "some-registry.io/run-image",
+			},
+			ServiceAccountName: serviceAccountName,
+		},
+	}
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
+			listers := testhelpers.NewListers(row.Objects)
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+			r := &stack.Reconciler{
+				Client:          fakeClient,
+				StackLister:     listers.GetStackLister(),
+				StackReader:     fakeStackReader,
+				KeychainFactory: fakeKeyChainFactory,
+			}
+			return &kreconciler.NetworkErrorReconciler{Reconciler: r}, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+		})
+
+	it.Before(func() {
+		fakeKeyChainFactory.AddKeychainForSecretRef(t, registry.SecretRef{
+			ServiceAccount: serviceAccountName,
+			Namespace:      testNamespace,
+		}, expectedKeychain)
+	})
+
+	when("#Reconcile", func() {
+		resolvedStack := buildapi.ResolvedClusterStack{
+			Id: "some.stack.id",
+			BuildImage: buildapi.ClusterStackStatusImage{
+				LatestImage: "some-registry.io/build-image@sha245:123",
+			},
+			RunImage: buildapi.ClusterStackStatusImage{
+				LatestImage: "some-registry.io/run-image@sha245:123",
+			},
+			Mixins:  []string{"a-nice-mixin"},
+			UserID:  1000,
+			GroupID: 2000,
+		}
+
+		it("saves metadata to the status using the keychain of the stack's service account", func() {
+			fakeStackReader.ReadReturns(resolvedStack, nil)
+
+			rt.Test(rtesting.TableRow{
+				Key: stackKey,
+				Objects: []runtime.Object{
+					testStack,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &buildapi.Stack{
+							ObjectMeta: testStack.ObjectMeta,
+							Spec:       testStack.Spec,
+							Status: buildapi.StackStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: 1,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionReady,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								ResolvedClusterStack: resolvedStack,
+							},
+						},
+					},
+				},
+			})
+
+			require.Equal(t, 1, fakeStackReader.ReadCallCount())
+			keychain, stackSpec := fakeStackReader.ReadArgsForCall(0)
+			assert.Equal(t, expectedKeychain, keychain)
+			assert.Equal(t, buildapi.ClusterStackSpec{
+				Id:         testStack.Spec.Id,
+				BuildImage: testStack.Spec.BuildImage,
+				RunImage:   testStack.Spec.RunImage,
+			}, stackSpec)
+		})
+
+		it("does not update the status with no status change", func() {
+			fakeStackReader.ReadReturns(resolvedStack, nil)
+
+			testStack.Status = buildapi.StackStatus{
+				Status: corev1alpha1.Status{
+					ObservedGeneration: 1,
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+				ResolvedClusterStack: resolvedStack,
+			}
+			rt.Test(rtesting.TableRow{
+				Key: stackKey,
+				Objects: []runtime.Object{
+					testStack,
+				},
+				WantErr: false,
+			})
+		})
+
+		it("sets the status to Ready False if error reading the stack", func() {
+			fakeStackReader.ReadReturns(buildapi.ResolvedClusterStack{}, errors.New("invalid mixins on run image"))
+
+			rt.Test(rtesting.TableRow{
+				Key: stackKey,
+				Objects: []runtime.Object{
+					testStack,
+				},
+				WantErr: true,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &buildapi.Stack{
+							ObjectMeta: testStack.ObjectMeta,
+							Spec:       testStack.Spec,
+							Status: buildapi.StackStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: 1,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Message: "invalid mixins on run image",
+											Type:    corev1alpha1.ConditionReady,
+											Status:  corev1.ConditionFalse,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		})
+	})
+}
@@ -0,0 +1,138 @@
+package store
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging/logkey"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	buildinformers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha2"
+	buildlisters "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const (
+	ReconcilerName = "Stores"
+	Kind           = "Store"
+)
+
+//go:generate counterfeiter . StoreReader
+type StoreReader interface {
+	Read(keychain authn.Keychain, storeImages []corev1alpha1.ImageSource) ([]corev1alpha1.BuildpackStatus, error)
+}
+
+func NewController(
+	ctx context.Context,
+	opt reconciler.Options,
+	keychainFactory registry.KeychainFactory,
+	storeInformer buildinformers.StoreInformer,
+	storeReader StoreReader) *controller.Impl {
+	c := &Reconciler{
+		Client:          opt.Client,
+		StoreLister:     storeInformer.Lister(),
+		StoreReader:     storeReader,
+		KeychainFactory: keychainFactory,
+	}
+
+	logger := opt.Logger.With(
+		zap.String(logkey.Kind, buildapi.StoreCRName),
+	)
+
+	impl := controller.NewContext(
+		ctx,
+		&reconciler.NetworkErrorReconciler{
+			Reconciler: c,
+		},
+		controller.ControllerOptions{WorkQueueName: ReconcilerName, Logger: logger},
+	)
+	storeInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	return impl
+}
+
+type Reconciler struct {
+	Client          versioned.Interface
+	StoreReader     StoreReader
+	StoreLister     buildlisters.StoreLister
+	KeychainFactory registry.KeychainFactory
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, storeName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	store, err := c.StoreLister.Stores(namespace).Get(storeName)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	store = store.DeepCopy()
+
+	store, err = c.reconcileStoreStatus(ctx, store)
+
+	updateErr := c.updateStoreStatus(ctx, store)
+	if updateErr != nil {
+		return updateErr
+	}
+
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Reconciler) updateStoreStatus(ctx context.Context, desired *buildapi.Store) error {
+	desired.Status.ObservedGeneration = desired.Generation
+
+	original, err := c.StoreLister.Stores(desired.Namespace).Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.KpackV1alpha2().Stores(desired.Namespace).UpdateStatus(ctx, desired, metav1.UpdateOptions{})
+	return err
+}
+
+func (c *Reconciler) reconcileStoreStatus(ctx context.Context, store *buildapi.Store) (*buildapi.Store, error) {
+	keychain, err := c.KeychainFactory.KeychainForSecretRef(ctx, registry.SecretRef{
+		ServiceAccount: store.Spec.ServiceAccountName,
+		Namespace:      store.Namespace,
+	})
+	if err != nil {
+		store.Status = buildapi.StoreStatus{
+			Status: corev1alpha1.CreateStatusWithReadyCondition(store.Generation, err),
+		}
+		return store, err
+	}
+
+	buildpacks, err := c.StoreReader.Read(keychain, store.Spec.Sources)
+	if err != nil {
+		store.Status = buildapi.StoreStatus{
+			Status: corev1alpha1.CreateStatusWithReadyCondition(store.Generation, err),
+		}
+		return store, err
+	}
+
+	store.Status = buildapi.StoreStatus{
+		Buildpacks: buildpacks,
+		Status:     corev1alpha1.CreateStatusWithReadyCondition(store.Generation, nil),
+	}
+	return store, nil
+}
@@ -0,0 +1,204 @@
+package store_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	buildapi "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	kreconciler "github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/reconciler/store"
+	"github.com/pivotal/kpack/pkg/reconciler/store/storefakes"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestStoreReconciler(t *testing.T) {
+	spec.Run(t, "Store Reconciler", testStoreReconciler)
+}
+
+func testStoreReconciler(t *testing.T, when spec.G, it spec.S) {
+	const (
+		testNamespace            = "some-namespace"
+		storeName                = "some-store"
+		storeKey                 = testNamespace + "/" + storeName
+		serviceAccountName       = "some-service-account"
+		initialGeneration  int64 = 1
+	)
+	var (
+		fakeStoreReader     = &storefakes.FakeStoreReader{}
+		fakeKeyChainFactory = &registryfakes.FakeKeychainFactory{}
+		expectedKeychain    = &registryfakes.FakeKeychain{Name: "service-account"}
+	)
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(_ *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
+			listers := testhelpers.NewListers(row.Objects)
+
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+
+			r := &store.Reconciler{
+				Client:          fakeClient,
+				StoreReader:     fakeStoreReader,
+				StoreLister:     listers.GetStoreLister(),
+				KeychainFactory: fakeKeyChainFactory,
+			}
+			return &kreconciler.NetworkErrorReconciler{Reconciler: r}, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+		})
+
+	testStore := &buildapi.Store{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       storeName,
+			Namespace:  testNamespace,
+			Generation: initialGeneration,
+		},
+		Spec: buildapi.StoreSpec{
+			Sources: []corev1alpha1.ImageSource{
+				{
+					Image: "some.registry/some-image-1",
+				},
+				{
+					Image: "some.registry/some-image-2",
+				},
+			},
+			ServiceAccountName: serviceAccountName,
+		},
+	}
+
+	it.Before(func() {
+		fakeKeyChainFactory.AddKeychainForSecretRef(t, registry.SecretRef{
+			ServiceAccount: serviceAccountName,
+			Namespace:      testNamespace,
+		}, expectedKeychain)
+	})
+
+	when("#Reconcile", func() {
+		readBuildpacks := []corev1alpha1.BuildpackStatus{
+			{
+				BuildpackInfo: corev1alpha1.BuildpackInfo{
+					Id:      "paketo-buildpacks/node-engine",
+					Version: "0.0.116",
+				},
+				DiffId: "sha256:d57937f5ccb6f524afa02dd95224e1914c94a02483d37b07aa668e560dcb3bf4",
+				StoreImage: corev1alpha1.ImageSource{
+					Image: "some.registry/some-image-1",
+				},
+			},
+			{
+				BuildpackInfo: corev1alpha1.BuildpackInfo{
+					Id:      "paketo-buildpacks/npm",
+					Version: "0.0.71",
+				},
+				DiffId: "sha256:c67840e5ccb6f524afa02dd95224e1914c94a02483d37b07aa668e560dcb3bf5",
+				StoreImage: corev1alpha1.ImageSource{
+					Image: "some.registry/some-image-2",
+				},
+			},
+		}
+
+		it("saves metadata to the status using the keychain of the store's service account", func() {
+			fakeStoreReader.ReadReturns(readBuildpacks, nil)
+
+			rt.Test(rtesting.TableRow{
+				Key: storeKey,
+				Objects: []runtime.Object{
+					testStore,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &buildapi.Store{
+							ObjectMeta: testStore.ObjectMeta,
+							Spec:       testStore.Spec,
+							Status: buildapi.StoreStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: 1,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionReady,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								Buildpacks: readBuildpacks,
+							},
+						},
+					},
+				},
+			})
+
+			assert.Equal(t, 1, fakeStoreReader.ReadCallCount())
+			keychain, sources := fakeStoreReader.ReadArgsForCall(0)
+			assert.Equal(t, expectedKeychain, keychain)
+			assert.Equal(t, testStore.Spec.Sources, sources)
+		})
+
+		it("does not update the status with no status change", func() {
+			fakeStoreReader.ReadReturns(readBuildpacks, nil)
+
+			testStore.Status = buildapi.StoreStatus{
+				Status: corev1alpha1.Status{
+					ObservedGeneration: 1,
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+				Buildpacks: readBuildpacks,
+			}
+			rt.Test(rtesting.TableRow{
+				Key: storeKey,
+				Objects: []runtime.Object{
+					testStore,
+				},
+				WantErr: false,
+			})
+		})
+
+		it("sets the status to Ready False if error reading buildpacks", func() {
+			fakeStoreReader.ReadReturns(nil, fmt.Errorf("no buildpacks left"))
+
+			rt.Test(rtesting.TableRow{
+				Key: storeKey,
+				Objects: []runtime.Object{
+					testStore,
+				},
+				WantErr: true,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &buildapi.Store{
+							ObjectMeta: testStore.ObjectMeta,
+							Spec:       testStore.Spec,
+							Status: buildapi.StoreStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: 1,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Message: "no buildpacks left",
+											Type:    corev1alpha1.ConditionReady,
+											Status:  corev1.ConditionFalse,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		})
+	})
+}
@@ -2,9 +2,11 @@ package testhelpers
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	corev1listers "k8s.io/client-go/listers/core/v1"
+	networkingv1listers "k8s.io/client-go/listers/networking/v1"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/reconciler/testing"
 
@@ -59,6 +61,14 @@ func (l *Listers) GetBuildLister() buildlisters.BuildLister {
 	return buildlisters.NewBuildLister(l.indexerFor(&buildapi.Build{}))
 }
 
+func (l *Listers) GetBuildQuotaLister() buildlisters.BuildQuotaLister {
+	return buildlisters.NewBuildQuotaLister(l.indexerFor(&buildapi.BuildQuota{}))
+}
+
+func (l *Listers) GetBuildTemplateLister() buildlisters.BuildTemplateLister {
+	return buildlisters.NewBuildTemplateLister(l.indexerFor(&buildapi.BuildTemplate{}))
+}
+
 func (l *Listers) GetBuilderLister() buildlisters.BuilderLister {
 	return buildlisters.NewBuilderLister(l.indexerFor(&buildapi.Builder{}))
 }
@@ -67,6 +77,10 @@ func (l *Listers) GetBuildpackLister() buildlisters.BuildpackLister {
 	return buildlisters.NewBuildpackLister(l.indexerFor(&buildapi.Buildpack{}))
 }
 
+func (l *Listers) GetClusterBuildTemplateLister() buildlisters.ClusterBuildTemplateLister {
+	return buildlisters.NewClusterBuildTemplateLister(l.indexerFor(&buildapi.ClusterBuildTemplate{}))
+}
+
 func (l *Listers) GetClusterBuilderLister() buildlisters.ClusterBuilderLister {
 	return buildlisters.NewClusterBuilderLister(l.indexerFor(&buildapi.ClusterBuilder{}))
 }
@@ -83,10 +97,26 @@ func (l *Listers) GetClusterStackLister() buildlisters.ClusterStackLister {
 	return buildlisters.NewClusterStackLister(l.indexerFor(&buildapi.ClusterStack{}))
 }
 
+func (l *Listers) GetPromotionLister() buildlisters.PromotionLister {
+	return buildlisters.NewPromotionLister(l.indexerFor(&buildapi.Promotion{}))
+}
+
 func (l *Listers) GetSourceResolverLister() buildlisters.SourceResolverLister {
 	return buildlisters.NewSourceResolverLister(l.indexerFor(&buildapi.SourceResolver{}))
 }
 
+func (l *Listers) GetStackLister() buildlisters.StackLister {
+	return buildlisters.NewStackLister(l.indexerFor(&buildapi.Stack{}))
+}
+
+func (l *Listers) GetStoreLister() buildlisters.StoreLister {
+	return buildlisters.NewStoreLister(l.indexerFor(&buildapi.Store{}))
+}
+
+func (l *Listers) GetBuilderGrantLister() buildlisters.BuilderGrantLister {
+	return buildlisters.NewBuilderGrantLister(l.indexerFor(&buildapi.BuilderGrant{}))
+}
+
 func (l *Listers) GetPersistentVolumeClaimLister() corev1listers.PersistentVolumeClaimLister {
 	return corev1listers.NewPersistentVolumeClaimLister(l.indexerFor(&corev1.PersistentVolumeClaim{}))
 }
@@ -95,6 +125,10 @@ func (l *Listers) GetPodLister() corev1listers.PodLister {
 	return corev1listers.NewPodLister(l.indexerFor(&corev1.Pod{}))
 }
 
+func (l *Listers) GetNetworkPolicyLister() networkingv1listers.NetworkPolicyLister {
+	return networkingv1listers.NewNetworkPolicyLister(l.indexerFor(&networkingv1.NetworkPolicy{}))
+}
+
 func (l *Listers) GetConfigMapLister() corev1listers.ConfigMapLister {
 	return corev1listers.NewConfigMapLister(l.indexerFor(&corev1.ConfigMap{}))
 }
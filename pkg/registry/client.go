@@ -60,6 +60,119 @@ func (t *Client) Save(keychain authn.Keychain, tag string, image v1.Image) (stri
 	return identifier, remote.Tag(ref.Context().Tag(timestampTag()), image, remote.WithAuthFromKeychain(keychain))
 }
 
+func (t *Client) Copy(keychain authn.Keychain, src string, dst string) (string, error) {
+	srcRef, err := name.ParseReference(src, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	dstTag, err := name.NewTag(dst, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	desc, err := remote.Get(srcRef, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return "", handleError(err)
+	}
+
+	if desc.MediaType.IsIndex() {
+		index, err := desc.ImageIndex()
+		if err != nil {
+			return "", err
+		}
+
+		err = remote.WriteIndex(dstTag, index, remote.WithAuthFromKeychain(keychain))
+		if err != nil {
+			return "", handleError(err)
+		}
+	} else {
+		image, err := desc.Image()
+		if err != nil {
+			return "", err
+		}
+
+		err = remote.Write(dstTag, image, remote.WithAuthFromKeychain(keychain))
+		if err != nil {
+			return "", handleError(err)
+		}
+	}
+
+	return dstTag.Context().Name() + "@" + desc.Digest.String(), nil
+}
+
+// VerifyDigest checks that image is pinned to a digest and that the registry
+// serves a manifest with that digest.
+func (t *Client) VerifyDigest(keychain authn.Keychain, image string) error {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+
+	digest, ok := ref.(name.Digest)
+	if !ok {
+		return errors.Errorf("image %s is not pinned to a digest", image)
+	}
+
+	desc, err := remote.Get(ref, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return handleError(err)
+	}
+
+	if desc.Digest.String() != digest.DigestStr() {
+		return errors.Errorf("image %s has digest %s", image, desc.Digest)
+	}
+	return nil
+}
+
+// Digest returns the digest of the manifest the registry serves for image.
+func (t *Client) Digest(keychain authn.Keychain, image string) (string, error) {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	desc, err := remote.Head(ref, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return "", handleError(err)
+	}
+	return desc.Digest.String(), nil
+}
+
+// DeleteNotPermittedError is returned when the registry rejects a manifest
+// delete because of missing permissions or because deletes are disabled.
+type DeleteNotPermittedError struct {
+	Err error
+}
+
+func (e *DeleteNotPermittedError) Error() string {
+	return fmt.Sprintf("registry does not permit deleting images: %s", e.Err)
+}
+
+// Delete removes the manifest referenced by image. Deleting a manifest that
+// no longer exists is not an error.
+func (t *Client) Delete(keychain authn.Keychain, image string) error {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+
+	err = remote.Delete(ref, remote.WithAuthFromKeychain(keychain))
+	if err == nil {
+		return nil
+	}
+
+	if transportErr, ok := err.(*transport.Error); ok {
+		switch transportErr.StatusCode {
+		case http.StatusNotFound:
+			return nil
+		case http.StatusUnauthorized, http.StatusForbidden, http.StatusMethodNotAllowed:
+			return &DeleteNotPermittedError{Err: err}
+		}
+	}
+	return handleError(err)
+}
+
 func timestampTag() string {
 	now := time.Now()
 	return fmt.Sprintf("%s%02d%02d%02d", now.Format("20060102"), now.Hour(), now.Minute(), now.Second())
@@ -8,8 +8,11 @@ import (
 	"testing"
 
 	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/pkg/errors"
 	"github.com/sclevine/spec"
 	"github.com/stretchr/testify/assert"
@@ -272,6 +275,209 @@ func testClient(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 	})
+
+	when("Copy", func() {
+		var (
+			srcRegistry = httptest.NewServer(ggcrregistry.New())
+			dstRegistry = httptest.NewServer(ggcrregistry.New())
+			dstTag      = fmt.Sprintf("%s/promoted/image:prod", dstRegistry.URL[7:])
+		)
+
+		it.After(func() {
+			srcRegistry.Close()
+			dstRegistry.Close()
+		})
+
+		it("copies an image to another registry by digest", func() {
+			image := randomImage(t, 2)
+			digest, err := image.Digest()
+			require.NoError(t, err)
+
+			srcTag, err := name.NewTag(fmt.Sprintf("%s/some/image:tag", srcRegistry.URL[7:]))
+			require.NoError(t, err)
+			require.NoError(t, remote.Write(srcTag, image))
+
+			identifier, err := subject.Copy(keychain, srcTag.Context().Digest(digest.String()).Name(), dstTag)
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("%s/promoted/image@%s", dstRegistry.URL[7:], digest), identifier)
+
+			dstRef, err := name.ParseReference(dstTag)
+			require.NoError(t, err)
+			copied, err := remote.Image(dstRef)
+			require.NoError(t, err)
+			copiedDigest, err := copied.Digest()
+			require.NoError(t, err)
+			assert.Equal(t, digest, copiedDigest)
+		})
+
+		it("copies an image index preserving its digest", func() {
+			index, err := random.Index(5, 1, 2)
+			require.NoError(t, err)
+			digest, err := index.Digest()
+			require.NoError(t, err)
+
+			srcTag, err := name.NewTag(fmt.Sprintf("%s/some/index:tag", srcRegistry.URL[7:]))
+			require.NoError(t, err)
+			require.NoError(t, remote.WriteIndex(srcTag, index))
+
+			identifier, err := subject.Copy(keychain, srcTag.String(), dstTag)
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("%s/promoted/image@%s", dstRegistry.URL[7:], digest), identifier)
+
+			dstRef, err := name.ParseReference(dstTag)
+			require.NoError(t, err)
+			copied, err := remote.Index(dstRef)
+			require.NoError(t, err)
+			copiedDigest, err := copied.Digest()
+			require.NoError(t, err)
+			assert.Equal(t, digest, copiedDigest)
+		})
+
+		it("wraps network errors to NetworkError", func() {
+			handler.HandleFunc("/v2/", func(writer http.ResponseWriter, request *http.Request) {
+				writer.WriteHeader(http.StatusNotFound)
+			})
+
+			assertNetworkErrorOn(t, true, func() error {
+				_, err := subject.Copy(keychain, tagName, dstTag)
+				return err
+			})
+		})
+	})
+
+	when("VerifyDigest", func() {
+		var (
+			testRegistry = httptest.NewServer(ggcrregistry.New())
+		)
+
+		it.After(func() {
+			testRegistry.Close()
+		})
+
+		it("verifies an image index by digest", func() {
+			index, err := random.Index(5, 1, 2)
+			require.NoError(t, err)
+			digest, err := index.Digest()
+			require.NoError(t, err)
+
+			tag, err := name.NewTag(fmt.Sprintf("%s/some/index:tag", testRegistry.URL[7:]))
+			require.NoError(t, err)
+			require.NoError(t, remote.WriteIndex(tag, index))
+
+			require.NoError(t, subject.VerifyDigest(keychain, tag.Context().Digest(digest.String()).Name()))
+		})
+
+		it("returns an error for an image that is not pinned to a digest", func() {
+			err := subject.VerifyDigest(keychain, tagName)
+			require.EqualError(t, err, fmt.Sprintf("image %s is not pinned to a digest", tagName))
+		})
+
+		it("returns an error for a digest that is not in the registry", func() {
+			tag, err := name.NewTag(fmt.Sprintf("%s/some/image:tag", testRegistry.URL[7:]))
+			require.NoError(t, err)
+			require.NoError(t, remote.Write(tag, randomImage(t, 1)))
+
+			err = subject.VerifyDigest(keychain, tag.Context().Digest("sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855").Name())
+			require.Error(t, err)
+		})
+	})
+
+	when("Digest", func() {
+		var (
+			testRegistry = httptest.NewServer(ggcrregistry.New())
+		)
+
+		it.After(func() {
+			testRegistry.Close()
+		})
+
+		it("returns the digest the tag points at", func() {
+			image := randomImage(t, 1)
+			digest, err := image.Digest()
+			require.NoError(t, err)
+
+			tag, err := name.NewTag(fmt.Sprintf("%s/some/image:tag", testRegistry.URL[7:]))
+			require.NoError(t, err)
+			require.NoError(t, remote.Write(tag, image))
+
+			tagDigest, err := subject.Digest(keychain, tag.String())
+			require.NoError(t, err)
+			assert.Equal(t, digest.String(), tagDigest)
+		})
+
+		it("wraps network errors to NetworkError", func() {
+			handler.HandleFunc("/v2/", func(writer http.ResponseWriter, request *http.Request) {
+				writer.WriteHeader(http.StatusNotFound)
+			})
+
+			assertNetworkErrorOn(t, true, func() error {
+				_, err := subject.Digest(keychain, tagName)
+				return err
+			})
+		})
+	})
+
+	when("Delete", func() {
+		var (
+			testRegistry = httptest.NewServer(ggcrregistry.New())
+		)
+
+		it.After(func() {
+			testRegistry.Close()
+		})
+
+		it("deletes an image by digest", func() {
+			image := randomImage(t, 1)
+			digest, err := image.Digest()
+			require.NoError(t, err)
+
+			tag, err := name.NewTag(fmt.Sprintf("%s/some/image:tag", testRegistry.URL[7:]))
+			require.NoError(t, err)
+			require.NoError(t, remote.Write(tag, image))
+
+			digestRef := tag.Context().Digest(digest.String())
+			require.NoError(t, subject.Delete(keychain, digestRef.Name()))
+
+			_, err = remote.Image(digestRef)
+			require.Error(t, err)
+		})
+
+		it("ignores images that no longer exist", func() {
+			image := randomImage(t, 1)
+			tag, err := name.NewTag(fmt.Sprintf("%s/some/image:tag", testRegistry.URL[7:]))
+			require.NoError(t, err)
+			require.NoError(t, remote.Write(tag, image))
+
+			err = subject.Delete(keychain, tag.Context().Digest("sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855").Name())
+			require.NoError(t, err)
+		})
+
+		for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusMethodNotAllowed} {
+			status := status
+			it(fmt.Sprintf("returns a DeleteNotPermittedError on %d", status), func() {
+				handler.HandleFunc("/v2/", func(writer http.ResponseWriter, request *http.Request) {
+					if request.Method == http.MethodDelete {
+						writer.WriteHeader(status)
+					}
+				})
+
+				err := subject.Delete(keychain, tagName)
+				require.Error(t, err)
+				var notPermitted *registry.DeleteNotPermittedError
+				require.True(t, errors.As(err, &notPermitted))
+			})
+		}
+
+		it("wraps network errors to NetworkError", func() {
+			handler.HandleFunc("/v2/", func(writer http.ResponseWriter, request *http.Request) {
+				writer.WriteHeader(http.StatusBadGateway)
+			})
+
+			assertNetworkErrorOn(t, true, func() error {
+				return subject.Delete(keychain, tagName)
+			})
+		})
+	})
 }
 
 func randomImage(t *testing.T, layers int64) v1.Image {
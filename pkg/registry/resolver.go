@@ -13,9 +13,10 @@ type Resolver struct {
 func (*Resolver) Resolve(ctx context.Context, sourceResolver *buildapi.SourceResolver) (corev1alpha1.ResolvedSourceConfig, error) {
 	return corev1alpha1.ResolvedSourceConfig{
 		Registry: &corev1alpha1.ResolvedRegistrySource{
-			Image:            sourceResolver.Spec.Source.Registry.Image,
-			ImagePullSecrets: sourceResolver.Spec.Source.Registry.ImagePullSecrets,
-			SubPath:          sourceResolver.Spec.Source.SubPath,
+			Image:               sourceResolver.Spec.Source.Registry.Image,
+			ImagePullSecrets:    sourceResolver.Spec.Source.Registry.ImagePullSecrets,
+			SubPath:             sourceResolver.Spec.Source.SubPath,
+			DereferenceSymlinks: sourceResolver.Spec.Source.DereferenceSymlinks,
 		},
 	}, nil
 }
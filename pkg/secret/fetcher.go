@@ -21,6 +21,22 @@ func (f *Fetcher) SecretsForServiceAccount(ctx context.Context, serviceAccount,
 	return f.secretsFromServiceAccount(ctx, sa, namespace)
 }
 
+// Secrets returns the referenced secrets in a namespace, skipping any that do
+// not exist.
+func (f *Fetcher) Secrets(ctx context.Context, refs []corev1.LocalObjectReference, namespace string) ([]*corev1.Secret, error) {
+	var secrets []*corev1.Secret
+	for _, ref := range refs {
+		secret, err := f.Client.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		} else if k8serrors.IsNotFound(err) {
+			continue
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
 func (f *Fetcher) secretsFromServiceAccount(ctx context.Context, account *corev1.ServiceAccount, namespace string) ([]*corev1.Secret, error) {
 	var secrets []*corev1.Secret
 	for _, secretRef := range account.Secrets {
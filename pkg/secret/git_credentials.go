@@ -0,0 +1,191 @@
+package secret
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// NetrcKey is the secret key holding credentials in .netrc format.
+	NetrcKey = ".netrc"
+	// GitCredentialKey is the secret key holding credentials in the
+	// key=value format written by git credential helpers.
+	GitCredentialKey = "git-credential"
+)
+
+var gitCredentialParsers = []struct {
+	key   string
+	parse func(data, host string) (BasicAuth, bool)
+}{
+	{key: NetrcKey, parse: BasicAuthFromNetrc},
+	{key: GitCredentialKey, parse: BasicAuthFromGitCredential},
+}
+
+// HasGitCredentialFile reports whether secret data holds a .netrc file or git
+// credential helper output.
+func HasGitCredentialFile(data map[string][]byte) bool {
+	for _, p := range gitCredentialParsers {
+		if _, ok := data[p.key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GitBasicAuthFromData returns the credentials for host from the .netrc file
+// or, failing that, the git credential helper output in secret data.
+func GitBasicAuthFromData(data map[string][]byte, host string) (BasicAuth, error) {
+	for _, p := range gitCredentialParsers {
+		contents, ok := data[p.key]
+		if !ok {
+			continue
+		}
+
+		if auth, ok := p.parse(string(contents), host); ok {
+			return auth, nil
+		}
+	}
+	return BasicAuth{}, fmt.Errorf("no credentials for %s", host)
+}
+
+// BasicAuthFromNetrc returns the login and password of the machine in a
+// .netrc file that matches host, falling back to the default entry.
+func BasicAuthFromNetrc(netrc, host string) (BasicAuth, bool) {
+	var machine, fallback, current *BasicAuth
+
+	tokens := netrcTokens(netrc)
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "machine":
+			if machine != nil {
+				return *machine, true
+			}
+			current = nil
+			if i+1 < len(tokens) && hostMatches(tokens[i+1], host) {
+				machine = &BasicAuth{}
+				current = machine
+			}
+			i++
+		case "default":
+			if machine != nil {
+				return *machine, true
+			}
+			fallback = &BasicAuth{}
+			current = fallback
+		case "login", "password", "account":
+			if i+1 >= len(tokens) {
+				break
+			}
+			if current != nil && tokens[i] == "login" {
+				current.Username = tokens[i+1]
+			} else if current != nil && tokens[i] == "password" {
+				current.Password = tokens[i+1]
+			}
+			i++
+		}
+	}
+
+	switch {
+	case machine != nil:
+		return *machine, true
+	case fallback != nil:
+		return *fallback, true
+	default:
+		return BasicAuth{}, false
+	}
+}
+
+// netrcTokens splits a .netrc file into whitespace separated tokens, leaving
+// out macro definitions which run until the next blank line.
+func netrcTokens(netrc string) []string {
+	var tokens []string
+	inMacro := false
+
+	scanner := bufio.NewScanner(strings.NewReader(netrc))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if inMacro {
+			inMacro = strings.TrimSpace(line) != ""
+			continue
+		}
+
+		for _, field := range strings.Fields(line) {
+			if strings.HasPrefix(field, "#") {
+				break
+			}
+			if field == "macdef" {
+				inMacro = true
+				break
+			}
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// BasicAuthFromGitCredential returns the username and password of the
+// credential for host in git credential helper output. Credentials are
+// separated by blank lines, and one without a host or url applies to any host.
+func BasicAuthFromGitCredential(output, host string) (BasicAuth, bool) {
+	var fallback *BasicAuth
+
+	for _, block := range strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n\n") {
+		var (
+			auth      BasicAuth
+			credHost  string
+			hasFields bool
+		)
+
+		for _, line := range strings.Split(block, "\n") {
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				continue
+			}
+			hasFields = true
+
+			switch strings.TrimSpace(key) {
+			case "host":
+				credHost = value
+			case "url":
+				if u, err := url.Parse(value); err == nil {
+					credHost = u.Host
+					if u.User != nil {
+						auth.Username = u.User.Username()
+						auth.Password, _ = u.User.Password()
+					}
+				}
+			case "username":
+				auth.Username = value
+			case "password":
+				auth.Password = value
+			}
+		}
+
+		switch {
+		case !hasFields:
+			continue
+		case credHost == "" && fallback == nil:
+			fallback = &auth
+		case credHost != "" && hostMatches(credHost, host):
+			return auth, true
+		}
+	}
+
+	if fallback != nil {
+		return *fallback, true
+	}
+	return BasicAuth{}, false
+}
+
+func hostMatches(credHost, host string) bool {
+	return credHost == host || stripPort(credHost) == stripPort(host)
+}
+
+func stripPort(host string) string {
+	if i := strings.LastIndex(host, ":"); i != -1 && !strings.Contains(host[i:], "]") {
+		return host[:i]
+	}
+	return host
+}
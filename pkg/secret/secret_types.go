@@ -1,5 +1,9 @@
 package secret
 
+// SSHKnownHostsKey is the key of an optional known_hosts file in ssh auth
+// secrets used to verify the host keys of git remotes.
+const SSHKnownHostsKey = "known_hosts"
+
 type BasicAuth struct {
 	Username string
 	Password string
@@ -7,4 +11,5 @@ type BasicAuth struct {
 
 type SSH struct {
 	PrivateKey string
+	KnownHosts string
 }
@@ -3,6 +3,7 @@ package secret
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 
 	corev1 "k8s.io/api/core/v1"
@@ -35,11 +36,45 @@ func ReadSshSecret(secretVolume, secretName string) (SSH, error) {
 		return SSH{}, err
 	}
 
+	knownHosts, err := ioutil.ReadFile(filepath.Join(secretPath, SSHKnownHostsKey))
+	if err != nil && !os.IsNotExist(err) {
+		return SSH{}, err
+	}
+
 	return SSH{
 		PrivateKey: string(privateKey),
+		KnownHosts: string(knownHosts),
 	}, nil
 }
 
 func volumeName(VolumePath, secretName string) string {
 	return fmt.Sprintf("%s/%s", VolumePath, secretName)
 }
+
+// ReadGitBasicAuthSecret reads the basic auth credentials for host from a git
+// secret, which may hold a .netrc file or git credential helper output in
+// place of a username and password.
+func ReadGitBasicAuthSecret(secretVolume, secretName, host string) (BasicAuth, error) {
+	secretPath := volumeName(secretVolume, secretName)
+
+	data := map[string][]byte{}
+	for _, key := range []string{NetrcKey, GitCredentialKey} {
+		b, err := ioutil.ReadFile(filepath.Join(secretPath, key))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return BasicAuth{}, err
+		}
+		data[key] = b
+	}
+
+	if !HasGitCredentialFile(data) {
+		return ReadBasicAuthSecret(secretVolume, secretName)
+	}
+
+	auth, err := GitBasicAuthFromData(data, host)
+	if err != nil {
+		return BasicAuth{}, fmt.Errorf("%s in secret %s", err, secretName)
+	}
+	return auth, nil
+}
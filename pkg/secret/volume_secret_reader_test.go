@@ -43,6 +43,54 @@ func testVolumeSecretReader(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("#readGitBasicAuthSecret", func() {
+		var testDir string
+
+		it.Before(func() {
+			var err error
+			testDir, err = ioutil.TempDir("", "secret-volume")
+			require.NoError(t, err)
+
+			require.NoError(t, os.MkdirAll(path.Join(testDir, "creds"), 0777))
+		})
+
+		it.After(func() {
+			require.NoError(t, os.RemoveAll(testDir))
+		})
+
+		it("returns the credentials for the host from a .netrc file", func() {
+			require.NoError(t, ioutil.WriteFile(path.Join(testDir, "creds", secret.NetrcKey), []byte("machine github.com login netrc-username password netrc-password"), 0600))
+
+			auth, err := secret.ReadGitBasicAuthSecret(testDir, "creds", "github.com")
+			require.NoError(t, err)
+
+			assert.Equal(t, auth, secret.BasicAuth{
+				Username: "netrc-username",
+				Password: "netrc-password",
+			})
+		})
+
+		it("returns the username and password without a credential file", func() {
+			require.NoError(t, ioutil.WriteFile(path.Join(testDir, "creds", corev1.BasicAuthUsernameKey), []byte("saved-username"), 0600))
+			require.NoError(t, ioutil.WriteFile(path.Join(testDir, "creds", corev1.BasicAuthPasswordKey), []byte("saved-password"), 0600))
+
+			auth, err := secret.ReadGitBasicAuthSecret(testDir, "creds", "github.com")
+			require.NoError(t, err)
+
+			assert.Equal(t, auth, secret.BasicAuth{
+				Username: "saved-username",
+				Password: "saved-password",
+			})
+		})
+
+		it("returns an error when the credential file has no credentials for the host", func() {
+			require.NoError(t, ioutil.WriteFile(path.Join(testDir, "creds", secret.GitCredentialKey), []byte("host=gitlab.com\nusername=u\npassword=p\n"), 0600))
+
+			_, err := secret.ReadGitBasicAuthSecret(testDir, "creds", "github.com")
+			require.EqualError(t, err, "no credentials for github.com in secret creds")
+		})
+	})
+
 	when("#readSshSecret", func() {
 		it("returns the private key from the secret", func() {
 			testDir, err := ioutil.TempDir("", "secret-volume")
@@ -63,5 +111,27 @@ func testVolumeSecretReader(t *testing.T, when spec.G, it spec.S) {
 				PrivateKey: "foobar",
 			})
 		})
+
+		it("returns the known_hosts from the secret", func() {
+			testDir, err := ioutil.TempDir("", "secret-volume")
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, os.RemoveAll(testDir))
+			}()
+
+			require.NoError(t, os.MkdirAll(path.Join(testDir, "creds"), 0777))
+
+			require.NoError(t, ioutil.WriteFile(path.Join(testDir, "creds", corev1.SSHAuthPrivateKey), []byte("foobar"), 0600))
+			require.NoError(t, ioutil.WriteFile(path.Join(testDir, "creds", secret.SSHKnownHostsKey), []byte("github.com ssh-ed25519 AAAA"), 0600))
+
+			auth, err := secret.ReadSshSecret(testDir, "creds")
+			require.NoError(t, err)
+
+			assert.Equal(t, auth, secret.SSH{
+				PrivateKey: "foobar",
+				KnownHosts: "github.com ssh-ed25519 AAAA",
+			})
+		})
 	})
 }
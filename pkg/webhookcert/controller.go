@@ -0,0 +1,154 @@
+package webhookcert
+
+import (
+	"context"
+	"crypto/x509"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+const (
+	ReconcilerName = "WebhookCertificates"
+
+	// CertManagerCertificateAnnotation is set by cert-manager on the secrets
+	// of its Certificates. The certificates of annotated secrets are not
+	// rotated by kpack.
+	CertManagerCertificateAnnotation = "cert-manager.io/certificate-name"
+
+	certManagerCACert = "ca.crt"
+)
+
+// NewController replaces the knative certificates controller. It rotates the
+// webhook certificates before they expire, or mirrors the certificate issued
+// by cert-manager, keeping the previous CAs in the CA bundle.
+func NewController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+	secretInformer := secretinformer.Get(ctx)
+	options := webhook.GetOptions(ctx)
+
+	key := types.NamespacedName{
+		Namespace: system.Namespace(),
+		Name:      options.SecretName,
+	}
+
+	c := &Reconciler{
+		LeaderAwareFuncs: pkgreconciler.LeaderAwareFuncs{
+			PromoteFunc: func(bkt pkgreconciler.Bucket, enq func(pkgreconciler.Bucket, types.NamespacedName)) error {
+				enq(bkt, key)
+				return nil
+			},
+		},
+		Key:          key,
+		K8sClient:    kubeclient.Get(ctx),
+		SecretLister: secretInformer.Lister(),
+		Rotator: &Rotator{
+			ServiceName: options.ServiceName,
+			Namespace:   key.Namespace,
+			Now:         time.Now,
+		},
+	}
+
+	impl := controller.NewContext(ctx, c, controller.ControllerOptions{WorkQueueName: ReconcilerName, Logger: logging.FromContext(ctx).Named(ReconcilerName)})
+	c.EnqueueKeyAfter = impl.EnqueueKeyAfter
+
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithNameAndNamespace(key.Namespace, key.Name),
+		Handler:    controller.HandleAll(impl.Enqueue),
+	})
+
+	return impl
+}
+
+type Reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	Key             types.NamespacedName
+	K8sClient       k8sclient.Interface
+	SecretLister    corelisters.SecretLister
+	Rotator         *Rotator
+	EnqueueKeyAfter func(key types.NamespacedName, after time.Duration)
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	if !c.IsLeaderFor(c.Key) {
+		return controller.NewSkipKey(key)
+	}
+
+	secret, err := c.SecretLister.Secrets(c.Key.Namespace).Get(c.Key.Name)
+	if k8serrors.IsNotFound(err) {
+		// the secret is created by the install and only populated here
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var data map[string][]byte
+	if _, ok := secret.Annotations[CertManagerCertificateAnnotation]; ok {
+		data = mirrorCertManager(secret.Data, c.Rotator.Now())
+	} else {
+		var next time.Time
+		data, next, err = c.Rotator.Rotate(secret.Data)
+		if err != nil {
+			return err
+		}
+		c.EnqueueKeyAfter(c.Key, next.Sub(c.Rotator.Now()))
+	}
+
+	if reflect.DeepEqual(data, secret.Data) {
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	secret.Data = data
+	_, err = c.K8sClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// mirrorCertManager copies the certificate issued by cert-manager into the
+// keys read by the webhook. The CA bundle keeps previous CAs until they
+// expire so that the previous certificate stays trusted while the new
+// bundle propagates.
+func mirrorCertManager(data map[string][]byte, now time.Time) map[string][]byte {
+	if len(data[corev1.TLSCertKey]) == 0 || len(data[corev1.TLSPrivateKeyKey]) == 0 {
+		return data
+	}
+
+	mirrored := copyData(data)
+	mirrored[certresources.ServerCert] = data[corev1.TLSCertKey]
+	mirrored[certresources.ServerKey] = data[corev1.TLSPrivateKeyKey]
+
+	bundle := parseCertificates(data[certManagerCACert])
+	for _, ca := range unexpired(parseCertificates(data[certresources.CACert]), now) {
+		if !containsCertificate(bundle, ca) {
+			bundle = append(bundle, ca)
+		}
+	}
+	mirrored[certresources.CACert] = encodeCertificates(bundle)
+
+	return mirrored
+}
+
+func containsCertificate(certs []*x509.Certificate, cert *x509.Certificate) bool {
+	for _, c := range certs {
+		if c.Equal(cert) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package webhookcert_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	"github.com/pivotal/kpack/pkg/webhookcert"
+)
+
+func TestWebhookCertReconciler(t *testing.T) {
+	spec.Run(t, "Webhook Cert Reconciler", testWebhookCertReconciler)
+}
+
+func testWebhookCertReconciler(t *testing.T, when spec.G, it spec.S) {
+	var (
+		now      = time.Now()
+		key      = types.NamespacedName{Namespace: "kpack", Name: "webhook-certs"}
+		enqueued []time.Duration
+	)
+
+	reconcile := func(secret *corev1.Secret, leader bool) (*fake.Clientset, error) {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		require.NoError(t, indexer.Add(secret))
+		client := fake.NewSimpleClientset(secret)
+
+		r := &webhookcert.Reconciler{
+			Key:          key,
+			K8sClient:    client,
+			SecretLister: corelisters.NewSecretLister(indexer),
+			Rotator: &webhookcert.Rotator{
+				ServiceName: "kpack-webhook",
+				Namespace:   key.Namespace,
+				Now:         func() time.Time { return now },
+			},
+			EnqueueKeyAfter: func(_ types.NamespacedName, after time.Duration) {
+				enqueued = append(enqueued, after)
+			},
+		}
+		if leader {
+			require.NoError(t, r.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}))
+		}
+
+		return client, r.Reconcile(context.Background(), key.String())
+	}
+
+	it.Before(func() {
+		enqueued = nil
+	})
+
+	it("populates the secret and requeues before the serving certificate expires", func() {
+		client, err := reconcile(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}, true)
+		require.NoError(t, err)
+
+		secret, err := client.CoreV1().Secrets(key.Namespace).Get(context.Background(), key.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotEmpty(t, secret.Data[certresources.ServerCert])
+		assert.NotEmpty(t, secret.Data[certresources.ServerKey])
+		assert.NotEmpty(t, secret.Data[certresources.CACert])
+		assert.NotEmpty(t, secret.Data[webhookcert.CAKey])
+
+		require.Len(t, enqueued, 1)
+		assert.InDelta(t, (23 * 24 * time.Hour).Seconds(), enqueued[0].Seconds(), 5*time.Minute.Seconds())
+	})
+
+	it("mirrors certificates issued by cert-manager", func() {
+		serverKey, serverCert, caCert, err := certresources.CreateCerts(context.Background(), "kpack-webhook", key.Namespace, now.Add(time.Hour))
+		require.NoError(t, err)
+		_, _, previousCACert, err := certresources.CreateCerts(context.Background(), "kpack-webhook", key.Namespace, now.Add(time.Hour))
+		require.NoError(t, err)
+
+		client, err := reconcile(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        key.Name,
+				Namespace:   key.Namespace,
+				Annotations: map[string]string{webhookcert.CertManagerCertificateAnnotation: "kpack-webhook"},
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       serverCert,
+				corev1.TLSPrivateKeyKey: serverKey,
+				"ca.crt":                caCert,
+				certresources.CACert:    previousCACert,
+			},
+		}, true)
+		require.NoError(t, err)
+
+		secret, err := client.CoreV1().Secrets(key.Namespace).Get(context.Background(), key.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, serverCert, secret.Data[certresources.ServerCert])
+		assert.Equal(t, serverKey, secret.Data[certresources.ServerKey])
+		assert.Equal(t, string(caCert)+string(previousCACert), string(secret.Data[certresources.CACert]))
+		assert.Empty(t, secret.Data[webhookcert.CAKey])
+		assert.Empty(t, enqueued)
+	})
+
+	it("does not update a secret with valid certificates", func() {
+		data, _, err := (&webhookcert.Rotator{ServiceName: "kpack-webhook", Namespace: key.Namespace, Now: func() time.Time { return now }}).Rotate(nil)
+		require.NoError(t, err)
+
+		client, err := reconcile(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}, Data: data}, true)
+		require.NoError(t, err)
+		assert.Empty(t, client.Actions())
+	})
+
+	it("skips the secret when not the leader", func() {
+		client, err := reconcile(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}, false)
+		assert.True(t, controller.IsSkipKey(err))
+		assert.Empty(t, client.Actions())
+	})
+}
@@ -0,0 +1,290 @@
+package webhookcert
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+const (
+	// CAKey is the key of the secret holding the private key of the CA that
+	// signs the serving certificate.
+	CAKey = "ca-key.pem"
+
+	caValidity     = 365 * 24 * time.Hour
+	caRotation     = 30 * 24 * time.Hour
+	serverValidity = 30 * 24 * time.Hour
+	serverRotation = 7 * 24 * time.Hour
+
+	// clockSkew backdates certificates so that they are accepted by api
+	// servers with a clock behind the webhook.
+	clockSkew = 5 * time.Minute
+
+	// bundlePropagation is how long a new CA is only trusted before it signs
+	// the serving certificate, which gives the admission controllers time to
+	// patch the caBundle of the webhook configurations and CRDs.
+	bundlePropagation = 5 * time.Minute
+)
+
+// Rotator keeps the certificates of the webhook secret valid without a
+// window in which the api server does not trust the serving certificate.
+//
+// The CA bundle contains every CA that has not expired. A new CA is added to
+// the bundle well before the current one expires and only signs the serving
+// certificate once the bundle has propagated, so the serving certificate
+// and the bundle never change at the same time.
+type Rotator struct {
+	ServiceName string
+	Namespace   string
+	Now         func() time.Time
+}
+
+// Rotate returns the secret data with valid certificates and the time at
+// which the data needs to be rotated again.
+func (r *Rotator) Rotate(data map[string][]byte) (map[string][]byte, time.Time, error) {
+	now := r.Now()
+	rotated := copyData(data)
+
+	bundle := unexpired(parseCertificates(data[certresources.CACert]), now)
+
+	caKey, caCert := signingCA(data[CAKey], bundle)
+	if caCert == nil || !now.Add(caRotation).Before(caCert.NotAfter) {
+		var err error
+		caKey, caCert, err = r.createCA(now)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		bundle = append([]*x509.Certificate{caCert}, bundle...)
+
+		rotated[CAKey], err = encodeKey(caKey)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+	rotated[certresources.CACert] = encodeCertificates(bundle)
+
+	next := caCert.NotAfter.Add(-caRotation)
+	for _, ca := range bundle[1:] {
+		next = earliest(next, ca.NotAfter)
+	}
+
+	serverCert := parseServerCertificate(data[certresources.ServerCert], data[certresources.ServerKey], now)
+	if serverCert != nil && serverCert.CheckSignatureFrom(caCert) == nil && now.Add(serverRotation).Before(serverCert.NotAfter) {
+		return rotated, earliest(next, serverCert.NotAfter.Add(-serverRotation)), nil
+	}
+
+	trustedAt := caCert.NotBefore.Add(clockSkew + bundlePropagation)
+	if serverCert != nil && trusted(serverCert, bundle) && now.Before(trustedAt) {
+		return rotated, earliest(next, trustedAt), nil
+	}
+
+	serverKey, serverCertPEM, serverCert, err := r.createServerCertificate(now, caKey, caCert)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	rotated[certresources.ServerKey] = serverKey
+	rotated[certresources.ServerCert] = serverCertPEM
+
+	return rotated, earliest(next, serverCert.NotAfter.Add(-serverRotation)), nil
+}
+
+func (r *Rotator) createCA(now time.Time) (*ecdsa.PrivateKey, *x509.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := r.template(now, now.Add(caValidity))
+	if err != nil {
+		return nil, nil, err
+	}
+	template.IsCA = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+
+	cert, _, err := createCertificate(template, template, key.Public(), key)
+	return key, cert, err
+}
+
+func (r *Rotator) createServerCertificate(now time.Time, caKey crypto.Signer, caCert *x509.Certificate) ([]byte, []byte, *x509.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	template, err := r.template(now, earliest(now.Add(serverValidity), caCert.NotAfter))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+
+	cert, certPEM, err := createCertificate(template, caCert, key.Public(), caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	keyPEM, err := encodeKey(key)
+	return keyPEM, certPEM, cert, err
+}
+
+func (r *Rotator) template(now, notAfter time.Time) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serial number")
+	}
+
+	serviceName := fmt.Sprintf("%s.%s", r.ServiceName, r.Namespace)
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{"kpack.io"},
+			CommonName:   serviceName + ".svc",
+		},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		DNSNames: []string{
+			r.ServiceName,
+			serviceName,
+			serviceName + ".svc",
+			serviceName + ".svc.cluster.local",
+		},
+	}, nil
+}
+
+func createCertificate(template, parent *x509.Certificate, pub crypto.PublicKey, parentKey crypto.Signer) (*x509.Certificate, []byte, error) {
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, parentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+func signingCA(keyPEM []byte, bundle []*x509.Certificate) (*ecdsa.PrivateKey, *x509.Certificate) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil
+	}
+
+	signer, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, nil
+	}
+
+	for _, cert := range bundle {
+		if cert.IsCA && signer.PublicKey.Equal(cert.PublicKey) {
+			return signer, cert
+		}
+	}
+	return nil, nil
+}
+
+// parseServerCertificate returns the serving certificate if it matches its
+// key and has not expired.
+func parseServerCertificate(certPEM, keyPEM []byte, now time.Time) *x509.Certificate {
+	if len(certPEM) == 0 || len(keyPEM) == 0 {
+		return nil
+	}
+
+	certs := parseCertificates(certPEM)
+	if len(certs) == 0 || !now.Before(certs[0].NotAfter) {
+		return nil
+	}
+
+	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		return nil
+	}
+	return certs[0]
+}
+
+func trusted(cert *x509.Certificate, bundle []*x509.Certificate) bool {
+	for _, ca := range bundle {
+		if cert.CheckSignatureFrom(ca) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func parseCertificates(data []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		certs = append(certs, cert)
+	}
+}
+
+func unexpired(certs []*x509.Certificate, now time.Time) []*x509.Certificate {
+	var valid []*x509.Certificate
+	for _, cert := range certs {
+		if now.Before(cert.NotAfter) {
+			valid = append(valid, cert)
+		}
+	}
+	return valid
+}
+
+func encodeCertificates(certs []*x509.Certificate) []byte {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+	return buf.Bytes()
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func copyData(data map[string][]byte) map[string][]byte {
+	copied := make(map[string][]byte, len(data))
+	for k, v := range data {
+		copied[k] = v
+	}
+	return copied
+}
+
+func earliest(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,161 @@
+package webhookcert_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	"github.com/pivotal/kpack/pkg/webhookcert"
+)
+
+func TestRotator(t *testing.T) {
+	spec.Run(t, "Rotator", testRotator)
+}
+
+func testRotator(t *testing.T, when spec.G, it spec.S) {
+	var (
+		now     = time.Now()
+		rotator = &webhookcert.Rotator{
+			ServiceName: "kpack-webhook",
+			Namespace:   "kpack",
+			Now:         func() time.Time { return now },
+		}
+	)
+
+	it.Before(func() {
+		now = time.Now()
+	})
+
+	when("the secret is empty", func() {
+		it("creates a CA and a serving certificate", func() {
+			data, next, err := rotator.Rotate(nil)
+			require.NoError(t, err)
+
+			bundle := certificates(t, data[certresources.CACert])
+			require.Len(t, bundle, 1)
+			assert.True(t, bundle[0].IsCA)
+			assert.NotEmpty(t, data[webhookcert.CAKey])
+
+			serverCert := certificates(t, data[certresources.ServerCert])[0]
+			require.NoError(t, serverCert.CheckSignatureFrom(bundle[0]))
+			assert.Contains(t, serverCert.DNSNames, "kpack-webhook.kpack.svc")
+			_, err = tls.X509KeyPair(data[certresources.ServerCert], data[certresources.ServerKey])
+			require.NoError(t, err)
+
+			assert.True(t, serverCert.NotAfter.Add(-7*24*time.Hour).Equal(next))
+		})
+	})
+
+	when("the certificates are valid", func() {
+		it("does not change the secret", func() {
+			data, _, err := rotator.Rotate(nil)
+			require.NoError(t, err)
+
+			now = now.Add(time.Hour)
+			rotated, _, err := rotator.Rotate(data)
+			require.NoError(t, err)
+			assert.Equal(t, data, rotated)
+		})
+	})
+
+	when("the serving certificate expires soon", func() {
+		it("reissues it with the same CA", func() {
+			data, next, err := rotator.Rotate(nil)
+			require.NoError(t, err)
+
+			now = next
+			rotated, _, err := rotator.Rotate(data)
+			require.NoError(t, err)
+
+			assert.Equal(t, data[certresources.CACert], rotated[certresources.CACert])
+			assert.Equal(t, data[webhookcert.CAKey], rotated[webhookcert.CAKey])
+			assert.NotEqual(t, data[certresources.ServerCert], rotated[certresources.ServerCert])
+		})
+	})
+
+	when("the secret was created by the knative certificates controller", func() {
+		it("trusts a new CA before it signs the serving certificate", func() {
+			serverKey, serverCert, caCert, err := certresources.CreateCerts(context.Background(), "kpack-webhook", "kpack", now.Add(7*24*time.Hour))
+			require.NoError(t, err)
+			data := map[string][]byte{
+				certresources.ServerKey:  serverKey,
+				certresources.ServerCert: serverCert,
+				certresources.CACert:     caCert,
+			}
+
+			rotated, next, err := rotator.Rotate(data)
+			require.NoError(t, err)
+			assert.Len(t, certificates(t, rotated[certresources.CACert]), 2)
+			assert.Equal(t, serverCert, rotated[certresources.ServerCert])
+			assert.WithinDuration(t, now.Add(5*time.Minute), next, time.Second)
+
+			now = next
+			rotated, _, err = rotator.Rotate(rotated)
+			require.NoError(t, err)
+			assert.NotEqual(t, serverCert, rotated[certresources.ServerCert])
+			require.NoError(t, certificates(t, rotated[certresources.ServerCert])[0].CheckSignatureFrom(certificates(t, rotated[certresources.CACert])[0]))
+		})
+	})
+
+	it("always serves a certificate trusted by the previously published CA bundle", func() {
+		data, next, err := rotator.Rotate(nil)
+		require.NoError(t, err)
+
+		end := now.Add(3 * 365 * 24 * time.Hour)
+		caRotations := 0
+		for now.Before(end) {
+			now = next
+			var rotated map[string][]byte
+			rotated, next, err = rotator.Rotate(data)
+			require.NoError(t, err)
+			require.True(t, next.After(now))
+
+			serverCert := certificates(t, rotated[certresources.ServerCert])[0]
+			require.True(t, now.Before(serverCert.NotAfter))
+			require.True(t, trustedBy(serverCert, certificates(t, data[certresources.CACert])), "serving certificate is not trusted by the published bundle at %s", now)
+
+			if string(rotated[webhookcert.CAKey]) != string(data[webhookcert.CAKey]) {
+				caRotations++
+				require.Equal(t, data[certresources.ServerCert], rotated[certresources.ServerCert], "serving certificate changed with the CA bundle")
+			}
+			data = rotated
+		}
+
+		assert.Equal(t, 3, caRotations)
+		assert.LessOrEqual(t, len(certificates(t, data[certresources.CACert])), 2)
+	})
+}
+
+func certificates(t *testing.T, data []byte) []*x509.Certificate {
+	t.Helper()
+
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		certs = append(certs, cert)
+	}
+}
+
+func trustedBy(cert *x509.Certificate, bundle []*x509.Certificate) bool {
+	for _, ca := range bundle {
+		if cert.CheckSignatureFrom(ca) == nil {
+			return true
+		}
+	}
+	return false
+}